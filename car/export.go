@@ -0,0 +1,218 @@
+// Package car exports the DAG of an Ethereum block, rooted at its header, as a CAR (content addressable archive) file.
+package car
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-cid"
+	carv2 "github.com/ipld/go-car/v2"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/traversal"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/selectors"
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+// Version is the CAR format version to write
+type Version uint64
+
+const (
+	V1 Version = 1
+	V2 Version = 2
+)
+
+// Option configures the scope and format of an export
+type Option func(*options)
+
+type options struct {
+	version       Version
+	uncles        bool
+	txs           bool
+	receipts      bool
+	state         bool
+	stateAccounts []common.Address
+	storage       bool
+}
+
+// WithVersion sets the CAR format version to write, the default is V1.
+// V2 files carry an index of the blocks.
+func WithVersion(v Version) Option {
+	return func(o *options) {
+		o.version = v
+	}
+}
+
+// WithUncles includes the uncles list of the block
+func WithUncles() Option {
+	return func(o *options) {
+		o.uncles = true
+	}
+}
+
+// WithTxs includes the transaction trie of the block
+func WithTxs() Option {
+	return func(o *options) {
+		o.txs = true
+	}
+}
+
+// WithReceipts includes the receipt trie of the block, along with the log trie of every receipt
+func WithReceipts() Option {
+	return func(o *options) {
+		o.receipts = true
+	}
+}
+
+// WithState includes the state trie of the block.
+// If no addresses are provided the full state trie is included, otherwise only the state trie nodes on the paths to
+// the accounts for the provided addresses are included.
+func WithState(addresses ...common.Address) Option {
+	return func(o *options) {
+		o.state = true
+		o.stateAccounts = append(o.stateAccounts, addresses...)
+	}
+}
+
+// WithStorage includes the storage trie and contract code of every account included by WithState
+func WithStorage() Option {
+	return func(o *options) {
+		o.storage = true
+	}
+}
+
+// Export writes the DAG rooted at the header CID to the writer as a CAR file, loading the blocks from the LinkSystem.
+// With no options only the header is exported; the options extend the export to the uncles, transactions, receipts
+// and state of the block. The header CID is the only root of the CAR file, and blocks are written once each in
+// deterministic selector traversal order, so exporting the same scope twice produces identical files.
+// Links to the empty trie root and to empty contract code do not reference stored blocks, so they are not followed.
+func Export(ctx context.Context, lsys ipld.LinkSystem, header cid.Cid, w io.Writer, opts ...Option) error {
+	if header.Prefix().Codec != cid.EthBlock {
+		return fmt.Errorf("CAR export requires a header CID; got multicodec type (%d)", header.Prefix().Codec)
+	}
+	o := &options{version: V1}
+	for _, opt := range opts {
+		opt(o)
+	}
+	lsys = skipEmpty(lsys)
+	sel := Selector(opts...)
+	chooser := carv2.WithTraversalPrototypeChooser(dageth.NodePrototypeChooser)
+	switch o.version {
+	case V1:
+		_, err := carv2.TraverseV1(ctx, &lsys, header, sel, w, chooser)
+		return err
+	case V2:
+		writer, err := carv2.NewSelectiveWriter(ctx, &lsys, header, sel, chooser)
+		if err != nil {
+			return fmt.Errorf("unable to traverse block DAG: %v", err)
+		}
+		_, err = writer.WriteTo(w)
+		return err
+	default:
+		return fmt.Errorf("unsupported CAR version %d", o.version)
+	}
+}
+
+// Selector returns the selector Export uses, applied to a header, for the scope described by the options
+func Selector(opts ...Option) datamodel.Node {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	matchValue := ssb.ExploreAll(ssb.Matcher())
+	return ssb.ExploreUnion(
+		ssb.Matcher(),
+		ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			if o.uncles {
+				efsb.Insert("UnclesCID", ssb.Matcher())
+			}
+			if o.txs {
+				efsb.Insert("TxRootCID", selectors.Subtrie(matchValue))
+			}
+			if o.receipts {
+				efsb.Insert("RctRootCID", selectors.Subtrie(ssb.ExploreUnion(
+					matchValue,
+					ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+						efsb.Insert("Receipt", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+							efsb.Insert("LogRootCID", selectors.Subtrie(matchValue))
+						}))
+					}),
+				)))
+			}
+			if !o.state {
+				return
+			}
+			account := matchValue
+			if o.storage {
+				account = ssb.ExploreUnion(
+					matchValue,
+					ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+						efsb.Insert("Account", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+							efsb.Insert("StorageRootCID", selectors.Subtrie(matchValue))
+							efsb.Insert("CodeCID", ssb.Matcher())
+						}))
+					}),
+				)
+			}
+			if len(o.stateAccounts) == 0 {
+				efsb.Insert("StateRootCID", selectors.Subtrie(account))
+				return
+			}
+			paths := make([]builder.SelectorSpec, len(o.stateAccounts))
+			for i, addr := range o.stateAccounts {
+				paths[i] = selectors.TriePath(shared.AddressToLeafKey(addr), account)
+			}
+			if len(paths) == 1 {
+				efsb.Insert("StateRootCID", paths[0])
+				return
+			}
+			efsb.Insert("StateRootCID", ssb.ExploreUnion(paths...))
+		}),
+	).Node()
+}
+
+var (
+	emptyRootHash = types.EmptyRootHash.Bytes()
+	emptyCodeHash = crypto.Keccak256(nil)
+	trieCodecs    = map[uint64]bool{
+		cid.EthTxTrie:        true,
+		cid.EthTxReceiptTrie: true,
+		cid.EthStateTrie:     true,
+		cid.EthStorageTrie:   true,
+		dageth.EthLogTrie:    true,
+	}
+)
+
+// skipEmpty wraps the LinkSystem so that loading a link to the empty trie root or to empty contract code, which do
+// not reference stored blocks, signals the traversal to skip the link instead of failing
+func skipEmpty(lsys ipld.LinkSystem) ipld.LinkSystem {
+	readOpener := lsys.StorageReadOpener
+	lsys.StorageReadOpener = func(lnkCtx ipld.LinkContext, lnk ipld.Link) (io.Reader, error) {
+		if cidLink, ok := lnk.(cidlink.Link); ok {
+			decodedMh, err := multihash.Decode(cidLink.Hash())
+			if err == nil {
+				codec := cidLink.Prefix().Codec
+				if codec == cid.Raw && bytes.Equal(decodedMh.Digest, emptyCodeHash) {
+					return nil, traversal.SkipMe{}
+				}
+				if trieCodecs[codec] && bytes.Equal(decodedMh.Digest, emptyRootHash) {
+					return nil, traversal.SkipMe{}
+				}
+			}
+		}
+		return readOpener(lnkCtx, lnk)
+	}
+	return lsys
+}
@@ -0,0 +1,262 @@
+package car_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	carv2 "github.com/ipld/go-car/v2"
+	"github.com/ipld/go-ipld-prime"
+	_ "github.com/ipld/go-ipld-prime/codec/raw"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/car"
+	_ "github.com/vulcanize/go-codec-dageth/header"
+	_ "github.com/vulcanize/go-codec-dageth/log_trie"
+	_ "github.com/vulcanize/go-codec-dageth/rct_trie"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	_ "github.com/vulcanize/go-codec-dageth/state_trie"
+	_ "github.com/vulcanize/go-codec-dageth/storage_trie"
+	"github.com/vulcanize/go-codec-dageth/trie"
+	_ "github.com/vulcanize/go-codec-dageth/tx_trie"
+	_ "github.com/vulcanize/go-codec-dageth/uncles"
+)
+
+const (
+	testTxs      = 10
+	testAccounts = 50
+	testSlots    = 10
+)
+
+var (
+	testAddress = common.BigToAddress(big.NewInt(7))
+	testCode    = []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+)
+
+func TestExport(t *testing.T) {
+	lsys, header, codeCID := newFixture(t)
+
+	headerOnly := export(t, lsys, header)
+	cids := readCIDs(t, header, headerOnly)
+	if len(cids) != 1 || cids[0] != header {
+		t.Fatalf("header only export should contain only the header; got %v", cids)
+	}
+
+	opts := []car.Option{car.WithUncles(), car.WithTxs(), car.WithReceipts(), car.WithState(), car.WithStorage()}
+	full := export(t, lsys, header, opts...)
+	counts := make(map[uint64]int)
+	seen := make(map[cid.Cid]bool)
+	for _, c := range readCIDs(t, header, full) {
+		if seen[c] {
+			t.Errorf("block %s written more than once", c)
+		}
+		seen[c] = true
+		counts[c.Prefix().Codec]++
+	}
+	if !seen[codeCID] {
+		t.Errorf("full export should contain the contract code")
+	}
+	for _, codec := range []uint64{cid.EthTxTrie, cid.EthTxReceiptTrie, cid.EthStateTrie, cid.EthStorageTrie, dageth.EthLogTrie} {
+		if counts[codec] == 0 {
+			t.Errorf("full export should contain trie nodes with multicodec type (%d)", codec)
+		}
+	}
+	if !bytes.Equal(full, export(t, lsys, header, opts...)) {
+		t.Errorf("exporting the same scope twice should produce identical CAR files")
+	}
+
+	path := export(t, lsys, header, car.WithState(testAddress))
+	pathCounts := make(map[uint64]int)
+	for _, c := range readCIDs(t, header, path) {
+		pathCounts[c.Prefix().Codec]++
+	}
+	if pathCounts[cid.EthStateTrie] == 0 || pathCounts[cid.EthStateTrie] >= counts[cid.EthStateTrie] {
+		t.Errorf("state path export should contain some but not all state trie nodes; got %d of %d",
+			pathCounts[cid.EthStateTrie], counts[cid.EthStateTrie])
+	}
+
+	v2 := export(t, lsys, header, append(opts, car.WithVersion(car.V2))...)
+	v2CIDs := readCIDs(t, header, v2)
+	if len(v2CIDs) != len(seen) {
+		t.Errorf("V2 export contains %d blocks; expected %d", len(v2CIDs), len(seen))
+	}
+}
+
+func TestExportRequiresHeader(t *testing.T) {
+	lsys, _, codeCID := newFixture(t)
+	if err := car.Export(context.Background(), lsys, codeCID, &bytes.Buffer{}); err == nil {
+		t.Errorf("expected an error exporting from a non header CID")
+	}
+}
+
+func export(t *testing.T, lsys ipld.LinkSystem, header cid.Cid, opts ...car.Option) []byte {
+	buf := &bytes.Buffer{}
+	if err := car.Export(context.Background(), lsys, header, buf, opts...); err != nil {
+		t.Fatalf("unable to export CAR: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// readCIDs reads back the CAR file, checks that the header is its only root, and returns the CIDs of its blocks in order
+func readCIDs(t *testing.T, header cid.Cid, data []byte) []cid.Cid {
+	reader, err := carv2.NewBlockReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("unable to read CAR: %v", err)
+	}
+	if len(reader.Roots) != 1 || reader.Roots[0] != header {
+		t.Fatalf("CAR roots should be the header; got %v", reader.Roots)
+	}
+	var cids []cid.Cid
+	for {
+		blk, err := reader.Next()
+		if err != nil {
+			break
+		}
+		cids = append(cids, blk.Cid())
+	}
+	return cids
+}
+
+func newFixture(t *testing.T) (ipld.LinkSystem, cid.Cid, cid.Cid) {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+
+	codeCID, err := shared.RawToCid(cid.Raw, testCode)
+	if err != nil {
+		t.Fatalf("unable to make code CID: %v", err)
+	}
+	if err := store.Put(context.Background(), string(codeCID.Bytes()), testCode); err != nil {
+		t.Fatalf("unable to store code: %v", err)
+	}
+
+	storageBuilder := newBuilder(t, lsys, cid.EthStorageTrie)
+	for i := int64(0); i < testSlots; i++ {
+		slot := common.BigToHash(big.NewInt(i))
+		storageBuilder.Put(crypto.Keccak256(slot.Bytes()), crypto.Keccak256(slot.Bytes()))
+	}
+	storageRoot := digest(t, commit(t, storageBuilder))
+
+	// only the test account has storage and code, the others link to the empty storage root and empty code
+	stateBuilder := newBuilder(t, lsys, cid.EthStateTrie)
+	for i := int64(0); i < testAccounts; i++ {
+		addr := common.BigToAddress(big.NewInt(i))
+		acct := &types.StateAccount{
+			Nonce:    uint64(i),
+			Balance:  big.NewInt(i),
+			Root:     types.EmptyRootHash,
+			CodeHash: crypto.Keccak256(nil),
+		}
+		if addr == testAddress {
+			acct.Root = storageRoot
+			acct.CodeHash = crypto.Keccak256(testCode)
+		}
+		acctRLP, err := rlp.EncodeToBytes(acct)
+		if err != nil {
+			t.Fatalf("unable to RLP encode account: %v", err)
+		}
+		stateBuilder.Put(shared.AddressToLeafKey(addr), acctRLP)
+	}
+	stateRoot := digest(t, commit(t, stateBuilder))
+
+	txs := make(types.Transactions, testTxs)
+	rcts := make(types.Receipts, testTxs)
+	for i := range txs {
+		txs[i] = types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(i),
+			GasPrice: big.NewInt(1),
+			Gas:      21000,
+			To:       &testAddress,
+			Value:    big.NewInt(int64(i)),
+		})
+		rcts[i] = &types.Receipt{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(i+1) * 21000,
+			Logs:              []*types.Log{{Address: testAddress, Topics: []common.Hash{}, Data: []byte{byte(i)}}},
+		}
+		rcts[i].Bloom = types.CreateBloom(types.Receipts{rcts[i]})
+		logBuilder := newBuilder(t, lsys, dageth.EthLogTrie)
+		if err := logBuilder.PutEncodable(rcts[i].Logs[0]); err != nil {
+			t.Fatalf("unable to add logs to log trie: %v", err)
+		}
+		commit(t, logBuilder)
+	}
+	txBuilder := newBuilder(t, lsys, cid.EthTxTrie)
+	if err := txBuilder.PutIndexed(txs); err != nil {
+		t.Fatalf("unable to add txs to trie: %v", err)
+	}
+	txRoot := digest(t, commit(t, txBuilder))
+	rctBuilder := newBuilder(t, lsys, cid.EthTxReceiptTrie)
+	if err := rctBuilder.PutIndexed(rcts); err != nil {
+		t.Fatalf("unable to add receipts to trie: %v", err)
+	}
+	rctRoot := digest(t, commit(t, rctBuilder))
+
+	header := &types.Header{
+		UncleHash:   types.EmptyUncleHash,
+		Root:        stateRoot,
+		TxHash:      txRoot,
+		ReceiptHash: rctRoot,
+		Difficulty:  big.NewInt(1),
+		Number:      big.NewInt(1),
+		Extra:       []byte{},
+	}
+	headerRLP, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		t.Fatalf("unable to RLP encode header: %v", err)
+	}
+	headerCID, err := shared.RawToCid(cid.EthBlock, headerRLP)
+	if err != nil {
+		t.Fatalf("unable to make header CID: %v", err)
+	}
+	if err := store.Put(context.Background(), string(headerCID.Bytes()), headerRLP); err != nil {
+		t.Fatalf("unable to store header: %v", err)
+	}
+	// the uncles list of the header is empty
+	unclesRLP, err := rlp.EncodeToBytes([]*types.Header{})
+	if err != nil {
+		t.Fatalf("unable to RLP encode uncles: %v", err)
+	}
+	unclesCID, err := shared.RawToCid(cid.EthBlockList, unclesRLP)
+	if err != nil {
+		t.Fatalf("unable to make uncles CID: %v", err)
+	}
+	if err := store.Put(context.Background(), string(unclesCID.Bytes()), unclesRLP); err != nil {
+		t.Fatalf("unable to store uncles: %v", err)
+	}
+	return lsys, headerCID, codeCID
+}
+
+func newBuilder(t *testing.T, lsys ipld.LinkSystem, codec uint64) *trie.Builder {
+	builder, err := trie.NewBuilder(lsys, codec)
+	if err != nil {
+		t.Fatalf("unable to create trie builder: %v", err)
+	}
+	return builder
+}
+
+func commit(t *testing.T, builder *trie.Builder) cid.Cid {
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit trie: %v", err)
+	}
+	return root
+}
+
+func digest(t *testing.T, c cid.Cid) common.Hash {
+	decodedMh, err := multihash.Decode(c.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode multihash: %v", err)
+	}
+	return common.BytesToHash(decodedMh.Digest)
+}
@@ -0,0 +1,43 @@
+package dageth
+
+import (
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/traversal"
+)
+
+// PrototypeForCodec returns the dageth.Type prototype for the DAG-ETH multicodec type.
+// Contract code is linked from Account.CodeCID with the raw multicodec type, which maps to ByteCode.
+func PrototypeForCodec(codec uint64) (ipld.NodePrototype, bool) {
+	if codec == cid.Raw {
+		return Type.ByteCode, true
+	}
+	if c, ok := LookupCodec(codec); ok {
+		return c.Prototype, true
+	}
+	return nil, false
+}
+
+// AddSupportToChooser takes an existing node prototype chooser and subs in the dageth.Type prototype
+// for the link targets of every DAG-ETH multicodec type, falling back to the existing chooser for all other links.
+func AddSupportToChooser(existing traversal.LinkTargetNodePrototypeChooser) traversal.LinkTargetNodePrototypeChooser {
+	return func(lnk ipld.Link, lnkCtx ipld.LinkContext) (ipld.NodePrototype, error) {
+		if lnk, ok := lnk.(cidlink.Link); ok {
+			if proto, ok := PrototypeForCodec(lnk.Cid.Prefix().Codec); ok {
+				return proto, nil
+			}
+		}
+		return existing(lnk, lnkCtx)
+	}
+}
+
+// NodePrototypeChooser is a traversal.LinkTargetNodePrototypeChooser that maps the link targets of every DAG-ETH
+// multicodec type (header, uncles, transaction, receipt, account, log, the tx, receipt, state, storage and log tries,
+// and contract code) to their dageth.Type prototype, and all other links to basicnode.Prototype.Any.
+// Used with a traversal.Config, selector traversals starting from a header produce typed nodes all the way down
+// to the storage slots, since the links followed on the way all carry their target's multicodec type.
+var NodePrototypeChooser = AddSupportToChooser(func(ipld.Link, ipld.LinkContext) (ipld.NodePrototype, error) {
+	return basicnode.Prototype.Any, nil
+})
@@ -0,0 +1,156 @@
+package dageth_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	_ "github.com/ipld/go-ipld-prime/codec/raw"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/schema"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+	"github.com/ipld/go-ipld-prime/traversal"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	_ "github.com/vulcanize/go-codec-dageth/header"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	_ "github.com/vulcanize/go-codec-dageth/state_trie"
+	_ "github.com/vulcanize/go-codec-dageth/storage_trie"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestNodePrototypeChooser(t *testing.T) {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	lsys.TrustedStorage = true
+
+	code := []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+	codeCID, err := shared.RawToCid(cid.Raw, code)
+	if err != nil {
+		t.Fatalf("unable to make code CID: %v", err)
+	}
+	if err := store.Put(context.Background(), string(codeCID.Bytes()), code); err != nil {
+		t.Fatalf("unable to store code: %v", err)
+	}
+
+	storageBuilder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create storage trie builder: %v", err)
+	}
+	slots := 10
+	for i := 0; i < slots; i++ {
+		slot := crypto.Keccak256(common.BigToHash(big.NewInt(int64(i))).Bytes())
+		storageBuilder.Put(slot, common.BigToHash(big.NewInt(int64(i+1))).Bytes())
+	}
+	storageRoot, err := storageBuilder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+	decodedStorageMh, err := multihash.Decode(storageRoot.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode storage root multihash: %v", err)
+	}
+
+	stateBuilder, err := trie.NewBuilder(lsys, cid.EthStateTrie)
+	if err != nil {
+		t.Fatalf("unable to create state trie builder: %v", err)
+	}
+	// every account shares the same storage and code, so that there are no links to blocks missing from the store
+	accounts := 20
+	for i := 0; i < accounts; i++ {
+		acctRLP, err := rlp.EncodeToBytes(&types.StateAccount{
+			Nonce:    uint64(i),
+			Balance:  big.NewInt(int64(i)),
+			Root:     common.BytesToHash(decodedStorageMh.Digest),
+			CodeHash: crypto.Keccak256(code),
+		})
+		if err != nil {
+			t.Fatalf("unable to RLP encode account: %v", err)
+		}
+		stateBuilder.Put(crypto.Keccak256(common.BigToAddress(big.NewInt(int64(i))).Bytes()), acctRLP)
+	}
+	stateRoot, err := stateBuilder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit state trie: %v", err)
+	}
+	decodedStateMh, err := multihash.Decode(stateRoot.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode state root multihash: %v", err)
+	}
+
+	headerRLP, err := rlp.EncodeToBytes(&types.Header{
+		Root:       common.BytesToHash(decodedStateMh.Digest),
+		Difficulty: big.NewInt(1),
+		Number:     big.NewInt(1),
+		Extra:      []byte{},
+	})
+	if err != nil {
+		t.Fatalf("unable to RLP encode header: %v", err)
+	}
+	headerCID, err := shared.RawToCid(cid.EthBlock, headerRLP)
+	if err != nil {
+		t.Fatalf("unable to make header CID: %v", err)
+	}
+	if err := store.Put(context.Background(), string(headerCID.Bytes()), headerRLP); err != nil {
+		t.Fatalf("unable to store header: %v", err)
+	}
+
+	headerLink := cidlink.Link{Cid: headerCID}
+	proto, err := dageth.NodePrototypeChooser(headerLink, ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to choose header prototype: %v", err)
+	}
+	headerNode, err := lsys.Load(ipld.LinkContext{}, headerLink, proto)
+	if err != nil {
+		t.Fatalf("unable to load header: %v", err)
+	}
+
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	sel, err := selector.CompileSelector(ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+		efsb.Insert("StateRootCID", ssb.ExploreRecursive(selector.RecursionLimitNone(), ssb.ExploreAll(ssb.ExploreRecursiveEdge())))
+	}).Node())
+	if err != nil {
+		t.Fatalf("unable to compile selector: %v", err)
+	}
+	storageValues, codeValues := 0, 0
+	err = traversal.Progress{
+		Cfg: &traversal.Config{
+			LinkSystem:                     lsys,
+			LinkTargetNodePrototypeChooser: dageth.NodePrototypeChooser,
+		},
+	}.WalkAdv(headerNode, sel, func(prog traversal.Progress, n ipld.Node, _ traversal.VisitReason) error {
+		if _, ok := n.(schema.TypedNode); !ok && !n.IsNull() {
+			t.Fatalf("node at %s is not a typed node: %T", prog.Path.String(), n)
+		}
+		switch n.Prototype() {
+		case dageth.Type.ByteCode:
+			codeValues++
+		case dageth.Type.Bytes:
+			if prog.Path.Last().String() == trie.STORAGE_VALUE.String() {
+				storageValues++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to traverse header DAG: %v", err)
+	}
+	if storageValues != accounts*slots {
+		t.Errorf("traversal reached %d typed storage values; expected %d", storageValues, accounts*slots)
+	}
+	if codeValues != accounts {
+		t.Errorf("traversal reached %d typed code values; expected %d", codeValues, accounts)
+	}
+}
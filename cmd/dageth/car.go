@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-cid"
+
+	"github.com/vulcanize/go-codec-dageth/car"
+)
+
+func runCAR(args []string) error {
+	fs := flag.NewFlagSet("car", flag.ContinueOnError)
+	var src source
+	src.register(fs)
+	out := fs.String("o", "", "path of the CAR file to write (required)")
+	version := fs.Uint64("version", 1, "CAR format version to write (1 or 2)")
+	uncles := fs.Bool("uncles", false, "include the uncles list")
+	txs := fs.Bool("txs", false, "include the transaction trie")
+	rcts := fs.Bool("receipts", false, "include the receipt trie and log tries")
+	state := fs.Bool("state", false, "include the state trie")
+	accounts := fs.String("accounts", "", "comma separated addresses to limit the state trie to (implies -state)")
+	storage := fs.Bool("storage", false, "include the storage tries and code of the included accounts")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: dageth car [flags] <header CID>\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || *out == "" {
+		fs.Usage()
+		return fmt.Errorf("a header CID and an output path are required")
+	}
+	header, err := cid.Decode(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("invalid header CID: %v", err)
+	}
+
+	opts := []car.Option{car.WithVersion(car.Version(*version))}
+	if *uncles {
+		opts = append(opts, car.WithUncles())
+	}
+	if *txs {
+		opts = append(opts, car.WithTxs())
+	}
+	if *rcts {
+		opts = append(opts, car.WithReceipts())
+	}
+	if *accounts != "" {
+		var addrs []common.Address
+		for _, a := range strings.Split(*accounts, ",") {
+			if !common.IsHexAddress(a) {
+				return fmt.Errorf("invalid address %q", a)
+			}
+			addrs = append(addrs, common.HexToAddress(a))
+		}
+		opts = append(opts, car.WithState(addrs...))
+	} else if *state {
+		opts = append(opts, car.WithState())
+	}
+	if *storage {
+		opts = append(opts, car.WithStorage())
+	}
+
+	lsys, release, err := src.linkSystem()
+	if err != nil {
+		return err
+	}
+	defer release()
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := car.Export(context.Background(), lsys, header, w, opts...); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
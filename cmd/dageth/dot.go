@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func runDOT(args []string) error {
+	fs := flag.NewFlagSet("dot", flag.ContinueOnError)
+	var src source
+	src.register(fs)
+	out := fs.String("o", "", "path of the DOT file to write (default stdout)")
+	depth := fs.Int("depth", -1, "maximum depth of the nodes to render, negative renders the whole trie")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: dageth dot [flags] <trie root CID>\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("a trie root CID is required")
+	}
+	root, err := cid.Decode(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("invalid trie root CID: %v", err)
+	}
+
+	lsys, release, err := src.linkSystem()
+	if err != nil {
+		return err
+	}
+	defer release()
+	lnkCtx := ipld.LinkContext{Ctx: context.Background()}
+	if *out == "" {
+		return trie.WriteDOT(lnkCtx, lsys, root, *depth, os.Stdout)
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := trie.WriteDOT(lnkCtx, lsys, root, *depth, f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
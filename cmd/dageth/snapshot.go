@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+
+	"github.com/vulcanize/go-codec-dageth/snapshot"
+)
+
+func runSnapshot(args []string) error {
+	fs := flag.NewFlagSet("snapshot", flag.ContinueOnError)
+	var src source
+	src.register(fs)
+	out := fs.String("o", "", "path of the snapshot stream file to write")
+	leveldb := fs.String("leveldb", "", "path of the LevelDB database to write the snapshot into")
+	pebble := fs.String("pebble", "", "path of the Pebble database to write the snapshot into")
+	start := fs.String("start", "", "hex account hash to start the export from, inclusive")
+	limit := fs.String("limit", "", "hex account hash to end the export at, exclusive")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: dageth snapshot [flags] <state root CID>\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	var outputs int
+	for _, o := range []string{*out, *leveldb, *pebble} {
+		if o != "" {
+			outputs++
+		}
+	}
+	if fs.NArg() != 1 || outputs != 1 {
+		fs.Usage()
+		return fmt.Errorf("a state root CID and exactly one of -o, -leveldb or -pebble are required")
+	}
+	stateRoot, err := cid.Decode(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("invalid state root CID: %v", err)
+	}
+	var startHash common.Hash
+	if *start != "" {
+		if startHash, err = parseHash(*start); err != nil {
+			return err
+		}
+	}
+	var limitHash *common.Hash
+	if *limit != "" {
+		h, err := parseHash(*limit)
+		if err != nil {
+			return err
+		}
+		limitHash = &h
+	}
+	rng := snapshot.WithRange(startHash, limitHash)
+
+	lsys, release, err := src.linkSystem()
+	if err != nil {
+		return err
+	}
+	defer release()
+	lnkCtx := ipld.LinkContext{Ctx: context.Background()}
+	var count int
+	if *leveldb != "" || *pebble != "" {
+		var db ethdb.Database
+		if *leveldb != "" {
+			db, err = rawdb.NewLevelDBDatabase(*leveldb, 256, 256, "", false)
+		} else {
+			db, err = rawdb.NewPebbleDBDatabase(*pebble, 256, 256, "", false)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to open database: %v", err)
+		}
+		count, err = exportToDB(lnkCtx, lsys, stateRoot, db, rng)
+		if closeErr := db.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		w := bufio.NewWriterSize(f, ethdb.IdealBatchSize)
+		if count, err = snapshot.Export(lnkCtx, lsys, stateRoot, snapshot.NewStreamWriter(w), rng,
+			snapshot.WithProgress(func(accountHash common.Hash) error {
+				if w.Buffered() < ethdb.IdealBatchSize/2 {
+					return nil
+				}
+				// only accounts flushed to the file are reported, so that an interrupted export can be resumed
+				// after them
+				if err := w.Flush(); err != nil {
+					return err
+				}
+				reportProgress(accountHash)
+				return nil
+			})); err != nil {
+			w.Flush()
+			f.Close()
+			return err
+		}
+		if err := w.Flush(); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "\nexported %d accounts\n", count)
+	return nil
+}
+
+// exportToDB exports the snapshot into the database through batches, writing each batch once it reaches the ideal
+// batch size
+func exportToDB(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, stateRoot cid.Cid, db ethdb.Database, rng snapshot.Option) (int, error) {
+	batch := db.NewBatch()
+	count, err := snapshot.Export(lnkCtx, lsys, stateRoot, batch, rng, snapshot.WithProgress(func(accountHash common.Hash) error {
+		if batch.ValueSize() < ethdb.IdealBatchSize {
+			return nil
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+		// only accounts in written batches are reported, so that an interrupted export can be resumed after them
+		reportProgress(accountHash)
+		return nil
+	}))
+	if err != nil {
+		return count, err
+	}
+	return count, batch.Write()
+}
+
+// reportProgress reports the last account exported, an interrupted export can be resumed after it with -start
+func reportProgress(accountHash common.Hash) {
+	fmt.Fprintf(os.Stderr, "\rexported account %s", accountHash.Hex())
+}
+
+func parseHash(s string) (common.Hash, error) {
+	b := common.FromHex(s)
+	if len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid account hash %q", s)
+	}
+	return common.BytesToHash(b), nil
+}
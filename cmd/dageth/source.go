@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car/v2/blockstore"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/raw"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/multicodec"
+	"github.com/ipld/go-ipld-prime/storage/fsstore"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	_ "github.com/vulcanize/go-codec-dageth/header"
+	_ "github.com/vulcanize/go-codec-dageth/log"
+	_ "github.com/vulcanize/go-codec-dageth/log_trie"
+	_ "github.com/vulcanize/go-codec-dageth/rct"
+	_ "github.com/vulcanize/go-codec-dageth/rct_trie"
+	_ "github.com/vulcanize/go-codec-dageth/state_account"
+	_ "github.com/vulcanize/go-codec-dageth/state_trie"
+	_ "github.com/vulcanize/go-codec-dageth/storage_trie"
+	_ "github.com/vulcanize/go-codec-dageth/tx"
+	_ "github.com/vulcanize/go-codec-dageth/tx_trie"
+	_ "github.com/vulcanize/go-codec-dageth/uncles"
+)
+
+// source holds the flags selecting the block store commands read blocks from
+type source struct {
+	car string
+	dir string
+}
+
+func (s *source) register(fs *flag.FlagSet) {
+	fs.StringVar(&s.car, "from-car", "", "read blocks from the CAR file at this path")
+	fs.StringVar(&s.dir, "from-dir", "", "read blocks from the go-ipld-prime fsstore directory at this path")
+}
+
+// linkSystem returns a LinkSystem reading blocks from the selected store, and a function to release the store
+func (s *source) linkSystem() (ipld.LinkSystem, func(), error) {
+	var reg multicodec.Registry
+	if err := dageth.RegisterAll(&reg); err != nil {
+		return ipld.LinkSystem{}, nil, err
+	}
+	reg.RegisterEncoder(cid.Raw, raw.Encode)
+	reg.RegisterDecoder(cid.Raw, raw.Decode)
+	lsys := cidlink.LinkSystemUsingMulticodecRegistry(reg)
+	switch {
+	case s.car != "" && s.dir != "":
+		return lsys, nil, fmt.Errorf("only one of -from-car and -from-dir can be set")
+	case s.car != "":
+		bs, err := blockstore.OpenReadOnly(s.car)
+		if err != nil {
+			return lsys, nil, fmt.Errorf("unable to open CAR file: %v", err)
+		}
+		lsys.StorageReadOpener = func(lnkCtx ipld.LinkContext, lnk ipld.Link) (io.Reader, error) {
+			cidLink, ok := lnk.(cidlink.Link)
+			if !ok {
+				return nil, fmt.Errorf("unsupported link type %T", lnk)
+			}
+			blk, err := bs.Get(lnkCtx.Ctx, cidLink.Cid)
+			if err != nil {
+				return nil, err
+			}
+			return bytes.NewReader(blk.RawData()), nil
+		}
+		return lsys, func() { bs.Close() }, nil
+	case s.dir != "":
+		store := &fsstore.Store{}
+		if err := store.InitDefaults(s.dir); err != nil {
+			return lsys, nil, fmt.Errorf("unable to open block directory: %v", err)
+		}
+		lsys.SetReadStorage(store)
+		return lsys, func() {}, nil
+	default:
+		return lsys, nil, fmt.Errorf("one of -from-car or -from-dir is required")
+	}
+}
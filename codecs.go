@@ -0,0 +1,184 @@
+package dageth
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/multicodec"
+	"github.com/multiformats/go-multihash"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+// The log and log trie multicodec types are not yet in the multicodec table
+const (
+	EthLogTrie = uint64(0x99) // Proposed
+	EthLog     = uint64(0x9a) // Proposed
+)
+
+// Codec describes one of the DAG-ETH codecs: its multicodec type and name, the multihash type of its CIDs,
+// the name and dageth.Type prototype of the DAG-ETH type of its nodes, and its encoder and decoder
+type Codec struct {
+	Code      uint64
+	Name      string
+	MultiHash uint64
+	TypeName  string
+	Prototype ipld.NodePrototype
+	Encoder   ipld.Encoder
+	Decoder   ipld.Decoder
+}
+
+// Codecs is the table of DAG-ETH codecs.
+// The Encoder and Decoder of a codec are set when its package (e.g. github.com/vulcanize/go-codec-dageth/header)
+// is imported, as this package can not import the codec packages which depend upon it.
+var Codecs = []*Codec{
+	{Code: cid.EthBlock, Name: "eth-block", MultiHash: multihash.KECCAK_256, TypeName: "Header", Prototype: Type.Header},
+	{Code: cid.EthBlockList, Name: "eth-block-list", MultiHash: multihash.KECCAK_256, TypeName: "Uncles", Prototype: Type.Uncles},
+	{Code: cid.EthTxTrie, Name: "eth-tx-trie", MultiHash: multihash.KECCAK_256, TypeName: "TrieNode", Prototype: Type.TrieNode},
+	{Code: cid.EthTx, Name: "eth-tx", MultiHash: multihash.KECCAK_256, TypeName: "Transaction", Prototype: Type.Transaction},
+	{Code: cid.EthTxReceiptTrie, Name: "eth-tx-receipt-trie", MultiHash: multihash.KECCAK_256, TypeName: "TrieNode", Prototype: Type.TrieNode},
+	{Code: cid.EthTxReceipt, Name: "eth-tx-receipt", MultiHash: multihash.KECCAK_256, TypeName: "Receipt", Prototype: Type.Receipt},
+	{Code: cid.EthStateTrie, Name: "eth-state-trie", MultiHash: multihash.KECCAK_256, TypeName: "TrieNode", Prototype: Type.TrieNode},
+	{Code: cid.EthAccountSnapshot, Name: "eth-account-snapshot", MultiHash: multihash.KECCAK_256, TypeName: "Account", Prototype: Type.Account},
+	{Code: cid.EthStorageTrie, Name: "eth-storage-trie", MultiHash: multihash.KECCAK_256, TypeName: "TrieNode", Prototype: Type.TrieNode},
+	{Code: EthLogTrie, Name: "eth-receipt-log-trie", MultiHash: multihash.KECCAK_256, TypeName: "TrieNode", Prototype: Type.TrieNode},
+	{Code: EthLog, Name: "eth-receipt-log", MultiHash: multihash.KECCAK_256, TypeName: "Log", Prototype: Type.Log},
+}
+
+// LookupCodec returns the entry of the codec table for the multicodec type
+func LookupCodec(code uint64) (*Codec, bool) {
+	for _, c := range Codecs {
+		if c.Code == code {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// codecName returns the multicodec name of a DAG-ETH codec, or of the raw codec DAG-ETH uses for contract code
+func codecName(code uint64) string {
+	if c, ok := LookupCodec(code); ok {
+		return c.Name
+	}
+	if code == cid.Raw {
+		return "raw"
+	}
+	return fmt.Sprintf("multicodec type (%d)", code)
+}
+
+// LinkDigest returns the keccak256 hash referenced by a link, checking that the link is a CID of the provided
+// multicodec type with a KECCAK_256 multihash. It returns an ErrWrongLinkTarget if the CID is of another type,
+// so that e.g. a header ParentCID pointing at a transaction does not encode as a valid looking hash.
+func LinkDigest(lnk ipld.Link, codec uint64) ([]byte, error) {
+	cidLink, ok := lnk.(cidlink.Link)
+	if !ok {
+		return nil, fmt.Errorf("link needs to be a CID; got %T", lnk)
+	}
+	decodedMh, err := multihash.Decode(cidLink.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode link multihash: %v", err)
+	}
+	if cidLink.Prefix().Codec != codec || decodedMh.Code != multihash.KECCAK_256 {
+		return nil, ErrWrongLinkTarget{Codec: codec, Link: cidLink.Cid}
+	}
+	return decodedMh.Digest, nil
+}
+
+// RegisterCodec sets the encoder and decoder of a codec in the table and registers them into the go-ipld-prime
+// multicodec registry. It is called by the init function of each codec package.
+func RegisterCodec(code uint64, enc ipld.Encoder, dec ipld.Decoder) {
+	c, ok := LookupCodec(code)
+	if !ok {
+		panic(fmt.Sprintf("multicodec type (%d) is not a DAG-ETH codec", code))
+	}
+	c.Encoder, c.Decoder = enc, dec
+	multicodec.RegisterEncoder(code, enc)
+	multicodec.RegisterDecoder(code, dec)
+}
+
+// RegisterAll registers the encoder and decoder of every DAG-ETH codec into the registry.
+// It errors if any codec package has not been imported, which leaves the codec without an encoder and decoder.
+func RegisterAll(reg *multicodec.Registry) error {
+	for _, c := range Codecs {
+		if c.Encoder == nil || c.Decoder == nil {
+			return fmt.Errorf("codec %s (%d) has no encoder and decoder, its package must be imported", c.Name, c.Code)
+		}
+	}
+	for _, c := range Codecs {
+		reg.RegisterEncoder(c.Code, c.Encoder)
+		reg.RegisterDecoder(c.Code, c.Decoder)
+	}
+	return nil
+}
+
+// RegisterAllStrict is like RegisterAll, but it registers the DecodeStrict decoder of every codec
+func RegisterAllStrict(reg *multicodec.Registry) error {
+	if err := RegisterAll(reg); err != nil {
+		return err
+	}
+	for _, c := range Codecs {
+		reg.RegisterDecoder(c.Code, c.DecodeStrict)
+	}
+	return nil
+}
+
+// DecodeStrict is like the Decoder of the codec, but it also rejects input which is not in canonical form.
+// The decoders reject the input DAG-ETH is known not to represent, such as header fields from later forks, and the
+// fuzz tests check that what they accept re-encodes to the same bytes; DecodeStrict guarantees it for every input, so
+// that a decoded node always hashes to the CID of its input.
+// DecodeStrict decodes into a node of the codec's Prototype and re-encodes it, returning an ErrNonCanonical wrapped
+// in an ErrInvalidBinary if the re-encoding differs from the input, before assigning the node to the NodeAssembler.
+func (c *Codec) DecodeStrict(na ipld.NodeAssembler, in io.Reader) error {
+	if c.Encoder == nil || c.Decoder == nil {
+		return fmt.Errorf("codec %s (%d) has no encoder and decoder, its package must be imported", c.Name, c.Code)
+	}
+	var src []byte
+	if buf, ok := in.(interface{ Bytes() []byte }); ok {
+		src = buf.Bytes()
+	} else {
+		// the whole input is compared with its re-encoding, so it is read into memory, capped like the decoders cap
+		// input of unknown size
+		var err error
+		if src, err = io.ReadAll(io.LimitReader(in, shared.MaxStreamSize+1)); err != nil {
+			return err
+		}
+		if int64(len(src)) > shared.MaxStreamSize {
+			return ErrInvalidBinary{Type: c.TypeName, Err: rlp.ErrValueTooLarge}
+		}
+	}
+	builder := c.Prototype.NewBuilder()
+	if err := c.Decoder(builder, bytes.NewBuffer(src)); err != nil {
+		return err
+	}
+	node := builder.Build()
+	enc := bytes.NewBuffer(make([]byte, 0, len(src)))
+	if err := c.Encoder(node, enc); err != nil {
+		return ErrInvalidBinary{Type: c.TypeName, Err: err}
+	}
+	if offset := firstDifference(src, enc.Bytes()); offset >= 0 {
+		return ErrInvalidBinary{Type: c.TypeName, Err: ErrNonCanonical{Offset: offset}}
+	}
+	return na.AssignNode(node)
+}
+
+// firstDifference returns the offset of the first byte at which a and b differ, or -1 if they are equal
+func firstDifference(a, b []byte) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	if len(a) != len(b) {
+		return n
+	}
+	return -1
+}
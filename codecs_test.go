@@ -0,0 +1,130 @@
+package dageth_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/multicodec"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/header"
+	_ "github.com/vulcanize/go-codec-dageth/log"
+	_ "github.com/vulcanize/go-codec-dageth/log_trie"
+	_ "github.com/vulcanize/go-codec-dageth/rct"
+	_ "github.com/vulcanize/go-codec-dageth/rct_trie"
+	"github.com/vulcanize/go-codec-dageth/shared/fuzztest"
+	_ "github.com/vulcanize/go-codec-dageth/state_account"
+	_ "github.com/vulcanize/go-codec-dageth/state_trie"
+	_ "github.com/vulcanize/go-codec-dageth/storage_trie"
+	_ "github.com/vulcanize/go-codec-dageth/tx"
+	_ "github.com/vulcanize/go-codec-dageth/tx_trie"
+	_ "github.com/vulcanize/go-codec-dageth/uncles"
+)
+
+func TestRegisterAll(t *testing.T) {
+	var reg multicodec.Registry
+	if err := dageth.RegisterAll(&reg); err != nil {
+		t.Fatalf("unable to register codecs: %v", err)
+	}
+	for _, c := range dageth.Codecs {
+		if _, err := reg.LookupEncoder(c.Code); err != nil {
+			t.Errorf("codec %s encoder was not registered: %v", c.Name, err)
+		}
+		if _, err := reg.LookupDecoder(c.Code); err != nil {
+			t.Errorf("codec %s decoder was not registered: %v", c.Name, err)
+		}
+		if _, err := multicodec.LookupDecoder(c.Code); err != nil {
+			t.Errorf("codec %s decoder was not registered into the default registry: %v", c.Name, err)
+		}
+		if proto, ok := dageth.PrototypeForCodec(c.Code); !ok || proto != c.Prototype {
+			t.Errorf("codec %s prototype does not match the chooser", c.Name)
+		}
+	}
+}
+
+func TestDecodeStrict(t *testing.T) {
+	headerCodec, _ := dageth.LookupCodec(cid.EthBlock)
+	londonHeader := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(0), BaseFee: big.NewInt(7)}
+	canonical, err := rlp.EncodeToBytes(londonHeader)
+	if err != nil {
+		t.Fatalf("unable to RLP encode header: %v", err)
+	}
+	strictNode := dageth.Type.Header.NewBuilder()
+	if err := headerCodec.DecodeStrict(strictNode, bytes.NewReader(canonical)); err != nil {
+		t.Fatalf("unable to strictly decode canonical header: %v", err)
+	}
+	node := dageth.Type.Header.NewBuilder()
+	if err := headerCodec.Decoder(node, bytes.NewReader(canonical)); err != nil {
+		t.Fatalf("unable to decode canonical header: %v", err)
+	}
+	if !ipld.DeepEqual(strictNode.Build(), node.Build()) {
+		t.Errorf("strictly decoded header does not match decoded header")
+	}
+
+	// a header with a field from a later fork is rejected by the decoder, as DAG-ETH can not represent the field
+	withdrawalsHash := common.HexToHash("0x01")
+	shanghaiHeader := types.CopyHeader(londonHeader)
+	shanghaiHeader.WithdrawalsHash = &withdrawalsHash
+	nonCanonical, err := rlp.EncodeToBytes(shanghaiHeader)
+	if err != nil {
+		t.Fatalf("unable to RLP encode header: %v", err)
+	}
+	var invalidField dageth.ErrInvalidField
+	err = headerCodec.Decoder(basicnode.Prototype.Any.NewBuilder(), bytes.NewReader(nonCanonical))
+	if !errors.As(err, &invalidField) || invalidField.Field != "WithdrawalsHash" {
+		t.Fatalf("expected a WithdrawalsHash ErrInvalidField; got %v", err)
+	}
+	var reg multicodec.Registry
+	if err := dageth.RegisterAllStrict(&reg); err != nil {
+		t.Fatalf("unable to register codecs: %v", err)
+	}
+	if _, err := reg.LookupDecoder(cid.EthBlock); err != nil {
+		t.Fatalf("strict header decoder was not registered: %v", err)
+	}
+
+	// a decoder which drops the field accepts the header, which DecodeStrict then rejects as it does not re-encode
+	// the same
+	lenient := *headerCodec
+	lenient.Decoder = func(na ipld.NodeAssembler, in io.Reader) error {
+		src, err := io.ReadAll(in)
+		if err != nil {
+			return err
+		}
+		var h types.Header
+		if err := rlp.DecodeBytes(src, &h); err != nil {
+			return err
+		}
+		h.WithdrawalsHash = nil
+		return header.DecodeHeader(na, h)
+	}
+	err = lenient.DecodeStrict(basicnode.Prototype.Any.NewBuilder(), bytes.NewReader(nonCanonical))
+	var nonCanonicalErr dageth.ErrNonCanonical
+	if !errors.As(err, &nonCanonicalErr) {
+		t.Fatalf("expected ErrNonCanonical; got %v", err)
+	}
+	// the list length prefix is the first byte to differ
+	if nonCanonicalErr.Offset != 1 {
+		t.Errorf("non-canonical header differs at offset %d; expected 1", nonCanonicalErr.Offset)
+	}
+}
+
+func FuzzDecodeStrict(f *testing.F) {
+	londonHeader, err := rlp.EncodeToBytes(&types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(0), BaseFee: big.NewInt(7)})
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(uint8(0), londonHeader)
+	f.Add(uint8(1), []byte{0xc0})
+	f.Fuzz(func(t *testing.T, i uint8, data []byte) {
+		fuzztest.FuzzStrict(t, dageth.Codecs[int(i)%len(dageth.Codecs)], data)
+	})
+}
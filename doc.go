@@ -6,12 +6,20 @@ go-ipld-prime (https://github.com/ipld/go-ipld-prime/).
 Use the Decode() and Encode() functions directly, or import one of the packages to have their codec
 registered into the go-ipld-prime multicodec registry and available from the
 cidlink.DefaultLinkSystem.
+The dageth.Codecs table lists every codec, and RegisterAll registers the imported codecs into
+another multicodec.Registry. RegisterAllStrict registers Codec.DecodeStrict instead of the codec decoders, rejecting
+input which decodes but is not in canonical form with an ErrNonCanonical.
+NewLinkSystem returns an ipld.LinkSystem preconfigured with the DAG-ETH codecs and keccak256 hashing. The Store helpers
+(e.g. StoreHeader) store a node with such a LinkSystem and return the CID of its canonical Ethereum hash.
 
 Nodes encoded with theses codecs _must_ conform to the DAG-ETH spec. Specifically,
 they should have the non-optional fields shown in the DAG-ETH [schemas](https://github.com/ipld/ipld/tree/master/specs/codecs/dag-eth):
 
 Use the dageth.Type slab to select the appropriate type (e.g. dageth.Type.Transaction) for strictness guarantees.
 Basic ipld.Nodes will need to have the appropriate fields (and no others) to successfully encode using this codec.
+
+Encoders return an ErrInvalidForm and decoders an ErrInvalidBinary, wrapping the cause (e.g. an ErrInvalidField,
+ErrUnsupportedTxType or RLP error) so that it can be inspected with errors.As.
 */
 package dageth
 
@@ -0,0 +1,101 @@
+package dageth
+
+import (
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+)
+
+// ErrInvalidForm is returned by the DAG-ETH encoders when an IPLD node does not have the form of the DAG-ETH type
+// it is encoded as. It wraps the error that describes why, e.g. an ErrInvalidField.
+type ErrInvalidForm struct {
+	Type string
+	Err  error
+}
+
+func (e ErrInvalidForm) Error() string {
+	return fmt.Sprintf("invalid DAG-ETH %s form (%v)", e.Type, e.Err)
+}
+
+func (e ErrInvalidForm) Unwrap() error {
+	return e.Err
+}
+
+// ErrInvalidBinary is returned by the DAG-ETH decoders when the input is not the consensus binary encoding of the
+// DAG-ETH type it is decoded as. It wraps the error that describes why, e.g. an RLP error or an ErrUnsupportedTxType.
+type ErrInvalidBinary struct {
+	Type string
+	Err  error
+}
+
+func (e ErrInvalidBinary) Error() string {
+	return fmt.Sprintf("invalid DAG-ETH %s binary (%v)", e.Type, e.Err)
+}
+
+func (e ErrInvalidBinary) Unwrap() error {
+	return e.Err
+}
+
+// ErrInvalidField is returned when a field of a DAG-ETH type is missing or malformed.
+// Field is the path of the field within the type, with list indexes as path segments (e.g. "Logs/2/Topics"), or empty
+// when the type as a whole is malformed.
+type ErrInvalidField struct {
+	Type   string
+	Field  string
+	Reason string
+	Err    error
+}
+
+func (e ErrInvalidField) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s %s", e.Type, e.Reason)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("%s %s %s: %v", e.Type, e.Field, e.Reason, e.Err)
+	}
+	return fmt.Sprintf("%s %s %s", e.Type, e.Field, e.Reason)
+}
+
+func (e ErrInvalidField) Unwrap() error {
+	return e.Err
+}
+
+// ErrUnsupportedTxType is returned when a transaction or receipt has an EIP-2718 type that is not supported
+type ErrUnsupportedTxType struct {
+	TxType uint8
+}
+
+func (e ErrUnsupportedTxType) Error() string {
+	return fmt.Sprintf("unsupported TxType %d", e.TxType)
+}
+
+// ErrHashMismatch is returned when the hash of a block does not match the CID it is held under
+type ErrHashMismatch struct {
+	Expected cid.Cid
+	Actual   cid.Cid
+}
+
+func (e ErrHashMismatch) Error() string {
+	return fmt.Sprintf("block %s does not match its CID (hashes to %s)", e.Expected.String(), e.Actual.String())
+}
+
+// ErrNonCanonical is returned by strict decoding when the input decodes but is not in canonical form: re-encoding
+// the decoded node produces bytes that differ from the input, first at Offset
+type ErrNonCanonical struct {
+	Offset int
+}
+
+func (e ErrNonCanonical) Error() string {
+	return fmt.Sprintf("input is not in canonical form; its re-encoding differs at byte offset %d", e.Offset)
+}
+
+// ErrWrongLinkTarget is returned when a link is not a CID of the multicodec type of the DAG-ETH type it should
+// reference, with a KECCAK_256 multihash
+type ErrWrongLinkTarget struct {
+	Codec uint64
+	Link  cid.Cid
+}
+
+func (e ErrWrongLinkTarget) Error() string {
+	return fmt.Sprintf("link %s is not a %s CID with a KECCAK_256 multihash", e.Link.String(), codecName(e.Codec))
+}
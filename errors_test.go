@@ -0,0 +1,326 @@
+package dageth_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/header"
+	"github.com/vulcanize/go-codec-dageth/rct"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	account "github.com/vulcanize/go-codec-dageth/state_account"
+	"github.com/vulcanize/go-codec-dageth/state_trie"
+	"github.com/vulcanize/go-codec-dageth/trie"
+	"github.com/vulcanize/go-codec-dageth/tx"
+	"github.com/vulcanize/go-codec-dageth/tx_trie"
+	"github.com/vulcanize/go-codec-dageth/uncles"
+)
+
+func TestInvalidFieldErrors(t *testing.T) {
+	headerNode := basicnode.Prototype.Map.NewBuilder()
+	if err := header.DecodeHeader(headerNode, types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(1)}); err != nil {
+		t.Fatalf("unable to decode header: %v", err)
+	}
+	badNonce := replaceField(t, headerNode.Build(), "Nonce", basicnode.NewBytes([]byte{1, 2, 3, 4}))
+	err := header.Encode(badNonce, new(bytes.Buffer))
+	var invalidForm dageth.ErrInvalidForm
+	if !errors.As(err, &invalidForm) || invalidForm.Type != "Header" {
+		t.Errorf("expected a Header ErrInvalidForm; got %v", err)
+	}
+	var invalidField dageth.ErrInvalidField
+	if !errors.As(err, &invalidField) || invalidField.Type != "Header" || invalidField.Field != "Nonce" {
+		t.Errorf("expected a Header Nonce ErrInvalidField; got %v", err)
+	}
+
+	txNode := basicnode.Prototype.Map.NewBuilder()
+	dynamicFeeTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		To:        &common.Address{},
+		Value:     big.NewInt(0),
+	})
+	if err := tx.DecodeTx(txNode, *dynamicFeeTx); err != nil {
+		t.Fatalf("unable to decode transaction: %v", err)
+	}
+	noFeeCap := replaceField(t, txNode.Build(), "GasFeeCap", ipld.Null)
+	err = tx.Encode(noFeeCap, new(bytes.Buffer))
+	if !errors.As(err, &invalidField) || invalidField.Type != "Transaction" || invalidField.Field != "GasFeeCap" {
+		t.Errorf("expected a Transaction GasFeeCap ErrInvalidField; got %v", err)
+	}
+
+	shortTime := replaceField(t, headerNode.Build(), "Time", basicnode.NewBytes([]byte{1}))
+	err = header.Encode(shortTime, new(bytes.Buffer))
+	if !errors.As(err, &invalidField) || invalidField.Type != "Header" || invalidField.Field != "Time" {
+		t.Errorf("expected a Header Time ErrInvalidField; got %v", err)
+	}
+	shortNonce := replaceField(t, txNode.Build(), "AccountNonce", basicnode.NewBytes([]byte{1}))
+	err = tx.Encode(shortNonce, new(bytes.Buffer))
+	if !errors.As(err, &invalidField) || invalidField.Type != "Transaction" || invalidField.Field != "AccountNonce" {
+		t.Errorf("expected a Transaction AccountNonce ErrInvalidField; got %v", err)
+	}
+}
+
+func TestInvalidBinaryErrors(t *testing.T) {
+	unsupported := []byte{0x7e, 0xc0}
+	for name, decode := range map[string]func(in []byte) error{
+		"Transaction": func(in []byte) error {
+			return tx.Decode(basicnode.Prototype.Any.NewBuilder(), bytes.NewReader(in))
+		},
+		"Transaction bytes": func(in []byte) error {
+			return tx.DecodeBytes(basicnode.Prototype.Any.NewBuilder(), in)
+		},
+		"Receipt": func(in []byte) error {
+			return rct.Decode(basicnode.Prototype.Any.NewBuilder(), bytes.NewReader(in))
+		},
+		"Receipt bytes": func(in []byte) error {
+			return rct.DecodeBytes(basicnode.Prototype.Any.NewBuilder(), in)
+		},
+	} {
+		err := decode(unsupported)
+		var unsupportedType dageth.ErrUnsupportedTxType
+		if !errors.As(err, &unsupportedType) || unsupportedType.TxType != 0x7e {
+			t.Errorf("%s: expected ErrUnsupportedTxType; got %v", name, err)
+		}
+		var invalidBinary dageth.ErrInvalidBinary
+		if !errors.As(err, &invalidBinary) {
+			t.Errorf("%s: expected ErrInvalidBinary; got %v", name, err)
+		}
+	}
+
+	err := uncles.DecodeBytes(basicnode.Prototype.Any.NewBuilder(), []byte{0x01})
+	var invalidBinary dageth.ErrInvalidBinary
+	if !errors.As(err, &invalidBinary) || invalidBinary.Type != "Uncles" {
+		t.Errorf("expected an Uncles ErrInvalidBinary; got %v", err)
+	}
+
+	acctRLP, err := rlp.EncodeToBytes(&types.StateAccount{Balance: big.NewInt(1)})
+	if err != nil {
+		t.Fatalf("unable to RLP encode account: %v", err)
+	}
+	err = account.Decode(basicnode.Prototype.Any.NewBuilder(), bytes.NewReader(append(acctRLP, 0x80)))
+	if !errors.As(err, &invalidBinary) || invalidBinary.Type != "Account" || !errors.Is(err, rlp.ErrMoreThanOneValue) {
+		t.Errorf("expected an Account ErrInvalidBinary wrapping rlp.ErrMoreThanOneValue; got %v", err)
+	}
+}
+
+func TestTypePrefixErrors(t *testing.T) {
+	// a byte string is neither a legacy RLP list nor led by a type byte
+	noTypeByte := []byte{0x82, 0x01, 0x02}
+	for name, decode := range map[string]func(in io.Reader) error{
+		"Transaction": func(in io.Reader) error {
+			return tx.Decode(basicnode.Prototype.Any.NewBuilder(), in)
+		},
+		"Receipt": func(in io.Reader) error {
+			return rct.Decode(basicnode.Prototype.Any.NewBuilder(), in)
+		},
+	} {
+		err := decode(struct{ io.Reader }{bytes.NewReader(noTypeByte)})
+		var invalidField dageth.ErrInvalidField
+		if !errors.As(err, &invalidField) || invalidField.Type != name {
+			t.Errorf("%s: expected an ErrInvalidField for the missing type byte; got %v", name, err)
+		}
+		var invalidBinary dageth.ErrInvalidBinary
+		if !errors.As(err, &invalidBinary) {
+			t.Errorf("%s: expected ErrInvalidBinary; got %v", name, err)
+		}
+	}
+}
+
+func TestTrieNodeErrors(t *testing.T) {
+	leafRLP, err := rlp.EncodeToBytes([]interface{}{shared.HexToCompact([]byte{1, 16}), []byte{1}})
+	if err != nil {
+		t.Fatalf("unable to RLP encode leaf: %v", err)
+	}
+	err = trie.DecodeTrieNodeBytes(basicnode.Prototype.Any.NewBuilder(), leafRLP, cid.EthBlock)
+	var unsupported trie.ErrUnsupportedCodec
+	var invalidBinary dageth.ErrInvalidBinary
+	if !errors.As(err, &unsupported) || unsupported.Codec != cid.EthBlock || !errors.As(err, &invalidBinary) {
+		t.Errorf("expected an ErrInvalidBinary wrapping ErrUnsupportedCodec for a header codec; got %v", err)
+	}
+
+	leaf := dageth.Type.TrieNode.NewBuilder()
+	if err := trie.DecodeTrieNodeBytes(leaf, leafRLP, cid.EthStorageTrie); err != nil {
+		t.Fatalf("unable to decode leaf: %v", err)
+	}
+	err = trie.EncodeTrieNode(leaf.Build(), new(bytes.Buffer), cid.EthBlock)
+	var invalidForm dageth.ErrInvalidForm
+	if !errors.As(err, &unsupported) || unsupported.Codec != cid.EthBlock || !errors.As(err, &invalidForm) {
+		t.Errorf("expected an ErrInvalidForm wrapping ErrUnsupportedCodec for a header codec; got %v", err)
+	}
+
+	// a branch child linking to a header is not a trie node
+	headerCID := shared.Keccak256ToCid(cid.EthBlock, crypto.Keccak256([]byte{1}))
+	branch := branchWithLinks(t, map[int]cid.Cid{1: headerCID})
+	err = trie.Encode(branch, new(bytes.Buffer))
+	var invalidField dageth.ErrInvalidField
+	if !errors.As(err, &invalidField) || invalidField.Field != trie.BRANCH_NODE.String()+"/Child1" || !errors.As(err, &invalidForm) {
+		t.Errorf("expected a TrieNode ErrInvalidForm and ErrInvalidField for a branch child linking to a header; got %v", err)
+	}
+}
+
+func TestWrongLinkTargetErrors(t *testing.T) {
+	headerNode := basicnode.Prototype.Map.NewBuilder()
+	if err := header.DecodeHeader(headerNode, types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(1)}); err != nil {
+		t.Fatalf("unable to decode header: %v", err)
+	}
+	txCID := shared.Keccak256ToCid(cid.EthTx, crypto.Keccak256([]byte{1}))
+	txParent := replaceField(t, headerNode.Build(), "ParentCID", basicnode.NewLink(cidlink.Link{Cid: txCID}))
+	err := header.Encode(txParent, new(bytes.Buffer))
+	var wrongTarget dageth.ErrWrongLinkTarget
+	if !errors.As(err, &wrongTarget) || wrongTarget.Codec != cid.EthBlock || wrongTarget.Link != txCID {
+		t.Errorf("expected ErrWrongLinkTarget for a header ParentCID linking to a transaction; got %v", err)
+	}
+
+	acctNode := basicnode.Prototype.Map.NewBuilder()
+	if err := account.DecodeAccount(acctNode, types.StateAccount{Balance: big.NewInt(1), CodeHash: types.EmptyCodeHash.Bytes()}); err != nil {
+		t.Fatalf("unable to decode account: %v", err)
+	}
+	sha2Root, err := cid.Prefix{Version: 1, Codec: cid.EthStorageTrie, MhType: multihash.SHA2_256, MhLength: -1}.Sum([]byte{0x80})
+	if err != nil {
+		t.Fatalf("unable to make CID: %v", err)
+	}
+	sha2Acct := replaceField(t, acctNode.Build(), "StorageRootCID", basicnode.NewLink(cidlink.Link{Cid: sha2Root}))
+	err = account.Encode(sha2Acct, new(bytes.Buffer))
+	var invalidField dageth.ErrInvalidField
+	if !errors.As(err, &wrongTarget) || !errors.As(err, &invalidField) || invalidField.Field != "StorageRootCID" {
+		t.Errorf("expected ErrWrongLinkTarget for an account StorageRootCID with a sha2 multihash; got %v", err)
+	}
+
+	// a branch of the state trie can not be encoded as a node of the tx trie
+	branch := make([]interface{}, 17)
+	for i := range branch {
+		branch[i] = []byte{}
+	}
+	branch[1] = crypto.Keccak256([]byte{1})
+	branchRLP, err := rlp.EncodeToBytes(branch)
+	if err != nil {
+		t.Fatalf("unable to RLP encode branch: %v", err)
+	}
+	stateBranch := dageth.Type.TrieNode.NewBuilder()
+	if err := trie.DecodeTrieNodeBytes(stateBranch, branchRLP, cid.EthStateTrie); err != nil {
+		t.Fatalf("unable to decode branch: %v", err)
+	}
+	if err := state_trie.Encode(stateBranch.Build(), new(bytes.Buffer)); err != nil {
+		t.Errorf("unable to encode state trie branch: %v", err)
+	}
+	if err := trie.Encode(stateBranch.Build(), new(bytes.Buffer)); err != nil {
+		t.Errorf("unable to encode state trie branch without a trie type: %v", err)
+	}
+	err = tx_trie.Encode(stateBranch.Build(), new(bytes.Buffer))
+	if !errors.As(err, &wrongTarget) || wrongTarget.Codec != cid.EthTxTrie {
+		t.Errorf("expected ErrWrongLinkTarget for a tx trie branch linking to a state trie node; got %v", err)
+	}
+
+	// without a trie type, the links of a branch must all be to nodes of the same trie
+	stateCID := shared.Keccak256ToCid(cid.EthStateTrie, crypto.Keccak256([]byte{1}))
+	storageCID := shared.Keccak256ToCid(cid.EthStorageTrie, crypto.Keccak256([]byte{2}))
+	mixedBranch := branchWithLinks(t, map[int]cid.Cid{1: stateCID, 2: storageCID})
+	err = trie.Encode(mixedBranch, new(bytes.Buffer))
+	if !errors.As(err, &wrongTarget) || wrongTarget.Codec != cid.EthStateTrie || wrongTarget.Link != storageCID {
+		t.Errorf("expected ErrWrongLinkTarget for a branch linking to both state and storage trie nodes; got %v", err)
+	}
+	if err := trie.Encode(branchWithLinks(t, map[int]cid.Cid{1: stateCID, 2: stateCID}), new(bytes.Buffer)); err != nil {
+		t.Errorf("unable to encode branch linking to state trie nodes without a trie type: %v", err)
+	}
+}
+
+// branchWithLinks returns a typed branch node with the children at the provided nibbles linking to the CIDs
+func branchWithLinks(t *testing.T, links map[int]cid.Cid) ipld.Node {
+	branch := dageth.Type.TrieNode.NewBuilder()
+	ma, err := branch.BeginMap(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ma.AssembleKey().AssignString(trie.BRANCH_NODE.String()); err != nil {
+		t.Fatal(err)
+	}
+	fields, err := ma.AssembleValue().BeginMap(17)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 16; i++ {
+		if err := fields.AssembleKey().AssignString(trie.BranchChildKey(i)); err != nil {
+			t.Fatal(err)
+		}
+		link, ok := links[i]
+		if !ok {
+			if err := fields.AssembleValue().AssignNull(); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		child, err := fields.AssembleValue().BeginMap(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := child.AssembleKey().AssignString("Link"); err != nil {
+			t.Fatal(err)
+		}
+		if err := child.AssembleValue().AssignLink(cidlink.Link{Cid: link}); err != nil {
+			t.Fatal(err)
+		}
+		if err := child.Finish(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fields.AssembleKey().AssignString("Value"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fields.AssembleValue().AssignNull(); err != nil {
+		t.Fatal(err)
+	}
+	if err := fields.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ma.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	return branch.Build()
+}
+
+// replaceField returns a copy of the map node with the value of the field replaced
+func replaceField(t *testing.T, node ipld.Node, field string, val ipld.Node) ipld.Node {
+	builder := basicnode.Prototype.Map.NewBuilder()
+	ma, err := builder.BeginMap(node.Length())
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := node.MapIterator()
+	for !it.Done() {
+		k, v, err := it.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := k.AsString()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key == field {
+			v = val
+		}
+		if err := ma.AssembleKey().AssignString(key); err != nil {
+			t.Fatal(err)
+		}
+		if err := ma.AssembleValue().AssignNode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ma.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	return builder.Build()
+}
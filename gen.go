@@ -37,12 +37,19 @@ func main() {
 		os.Exit(1)
 	}
 	// generate the code
-	adjCfg := &gengo.AdjunctCfg{}
+	adjCfg := &gengo.AdjunctCfg{
+		// TrieNode, TrieBranchNode, TrieExtensionNode and Child are mutually recursive,
+		// so Child needs to box its members to keep the generated types finite
+		CfgUnionMemlayout: map[schema.TypeName]string{
+			"Child": "interface",
+		},
+	}
 	gengo.Generate(".", pkgName, *ts, adjCfg)
 }
 
 func accumulateBasicTypes(ts *schema.TypeSystem) {
-	// we could more explicitly type our links with SpawnLinkReference
+	// we could more explicitly type our links with SpawnLinkReference, but that would not constrain the multicodec
+	// type of the CIDs, and TrieNode is shared by every trie, so the encoders check link targets with LinkDigest
 	ts.Accumulate(schema.SpawnLink("Link"))
 	ts.Accumulate(schema.SpawnBytes("Bytes"))
 	ts.Accumulate(schema.SpawnString("String"))
@@ -198,7 +205,7 @@ func accumulateChainTypes(ts *schema.TypeSystem) {
 			CumulativeGasUsed Uint
 			Bloom             Bloom
 			Logs 			  Logs
-			LogRootCID        &TrieNode
+			LogRootCID        optional &TrieNode
 		}
 
 		type Receipts [Receipt]
@@ -211,7 +218,7 @@ func accumulateChainTypes(ts *schema.TypeSystem) {
 			schema.SpawnStructField("CumulativeGasUsed", "Uint", false, false),
 			schema.SpawnStructField("Bloom", "Bloom", false, false),
 			schema.SpawnStructField("Logs", "Logs", false, false),
-			schema.SpawnStructField("LogRootCID", "Link", false, false),
+			schema.SpawnStructField("LogRootCID", "Link", true, false),
 		},
 		schema.SpawnStructRepresentationMap(nil),
 	))
@@ -268,7 +275,7 @@ func accumulateStateDataStructures(ts *schema.TypeSystem) {
 
 		type TrieExtensionNode struct {
 			PartialPath Bytes
-			Child &TrieNode
+			Child Child
 		}
 
 		type TrieLeafNode struct {
@@ -327,7 +334,7 @@ func accumulateStateDataStructures(ts *schema.TypeSystem) {
 	ts.Accumulate(schema.SpawnStruct("TrieExtensionNode",
 		[]schema.StructField{
 			schema.SpawnStructField("PartialPath", "Bytes", false, false),
-			schema.SpawnStructField("Child", "Link", false, false),
+			schema.SpawnStructField("Child", "Child", false, false),
 		},
 		schema.SpawnStructRepresentationMap(nil),
 	))
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 
 	dageth "github.com/vulcanize/go-codec-dageth"
 	"github.com/vulcanize/go-codec-dageth/header"
+	"github.com/vulcanize/go-codec-dageth/shared/fuzztest"
 )
 
 var (
@@ -330,3 +332,26 @@ func loadBlockFromRLPFile(filename string) (*types.Block, []byte, error) {
 	block := new(types.Block)
 	return block, blockRLP, rlp.DecodeBytes(blockRLP, block)
 }
+
+func FuzzDecodeBytes(f *testing.F) {
+	block, _, err := loadBlockFromRLPFile("./block1_rlp")
+	if err != nil {
+		f.Fatal(err)
+	}
+	londonHeader := types.CopyHeader(block.Header())
+	londonHeader.BaseFee = big.NewInt(1000000000)
+	for _, h := range []*types.Header{block.Header(), londonHeader} {
+		enc, err := rlp.EncodeToBytes(h)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(enc)
+	}
+	codec, ok := dageth.LookupCodec(header.MultiCodecType)
+	if !ok {
+		f.Fatal("codec is not in the dageth.Codecs table")
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzztest.FuzzRoundTrip(t, codec, data)
+	})
+}
@@ -1,7 +1,6 @@
 package header
 
 import (
-	"encoding/binary"
 	"fmt"
 	"io"
 	"math/big"
@@ -9,9 +8,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
-	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
-	"github.com/multiformats/go-multihash"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
 	"github.com/vulcanize/go-codec-dageth/shared"
@@ -43,28 +41,31 @@ func AppendEncode(enc []byte, inNode ipld.Node) ([]byte, error) {
 	}
 	wbs := shared.NewWriteableByteSlice(&enc)
 	if err := rlp.Encode(wbs, header); err != nil {
-		return enc, fmt.Errorf("invalid DAG-ETH Header form (unable to RLP encode header: %v)", err)
+		return enc, dageth.ErrInvalidForm{Type: "Header", Err: err}
 	}
 	return enc, nil
 }
 
 // EncodeHeader packs the node into the provided go-ethereum Header
 func EncodeHeader(header *types.Header, inNode ipld.Node) error {
-	// Wrap in a typed node for some basic schema form checking
-	builder := dageth.Type.Header.NewBuilder()
-	if err := builder.AssignNode(inNode); err != nil {
-		return err
+	// Wrap in a typed node for some basic schema form checking, unless it is one already
+	node, ok := inNode.(dageth.Header)
+	if !ok {
+		builder := dageth.Type.Header.NewBuilder()
+		if err := builder.AssignNode(inNode); err != nil {
+			return dageth.ErrInvalidForm{Type: "Header", Err: err}
+		}
+		node = builder.Build().(dageth.Header)
 	}
-	node := builder.Build()
 	for _, pFunc := range requiredPackFuncs {
 		if err := pFunc(header, node); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Header form (%v)", err)
+			return dageth.ErrInvalidForm{Type: "Header", Err: err}
 		}
 	}
 	return nil
 }
 
-var requiredPackFuncs = []func(*types.Header, ipld.Node) error{
+var requiredPackFuncs = []func(*types.Header, dageth.Header) error{
 	packParentCID,
 	packUnclesCID,
 	packCoinbase,
@@ -83,265 +84,136 @@ var requiredPackFuncs = []func(*types.Header, ipld.Node) error{
 	packBaseFee,
 }
 
-func packNonce(header *types.Header, node ipld.Node) error {
-	n, err := node.LookupByString("Nonce")
-	if err != nil {
-		return err
-	}
-	nBytes, err := n.AsBytes()
-	if err != nil {
-		return err
-	}
+func packNonce(header *types.Header, node dageth.Header) error {
+	nBytes := node.FieldNonce().Bytes()
 	if len(nBytes) != len(types.BlockNonce{}) {
-		return fmt.Errorf("header must have a %d byte Nonce", len(types.BlockNonce{}))
+		return dageth.ErrInvalidField{Type: "Header", Field: "Nonce", Reason: fmt.Sprintf("must be %d bytes", len(types.BlockNonce{}))}
 	}
 	copy(header.Nonce[:], nBytes)
 	return nil
 }
 
-func packMixDigest(header *types.Header, node ipld.Node) error {
-	md, err := node.LookupByString("MixDigest")
-	if err != nil {
-		return err
-	}
-	mdBytes, err := md.AsBytes()
-	if err != nil {
-		return err
-	}
-	header.MixDigest = common.BytesToHash(mdBytes)
+func packMixDigest(header *types.Header, node dageth.Header) error {
+	header.MixDigest = common.BytesToHash(node.FieldMixDigest().Bytes())
 	return nil
 }
 
-func packExtra(header *types.Header, node ipld.Node) error {
-	e, err := node.LookupByString("Extra")
-	if err != nil {
-		return err
-	}
-	extra, err := e.AsBytes()
-	if err != nil {
-		return err
-	}
-	header.Extra = extra
+func packExtra(header *types.Header, node dageth.Header) error {
+	header.Extra = node.FieldExtra().Bytes()
 	return nil
 }
 
-func packTime(header *types.Header, node ipld.Node) error {
-	t, err := node.LookupByString("Time")
-	if err != nil {
-		return err
-	}
-	tBytes, err := t.AsBytes()
+func packTime(header *types.Header, node dageth.Header) error {
+	v, err := shared.Uint64FromBytes(node.FieldTime().Bytes())
 	if err != nil {
-		return err
+		return dageth.ErrInvalidField{Type: "Header", Field: "Time", Reason: "must be 8 bytes"}
 	}
-	header.Time = binary.BigEndian.Uint64(tBytes)
+	header.Time = v
 	return nil
 }
 
-func packGasUsed(header *types.Header, node ipld.Node) error {
-	gu, err := node.LookupByString("GasUsed")
+func packGasUsed(header *types.Header, node dageth.Header) error {
+	v, err := shared.Uint64FromBytes(node.FieldGasUsed().Bytes())
 	if err != nil {
-		return err
-	}
-	guBytes, err := gu.AsBytes()
-	if err != nil {
-		return err
+		return dageth.ErrInvalidField{Type: "Header", Field: "GasUsed", Reason: "must be 8 bytes"}
 	}
-	header.GasUsed = binary.BigEndian.Uint64(guBytes)
+	header.GasUsed = v
 	return nil
 }
 
-func packGasLimit(header *types.Header, node ipld.Node) error {
-	gl, err := node.LookupByString("GasLimit")
+func packGasLimit(header *types.Header, node dageth.Header) error {
+	v, err := shared.Uint64FromBytes(node.FieldGasLimit().Bytes())
 	if err != nil {
-		return err
+		return dageth.ErrInvalidField{Type: "Header", Field: "GasLimit", Reason: "must be 8 bytes"}
 	}
-	glBytes, err := gl.AsBytes()
-	if err != nil {
-		return err
-	}
-	header.GasLimit = binary.BigEndian.Uint64(glBytes)
+	header.GasLimit = v
 	return nil
 }
 
-func packNumber(header *types.Header, node ipld.Node) error {
-	num, err := node.LookupByString("Number")
-	if err != nil {
-		return err
-	}
-	numBytes, err := num.AsBytes()
-	if err != nil {
-		return err
-	}
-	header.Number = new(big.Int).SetBytes(numBytes)
+func packNumber(header *types.Header, node dageth.Header) error {
+	header.Number = new(big.Int).SetBytes(node.FieldNumber().Bytes())
 	return nil
 }
 
-func packDifficulty(header *types.Header, node ipld.Node) error {
-	diff, err := node.LookupByString("Difficulty")
-	if err != nil {
-		return err
-	}
-	diffBytes, err := diff.AsBytes()
-	if err != nil {
-		return err
-	}
-	header.Difficulty = new(big.Int).SetBytes(diffBytes)
+func packDifficulty(header *types.Header, node dageth.Header) error {
+	header.Difficulty = new(big.Int).SetBytes(node.FieldDifficulty().Bytes())
 	return nil
 }
 
-func packBloom(header *types.Header, node ipld.Node) error {
-	blm, err := node.LookupByString("Bloom")
-	if err != nil {
-		return err
-	}
-	blmBytes, err := blm.AsBytes()
-	if err != nil {
-		return err
-	}
+func packBloom(header *types.Header, node dageth.Header) error {
+	blmBytes := node.FieldBloom().Bytes()
 	// prevent any chance of BytesToBloom panicing on wrong bytes length
 	if len(blmBytes) != types.BloomByteLength {
-		return fmt.Errorf("header must have a 256 byte Bloom")
+		return dageth.ErrInvalidField{Type: "Header", Field: "Bloom", Reason: fmt.Sprintf("must be %d bytes", types.BloomByteLength)}
 	}
 	header.Bloom = types.BytesToBloom(blmBytes)
 	return nil
 }
 
-func packRctRootCID(header *types.Header, node ipld.Node) error {
-	rctCID, err := node.LookupByString("RctRootCID")
-	if err != nil {
-		return err
-	}
-	rctLink, err := rctCID.AsLink()
+func packRctRootCID(header *types.Header, node dageth.Header) error {
+	rctRoot, err := linkDigest("RctRootCID", node.FieldRctRootCID(), cid.EthTxReceiptTrie)
 	if err != nil {
 		return err
 	}
-	rctCIDLink, ok := rctLink.(cidlink.Link)
-	if !ok {
-		return fmt.Errorf("header must have a RctRootCID")
-	}
-	rctMh := rctCIDLink.Hash()
-	decodedRctMh, err := multihash.Decode(rctMh)
-	if err != nil {
-		return fmt.Errorf("unable to decode RctRootCID multihash: %v", err)
-	}
-	header.ReceiptHash = common.BytesToHash(decodedRctMh.Digest)
+	header.ReceiptHash = rctRoot
 	return nil
 }
 
-func packTxRootCID(header *types.Header, node ipld.Node) error {
-	txCID, err := node.LookupByString("TxRootCID")
-	if err != nil {
-		return err
-	}
-	txLink, err := txCID.AsLink()
+func packTxRootCID(header *types.Header, node dageth.Header) error {
+	txRoot, err := linkDigest("TxRootCID", node.FieldTxRootCID(), cid.EthTxTrie)
 	if err != nil {
 		return err
 	}
-	txCIDLink, ok := txLink.(cidlink.Link)
-	if !ok {
-		return fmt.Errorf("header must have a TxRootCID")
-	}
-	txMh := txCIDLink.Hash()
-	decodedTxMh, err := multihash.Decode(txMh)
-	if err != nil {
-		return fmt.Errorf("unable to decode TxRootCID multihash: %v", err)
-	}
-	header.TxHash = common.BytesToHash(decodedTxMh.Digest)
+	header.TxHash = txRoot
 	return nil
 }
 
-func packStateRootCID(header *types.Header, node ipld.Node) error {
-	srCID, err := node.LookupByString("StateRootCID")
-	if err != nil {
-		return err
-	}
-	srLink, err := srCID.AsLink()
+func packStateRootCID(header *types.Header, node dageth.Header) error {
+	stateRoot, err := linkDigest("StateRootCID", node.FieldStateRootCID(), cid.EthStateTrie)
 	if err != nil {
 		return err
 	}
-	srCIDLink, ok := srLink.(cidlink.Link)
-	if !ok {
-		return fmt.Errorf("header must have a StateRootCID")
-	}
-	srMh := srCIDLink.Hash()
-	decodedSrMh, err := multihash.Decode(srMh)
-	if err != nil {
-		return fmt.Errorf("unable to decode StateRootCID multihash: %v", err)
-	}
-	header.Root = common.BytesToHash(decodedSrMh.Digest)
+	header.Root = stateRoot
 	return nil
 }
 
-func packCoinbase(header *types.Header, node ipld.Node) error {
-	coinbase, err := node.LookupByString("Coinbase")
-	if err != nil {
-		return err
-	}
-	coinbaseBytes, err := coinbase.AsBytes()
-	if err != nil {
-		return err
-	}
-	header.Coinbase = common.BytesToAddress(coinbaseBytes)
+func packCoinbase(header *types.Header, node dageth.Header) error {
+	header.Coinbase = common.BytesToAddress(node.FieldCoinbase().Bytes())
 	return nil
 }
 
-func packUnclesCID(header *types.Header, node ipld.Node) error {
-	uncleCID, err := node.LookupByString("UnclesCID")
+func packUnclesCID(header *types.Header, node dageth.Header) error {
+	unclesHash, err := linkDigest("UnclesCID", node.FieldUnclesCID(), cid.EthBlockList)
 	if err != nil {
 		return err
 	}
-	unclesLink, err := uncleCID.AsLink()
-	if err != nil {
-		return err
-	}
-	unclesCIDLink, ok := unclesLink.(cidlink.Link)
-	if !ok {
-		return fmt.Errorf("header must have an UnclesCID")
-	}
-	unclesMh := unclesCIDLink.Hash()
-	decodedUnclesMh, err := multihash.Decode(unclesMh)
-	if err != nil {
-		return fmt.Errorf("unable to decode UnclesCID multihash: %v", err)
-	}
-	header.UncleHash = common.BytesToHash(decodedUnclesMh.Digest)
+	header.UncleHash = unclesHash
 	return nil
 }
 
-func packParentCID(header *types.Header, node ipld.Node) error {
-	parentCID, err := node.LookupByString("ParentCID")
-	if err != nil {
-		return err
-	}
-	parentLink, err := parentCID.AsLink()
+func packParentCID(header *types.Header, node dageth.Header) error {
+	parentHash, err := linkDigest("ParentCID", node.FieldParentCID(), cid.EthBlock)
 	if err != nil {
 		return err
 	}
-	parentCIDLink, ok := parentLink.(cidlink.Link)
-	if !ok {
-		return fmt.Errorf("header must have a ParentCID")
-	}
-	parentMh := parentCIDLink.Hash()
-	decodedParentMh, err := multihash.Decode(parentMh)
-	if err != nil {
-		return fmt.Errorf("unable to decode ParentCID multihash: %v", err)
-	}
-	header.ParentHash = common.BytesToHash(decodedParentMh.Digest)
+	header.ParentHash = parentHash
 	return nil
 }
 
-func packBaseFee(header *types.Header, node ipld.Node) error {
-	baseFeeNode, err := node.LookupByString("BaseFee")
-	if err != nil {
-		return err
-	}
-	if baseFeeNode.IsNull() {
+func packBaseFee(header *types.Header, node dageth.Header) error {
+	baseFee := node.FieldBaseFee()
+	if !baseFee.Exists() {
 		return nil
 	}
-	baseFeeBytes, err := baseFeeNode.AsBytes()
+	header.BaseFee = new(big.Int).SetBytes(baseFee.Must().Bytes())
+	return nil
+}
+
+// linkDigest returns the hash referenced by the CID link of the header field, which must be a CID of the codec
+func linkDigest(field string, lnk dageth.Link, codec uint64) (common.Hash, error) {
+	digest, err := dageth.LinkDigest(lnk.Link(), codec)
 	if err != nil {
-		return err
+		return common.Hash{}, dageth.ErrInvalidField{Type: "Header", Field: field, Reason: "is not a valid link", Err: err}
 	}
-	header.BaseFee = new(big.Int).SetBytes(baseFeeBytes)
-	return nil
+	return common.BytesToHash(digest), nil
 }
@@ -1,56 +1,113 @@
 package header
 
 import (
+	"bytes"
 	"encoding/binary"
-	"fmt"
 	"io"
-	"io/ioutil"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
 )
 
 // Decode provides an IPLD codec decode interface for eth header IPLDs.
 // This function is registered via the go-ipld-prime link loader for multicodec
 // code 0x90 when this package is invoked via init.
 func Decode(na ipld.NodeAssembler, in io.Reader) error {
-	var src []byte
 	if buf, ok := in.(interface{ Bytes() []byte }); ok {
-		src = buf.Bytes()
-	} else {
-		var err error
-		src, err = ioutil.ReadAll(in)
-		if err != nil {
-			return err
-		}
+		return DecodeBytes(na, buf.Bytes())
+	}
+	trailing, err := shared.StreamDecode(in, func(s *rlp.Stream) error {
+		return decodeHeaderStream(na, s)
+	})
+	if err != nil {
+		return dageth.ErrInvalidBinary{Type: "Header", Err: err}
+	}
+	if trailing > 0 {
+		return dageth.ErrInvalidBinary{Type: "Header", Err: rlp.ErrMoreThanOneValue}
 	}
-	return DecodeBytes(na, src)
+	return nil
 }
 
 // DecodeBytes is like Decode, but it uses an input buffer directly.
-// Decode will grab or read all the bytes from an io.Reader anyway, so this can
-// save having to copy the bytes or create a bytes.Buffer.
+// Decode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
 func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
-	var header types.Header
-	if err := rlp.DecodeBytes(src, &header); err != nil {
+	return Decode(na, bytes.NewReader(src))
+}
+
+// decodeHeaderStream reads the header fields from the stream one by one, assembling each as it is read.
+// Headers holding fields from forks after London, which the DAG-ETH Header can not represent, are rejected.
+func decodeHeaderStream(na ipld.NodeAssembler, s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	ma, err := na.BeginMap(16)
+	if err != nil {
 		return err
 	}
-	return DecodeHeader(na, header)
+	if field, err := shared.UnpackStreamFields(ma, s, streamFields); err != nil {
+		return dageth.ErrInvalidField{Type: "Header", Field: field, Reason: "is malformed", Err: err}
+	}
+	// the BaseFee was added in London, and is null for headers from before it
+	baseFee := shared.StreamField{Name: "BaseFee", Unpack: shared.StreamNull}
+	if s.MoreDataInList() {
+		baseFee.Unpack = shared.StreamBigInt
+	}
+	if field, err := shared.UnpackStreamFields(ma, s, []shared.StreamField{baseFee}); err != nil {
+		return dageth.ErrInvalidField{Type: "Header", Field: field, Reason: "is malformed", Err: err}
+	}
+	if s.MoreDataInList() {
+		return dageth.ErrInvalidField{Type: "Header", Field: "WithdrawalsHash", Reason: "is not supported"}
+	}
+	if err := s.ListEnd(); err != nil {
+		return err
+	}
+	return ma.Finish()
+}
+
+// streamFields are the fields of the header up to London, in the order of its RLP encoding
+var streamFields = []shared.StreamField{
+	{Name: "ParentCID", Unpack: shared.StreamLink(cid.EthBlock)},
+	{Name: "UnclesCID", Unpack: shared.StreamLink(cid.EthBlockList)},
+	{Name: "Coinbase", Unpack: shared.StreamBytes(common.AddressLength)},
+	{Name: "StateRootCID", Unpack: shared.StreamLink(cid.EthStateTrie)},
+	{Name: "TxRootCID", Unpack: shared.StreamLink(cid.EthTxTrie)},
+	{Name: "RctRootCID", Unpack: shared.StreamLink(cid.EthTxReceiptTrie)},
+	{Name: "Bloom", Unpack: shared.StreamBytes(types.BloomByteLength)},
+	{Name: "Difficulty", Unpack: shared.StreamBigInt},
+	{Name: "Number", Unpack: shared.StreamBigInt},
+	{Name: "GasLimit", Unpack: shared.StreamUint},
+	{Name: "GasUsed", Unpack: shared.StreamUint},
+	{Name: "Time", Unpack: shared.StreamUint},
+	{Name: "Extra", Unpack: shared.StreamBytes(-1)},
+	{Name: "MixDigest", Unpack: shared.StreamBytes(common.HashLength)},
+	{Name: "Nonce", Unpack: shared.StreamBytes(8)},
 }
 
 // DecodeHeader unpacks a go-ethereum Header into a NodeAssembler
+// Headers holding fields from forks after London, which the DAG-ETH Header can not represent, are rejected.
 func DecodeHeader(na ipld.NodeAssembler, header types.Header) error {
+	if header.WithdrawalsHash != nil {
+		return dageth.ErrInvalidBinary{Type: "Header", Err: dageth.ErrInvalidField{Type: "Header", Field: "WithdrawalsHash", Reason: "is not supported"}}
+	}
+	if header.ExcessDataGas != nil {
+		return dageth.ErrInvalidBinary{Type: "Header", Err: dageth.ErrInvalidField{Type: "Header", Field: "ExcessDataGas", Reason: "is not supported"}}
+	}
 	ma, err := na.BeginMap(15)
 	if err != nil {
 		return err
 	}
 	for _, upFunc := range requiredUnpackFuncs {
 		if err := upFunc(ma, header); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Header binary (%v)", err)
+			return dageth.ErrInvalidBinary{Type: "Header", Err: err}
 		}
 	}
 	return ma.Finish()
@@ -131,7 +188,7 @@ func unpackGasLimit(ma ipld.MapAssembler, header types.Header) error {
 
 func unpackNumber(ma ipld.MapAssembler, header types.Header) error {
 	if header.Number == nil {
-		return fmt.Errorf("header cannot have `nil` Number")
+		return dageth.ErrInvalidField{Type: "Header", Field: "Number", Reason: "cannot be nil"}
 	}
 	if err := ma.AssembleKey().AssignString("Number"); err != nil {
 		return err
@@ -141,7 +198,7 @@ func unpackNumber(ma ipld.MapAssembler, header types.Header) error {
 
 func unpackDifficulty(ma ipld.MapAssembler, header types.Header) error {
 	if header.Difficulty == nil {
-		return fmt.Errorf("header cannot have `nil` Difficulty")
+		return dageth.ErrInvalidField{Type: "Header", Field: "Difficulty", Reason: "cannot be nil"}
 	}
 	if err := ma.AssembleKey().AssignString("Difficulty"); err != nil {
 		return err
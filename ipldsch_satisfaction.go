@@ -3,7 +3,7 @@ package dageth
 // Code generated by go-ipld-prime gengo.  DO NOT EDIT.
 
 import (
-	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/datamodel"
 	"github.com/ipld/go-ipld-prime/node/mixins"
 	"github.com/ipld/go-ipld-prime/schema"
 )
@@ -30,12 +30,12 @@ func (m MaybeAccessElement) IsAbsent() bool {
 func (m MaybeAccessElement) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeAccessElement) AsNode() ipld.Node {
+func (m MaybeAccessElement) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -53,36 +53,36 @@ var (
 	fieldName__AccessElement_Address     = _String{"Address"}
 	fieldName__AccessElement_StorageKeys = _String{"StorageKeys"}
 )
-var _ ipld.Node = (AccessElement)(&_AccessElement{})
+var _ datamodel.Node = (AccessElement)(&_AccessElement{})
 var _ schema.TypedNode = (AccessElement)(&_AccessElement{})
 
-func (AccessElement) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (AccessElement) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n AccessElement) LookupByString(key string) (ipld.Node, error) {
+func (n AccessElement) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Address":
 		return &n.Address, nil
 	case "StorageKeys":
 		return &n.StorageKeys, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n AccessElement) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n AccessElement) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (AccessElement) LookupByIndex(idx int64) (ipld.Node, error) {
+func (AccessElement) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.AccessElement"}.LookupByIndex(0)
 }
-func (n AccessElement) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n AccessElement) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n AccessElement) MapIterator() ipld.MapIterator {
+func (n AccessElement) MapIterator() datamodel.MapIterator {
 	return &_AccessElement__MapItr{n, 0}
 }
 
@@ -91,9 +91,9 @@ type _AccessElement__MapItr struct {
 	idx int
 }
 
-func (itr *_AccessElement__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_AccessElement__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 2 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -112,7 +112,7 @@ func (itr *_AccessElement__MapItr) Done() bool {
 	return itr.idx >= 2
 }
 
-func (AccessElement) ListIterator() ipld.ListIterator {
+func (AccessElement) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (AccessElement) Length() int64 {
@@ -139,16 +139,16 @@ func (AccessElement) AsString() (string, error) {
 func (AccessElement) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.AccessElement"}.AsBytes()
 }
-func (AccessElement) AsLink() (ipld.Link, error) {
+func (AccessElement) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.AccessElement"}.AsLink()
 }
-func (AccessElement) Prototype() ipld.NodePrototype {
+func (AccessElement) Prototype() datamodel.NodePrototype {
 	return _AccessElement__Prototype{}
 }
 
 type _AccessElement__Prototype struct{}
 
-func (_AccessElement__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_AccessElement__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _AccessElement__Builder
 	nb.Reset()
 	return &nb
@@ -158,7 +158,7 @@ type _AccessElement__Builder struct {
 	_AccessElement__Assembler
 }
 
-func (nb *_AccessElement__Builder) Build() ipld.Node {
+func (nb *_AccessElement__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -195,7 +195,7 @@ var (
 	fieldBits__AccessElement_sufficient = 0 + 1<<0 + 1<<1
 )
 
-func (na *_AccessElement__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_AccessElement__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -208,7 +208,7 @@ func (na *_AccessElement__Assembler) BeginMap(int64) (ipld.MapAssembler, error)
 	}
 	return na, nil
 }
-func (_AccessElement__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_AccessElement__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.AccessElement"}.BeginList(0)
 }
 func (na *_AccessElement__Assembler) AssignNull() error {
@@ -240,10 +240,10 @@ func (_AccessElement__Assembler) AssignString(string) error {
 func (_AccessElement__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.AccessElement"}.AssignBytes(nil)
 }
-func (_AccessElement__Assembler) AssignLink(ipld.Link) error {
+func (_AccessElement__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.AccessElement"}.AssignLink(nil)
 }
-func (na *_AccessElement__Assembler) AssignNode(v ipld.Node) error {
+func (na *_AccessElement__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -263,8 +263,8 @@ func (na *_AccessElement__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.AccessElement", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.AccessElement", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -281,7 +281,7 @@ func (na *_AccessElement__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_AccessElement__Assembler) Prototype() ipld.NodePrototype {
+func (_AccessElement__Assembler) Prototype() datamodel.NodePrototype {
 	return _AccessElement__Prototype{}
 }
 func (ma *_AccessElement__Assembler) valueFinishTidy() bool {
@@ -310,7 +310,7 @@ func (ma *_AccessElement__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_AccessElement__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_AccessElement__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -328,7 +328,7 @@ func (ma *_AccessElement__Assembler) AssembleEntry(k string) (ipld.NodeAssembler
 	switch k {
 	case "Address":
 		if ma.s&fieldBit__AccessElement_Address != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__AccessElement_Address}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__AccessElement_Address}
 		}
 		ma.s += fieldBit__AccessElement_Address
 		ma.state = maState_midValue
@@ -338,7 +338,7 @@ func (ma *_AccessElement__Assembler) AssembleEntry(k string) (ipld.NodeAssembler
 		return &ma.ca_Address, nil
 	case "StorageKeys":
 		if ma.s&fieldBit__AccessElement_StorageKeys != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__AccessElement_StorageKeys}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__AccessElement_StorageKeys}
 		}
 		ma.s += fieldBit__AccessElement_StorageKeys
 		ma.state = maState_midValue
@@ -347,9 +347,9 @@ func (ma *_AccessElement__Assembler) AssembleEntry(k string) (ipld.NodeAssembler
 		ma.ca_StorageKeys.m = &ma.cm
 		return &ma.ca_StorageKeys, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.AccessElement", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.AccessElement", Key: &_String{k}}
 }
-func (ma *_AccessElement__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_AccessElement__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -367,7 +367,7 @@ func (ma *_AccessElement__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_AccessElement__KeyAssembler)(ma)
 }
-func (ma *_AccessElement__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_AccessElement__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -410,7 +410,7 @@ func (ma *_AccessElement__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__AccessElement_sufficient != fieldBits__AccessElement_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__AccessElement_Address == 0 {
 			err.Missing = append(err.Missing, "Address")
 		}
@@ -423,19 +423,19 @@ func (ma *_AccessElement__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_AccessElement__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_AccessElement__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_AccessElement__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_AccessElement__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _AccessElement__KeyAssembler _AccessElement__Assembler
 
-func (_AccessElement__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_AccessElement__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.AccessElement.KeyAssembler"}.BeginMap(0)
 }
-func (_AccessElement__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_AccessElement__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.AccessElement.KeyAssembler"}.BeginList(0)
 }
 func (na *_AccessElement__KeyAssembler) AssignNull() error {
@@ -457,43 +457,44 @@ func (ka *_AccessElement__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Address":
 		if ka.s&fieldBit__AccessElement_Address != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__AccessElement_Address}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__AccessElement_Address}
 		}
 		ka.s += fieldBit__AccessElement_Address
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "StorageKeys":
 		if ka.s&fieldBit__AccessElement_StorageKeys != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__AccessElement_StorageKeys}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__AccessElement_StorageKeys}
 		}
 		ka.s += fieldBit__AccessElement_StorageKeys
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.AccessElement", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.AccessElement", Key: &_String{k}}
 	}
-	return nil
 }
 func (_AccessElement__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.AccessElement.KeyAssembler"}.AssignBytes(nil)
 }
-func (_AccessElement__KeyAssembler) AssignLink(ipld.Link) error {
+func (_AccessElement__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.AccessElement.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_AccessElement__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_AccessElement__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_AccessElement__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_AccessElement__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (AccessElement) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n AccessElement) Representation() ipld.Node {
+func (n AccessElement) Representation() datamodel.Node {
 	return (*_AccessElement__Repr)(n)
 }
 
@@ -503,35 +504,35 @@ var (
 	fieldName__AccessElement_Address_serial     = _String{"Address"}
 	fieldName__AccessElement_StorageKeys_serial = _String{"StorageKeys"}
 )
-var _ ipld.Node = &_AccessElement__Repr{}
+var _ datamodel.Node = &_AccessElement__Repr{}
 
-func (_AccessElement__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_AccessElement__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_AccessElement__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_AccessElement__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Address":
 		return n.Address.Representation(), nil
 	case "StorageKeys":
 		return n.StorageKeys.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_AccessElement__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_AccessElement__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_AccessElement__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_AccessElement__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.AccessElement.Repr"}.LookupByIndex(0)
 }
-func (n _AccessElement__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _AccessElement__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_AccessElement__Repr) MapIterator() ipld.MapIterator {
+func (n *_AccessElement__Repr) MapIterator() datamodel.MapIterator {
 	return &_AccessElement__ReprMapItr{n, 0}
 }
 
@@ -540,9 +541,9 @@ type _AccessElement__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_AccessElement__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_AccessElement__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 2 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -560,7 +561,7 @@ func (itr *_AccessElement__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error
 func (itr *_AccessElement__ReprMapItr) Done() bool {
 	return itr.idx >= 2
 }
-func (_AccessElement__Repr) ListIterator() ipld.ListIterator {
+func (_AccessElement__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_AccessElement__Repr) Length() int64 {
@@ -588,16 +589,16 @@ func (_AccessElement__Repr) AsString() (string, error) {
 func (_AccessElement__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.AccessElement.Repr"}.AsBytes()
 }
-func (_AccessElement__Repr) AsLink() (ipld.Link, error) {
+func (_AccessElement__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.AccessElement.Repr"}.AsLink()
 }
-func (_AccessElement__Repr) Prototype() ipld.NodePrototype {
+func (_AccessElement__Repr) Prototype() datamodel.NodePrototype {
 	return _AccessElement__ReprPrototype{}
 }
 
 type _AccessElement__ReprPrototype struct{}
 
-func (_AccessElement__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_AccessElement__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _AccessElement__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -607,7 +608,7 @@ type _AccessElement__ReprBuilder struct {
 	_AccessElement__ReprAssembler
 }
 
-func (nb *_AccessElement__ReprBuilder) Build() ipld.Node {
+func (nb *_AccessElement__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -637,7 +638,7 @@ func (na *_AccessElement__ReprAssembler) reset() {
 	na.ca_Address.reset()
 	na.ca_StorageKeys.reset()
 }
-func (na *_AccessElement__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_AccessElement__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -650,7 +651,7 @@ func (na *_AccessElement__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, err
 	}
 	return na, nil
 }
-func (_AccessElement__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_AccessElement__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.AccessElement.Repr"}.BeginList(0)
 }
 func (na *_AccessElement__ReprAssembler) AssignNull() error {
@@ -682,10 +683,10 @@ func (_AccessElement__ReprAssembler) AssignString(string) error {
 func (_AccessElement__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.AccessElement.Repr"}.AssignBytes(nil)
 }
-func (_AccessElement__ReprAssembler) AssignLink(ipld.Link) error {
+func (_AccessElement__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.AccessElement.Repr"}.AssignLink(nil)
 }
-func (na *_AccessElement__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_AccessElement__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -705,8 +706,8 @@ func (na *_AccessElement__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.AccessElement.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.AccessElement.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -723,7 +724,7 @@ func (na *_AccessElement__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_AccessElement__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_AccessElement__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _AccessElement__ReprPrototype{}
 }
 func (ma *_AccessElement__ReprAssembler) valueFinishTidy() bool {
@@ -750,7 +751,7 @@ func (ma *_AccessElement__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_AccessElement__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_AccessElement__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -768,7 +769,7 @@ func (ma *_AccessElement__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssem
 	switch k {
 	case "Address":
 		if ma.s&fieldBit__AccessElement_Address != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__AccessElement_Address_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__AccessElement_Address_serial}
 		}
 		ma.s += fieldBit__AccessElement_Address
 		ma.state = maState_midValue
@@ -778,7 +779,7 @@ func (ma *_AccessElement__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssem
 		return &ma.ca_Address, nil
 	case "StorageKeys":
 		if ma.s&fieldBit__AccessElement_StorageKeys != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__AccessElement_StorageKeys_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__AccessElement_StorageKeys_serial}
 		}
 		ma.s += fieldBit__AccessElement_StorageKeys
 		ma.state = maState_midValue
@@ -788,9 +789,9 @@ func (ma *_AccessElement__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssem
 		return &ma.ca_StorageKeys, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.AccessElement.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.AccessElement.Repr", Key: &_String{k}}
 }
-func (ma *_AccessElement__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_AccessElement__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -808,7 +809,7 @@ func (ma *_AccessElement__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_AccessElement__ReprKeyAssembler)(ma)
 }
-func (ma *_AccessElement__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_AccessElement__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -851,7 +852,7 @@ func (ma *_AccessElement__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__AccessElement_sufficient != fieldBits__AccessElement_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__AccessElement_Address == 0 {
 			err.Missing = append(err.Missing, "Address")
 		}
@@ -864,19 +865,19 @@ func (ma *_AccessElement__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_AccessElement__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_AccessElement__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_AccessElement__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_AccessElement__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _AccessElement__ReprKeyAssembler _AccessElement__ReprAssembler
 
-func (_AccessElement__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_AccessElement__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.AccessElement.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_AccessElement__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_AccessElement__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.AccessElement.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_AccessElement__ReprKeyAssembler) AssignNull() error {
@@ -898,7 +899,7 @@ func (ka *_AccessElement__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Address":
 		if ka.s&fieldBit__AccessElement_Address != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__AccessElement_Address_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__AccessElement_Address_serial}
 		}
 		ka.s += fieldBit__AccessElement_Address
 		ka.state = maState_expectValue
@@ -906,29 +907,29 @@ func (ka *_AccessElement__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "StorageKeys":
 		if ka.s&fieldBit__AccessElement_StorageKeys != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__AccessElement_StorageKeys_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__AccessElement_StorageKeys_serial}
 		}
 		ka.s += fieldBit__AccessElement_StorageKeys
 		ka.state = maState_expectValue
 		ka.f = 1
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.AccessElement.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.AccessElement.Repr", Key: &_String{k}}
 }
 func (_AccessElement__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.AccessElement.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_AccessElement__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_AccessElement__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.AccessElement.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_AccessElement__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_AccessElement__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_AccessElement__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_AccessElement__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -989,12 +990,12 @@ func (m MaybeAccessList) IsAbsent() bool {
 func (m MaybeAccessList) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeAccessList) AsNode() ipld.Node {
+func (m MaybeAccessList) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -1008,40 +1009,40 @@ func (m MaybeAccessList) Must() AccessList {
 	return &m.v
 }
 
-var _ ipld.Node = (AccessList)(&_AccessList{})
+var _ datamodel.Node = (AccessList)(&_AccessList{})
 var _ schema.TypedNode = (AccessList)(&_AccessList{})
 
-func (AccessList) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (AccessList) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (AccessList) LookupByString(string) (ipld.Node, error) {
+func (AccessList) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.AccessList"}.LookupByString("")
 }
-func (n AccessList) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n AccessList) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n AccessList) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n AccessList) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n AccessList) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n AccessList) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.AccessList", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.AccessList", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (AccessList) MapIterator() ipld.MapIterator {
+func (AccessList) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n AccessList) ListIterator() ipld.ListIterator {
+func (n AccessList) ListIterator() datamodel.ListIterator {
 	return &_AccessList__ListItr{n, 0}
 }
 
@@ -1050,9 +1051,9 @@ type _AccessList__ListItr struct {
 	idx int
 }
 
-func (itr *_AccessList__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_AccessList__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -1088,16 +1089,16 @@ func (AccessList) AsString() (string, error) {
 func (AccessList) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.AccessList"}.AsBytes()
 }
-func (AccessList) AsLink() (ipld.Link, error) {
+func (AccessList) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.AccessList"}.AsLink()
 }
-func (AccessList) Prototype() ipld.NodePrototype {
+func (AccessList) Prototype() datamodel.NodePrototype {
 	return _AccessList__Prototype{}
 }
 
 type _AccessList__Prototype struct{}
 
-func (_AccessList__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_AccessList__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _AccessList__Builder
 	nb.Reset()
 	return &nb
@@ -1107,7 +1108,7 @@ type _AccessList__Builder struct {
 	_AccessList__Assembler
 }
 
-func (nb *_AccessList__Builder) Build() ipld.Node {
+func (nb *_AccessList__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -1132,10 +1133,10 @@ func (na *_AccessList__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_AccessList__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_AccessList__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.AccessList"}.BeginMap(0)
 }
-func (na *_AccessList__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_AccessList__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -1180,10 +1181,10 @@ func (_AccessList__Assembler) AssignString(string) error {
 func (_AccessList__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.AccessList"}.AssignBytes(nil)
 }
-func (_AccessList__Assembler) AssignLink(ipld.Link) error {
+func (_AccessList__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.AccessList"}.AssignLink(nil)
 }
-func (na *_AccessList__Assembler) AssignNode(v ipld.Node) error {
+func (na *_AccessList__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -1198,8 +1199,8 @@ func (na *_AccessList__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.AccessList", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.AccessList", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -1213,7 +1214,7 @@ func (na *_AccessList__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_AccessList__Assembler) Prototype() ipld.NodePrototype {
+func (_AccessList__Assembler) Prototype() datamodel.NodePrototype {
 	return _AccessList__Prototype{}
 }
 func (la *_AccessList__Assembler) valueFinishTidy() bool {
@@ -1228,7 +1229,7 @@ func (la *_AccessList__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_AccessList__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_AccessList__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -1261,59 +1262,59 @@ func (la *_AccessList__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_AccessList__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_AccessList__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _AccessElement__Prototype{}
 }
 func (AccessList) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n AccessList) Representation() ipld.Node {
+func (n AccessList) Representation() datamodel.Node {
 	return (*_AccessList__Repr)(n)
 }
 
 type _AccessList__Repr _AccessList
 
-var _ ipld.Node = &_AccessList__Repr{}
+var _ datamodel.Node = &_AccessList__Repr{}
 
-func (_AccessList__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_AccessList__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_AccessList__Repr) LookupByString(string) (ipld.Node, error) {
+func (_AccessList__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.AccessList.Repr"}.LookupByString("")
 }
-func (nr *_AccessList__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_AccessList__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (AccessList)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(AccessElement).Representation(), nil
 }
-func (nr *_AccessList__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_AccessList__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (AccessList)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(AccessElement).Representation(), nil
 }
-func (n _AccessList__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _AccessList__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.AccessList.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.AccessList.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_AccessList__Repr) MapIterator() ipld.MapIterator {
+func (_AccessList__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_AccessList__Repr) ListIterator() ipld.ListIterator {
+func (nr *_AccessList__Repr) ListIterator() datamodel.ListIterator {
 	return &_AccessList__ReprListItr{(AccessList)(nr), 0}
 }
 
 type _AccessList__ReprListItr _AccessList__ListItr
 
-func (itr *_AccessList__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_AccessList__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_AccessList__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(AccessElement).Representation(), nil
@@ -1346,16 +1347,16 @@ func (_AccessList__Repr) AsString() (string, error) {
 func (_AccessList__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.AccessList.Repr"}.AsBytes()
 }
-func (_AccessList__Repr) AsLink() (ipld.Link, error) {
+func (_AccessList__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.AccessList.Repr"}.AsLink()
 }
-func (_AccessList__Repr) Prototype() ipld.NodePrototype {
+func (_AccessList__Repr) Prototype() datamodel.NodePrototype {
 	return _AccessList__ReprPrototype{}
 }
 
 type _AccessList__ReprPrototype struct{}
 
-func (_AccessList__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_AccessList__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _AccessList__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -1365,7 +1366,7 @@ type _AccessList__ReprBuilder struct {
 	_AccessList__ReprAssembler
 }
 
-func (nb *_AccessList__ReprBuilder) Build() ipld.Node {
+func (nb *_AccessList__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -1390,10 +1391,10 @@ func (na *_AccessList__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_AccessList__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_AccessList__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.AccessList.Repr"}.BeginMap(0)
 }
-func (na *_AccessList__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_AccessList__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -1438,10 +1439,10 @@ func (_AccessList__ReprAssembler) AssignString(string) error {
 func (_AccessList__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.AccessList.Repr"}.AssignBytes(nil)
 }
-func (_AccessList__ReprAssembler) AssignLink(ipld.Link) error {
+func (_AccessList__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.AccessList.Repr"}.AssignLink(nil)
 }
-func (na *_AccessList__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_AccessList__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -1456,8 +1457,8 @@ func (na *_AccessList__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.AccessList.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.AccessList.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -1471,7 +1472,7 @@ func (na *_AccessList__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_AccessList__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_AccessList__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _AccessList__ReprPrototype{}
 }
 func (la *_AccessList__ReprAssembler) valueFinishTidy() bool {
@@ -1486,7 +1487,7 @@ func (la *_AccessList__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_AccessList__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_AccessList__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -1519,7 +1520,7 @@ func (la *_AccessList__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_AccessList__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_AccessList__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _AccessElement__ReprPrototype{}
 }
 
@@ -1551,12 +1552,12 @@ func (m MaybeAccount) IsAbsent() bool {
 func (m MaybeAccount) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeAccount) AsNode() ipld.Node {
+func (m MaybeAccount) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -1576,13 +1577,13 @@ var (
 	fieldName__Account_StorageRootCID = _String{"StorageRootCID"}
 	fieldName__Account_CodeCID        = _String{"CodeCID"}
 )
-var _ ipld.Node = (Account)(&_Account{})
+var _ datamodel.Node = (Account)(&_Account{})
 var _ schema.TypedNode = (Account)(&_Account{})
 
-func (Account) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Account) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Account) LookupByString(key string) (ipld.Node, error) {
+func (n Account) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Nonce":
 		return &n.Nonce, nil
@@ -1593,23 +1594,23 @@ func (n Account) LookupByString(key string) (ipld.Node, error) {
 	case "CodeCID":
 		return &n.CodeCID, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Account) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Account) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Account) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Account) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Account"}.LookupByIndex(0)
 }
-func (n Account) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Account) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Account) MapIterator() ipld.MapIterator {
+func (n Account) MapIterator() datamodel.MapIterator {
 	return &_Account__MapItr{n, 0}
 }
 
@@ -1618,9 +1619,9 @@ type _Account__MapItr struct {
 	idx int
 }
 
-func (itr *_Account__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Account__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 4 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -1645,7 +1646,7 @@ func (itr *_Account__MapItr) Done() bool {
 	return itr.idx >= 4
 }
 
-func (Account) ListIterator() ipld.ListIterator {
+func (Account) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Account) Length() int64 {
@@ -1672,16 +1673,16 @@ func (Account) AsString() (string, error) {
 func (Account) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Account"}.AsBytes()
 }
-func (Account) AsLink() (ipld.Link, error) {
+func (Account) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Account"}.AsLink()
 }
-func (Account) Prototype() ipld.NodePrototype {
+func (Account) Prototype() datamodel.NodePrototype {
 	return _Account__Prototype{}
 }
 
 type _Account__Prototype struct{}
 
-func (_Account__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Account__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Account__Builder
 	nb.Reset()
 	return &nb
@@ -1691,7 +1692,7 @@ type _Account__Builder struct {
 	_Account__Assembler
 }
 
-func (nb *_Account__Builder) Build() ipld.Node {
+func (nb *_Account__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -1734,7 +1735,7 @@ var (
 	fieldBits__Account_sufficient    = 0 + 1<<0 + 1<<1 + 1<<2 + 1<<3
 )
 
-func (na *_Account__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Account__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -1747,7 +1748,7 @@ func (na *_Account__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Account__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Account__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Account"}.BeginList(0)
 }
 func (na *_Account__Assembler) AssignNull() error {
@@ -1779,10 +1780,10 @@ func (_Account__Assembler) AssignString(string) error {
 func (_Account__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Account"}.AssignBytes(nil)
 }
-func (_Account__Assembler) AssignLink(ipld.Link) error {
+func (_Account__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Account"}.AssignLink(nil)
 }
-func (na *_Account__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Account__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -1802,8 +1803,8 @@ func (na *_Account__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Account", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Account", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -1820,7 +1821,7 @@ func (na *_Account__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Account__Assembler) Prototype() ipld.NodePrototype {
+func (_Account__Assembler) Prototype() datamodel.NodePrototype {
 	return _Account__Prototype{}
 }
 func (ma *_Account__Assembler) valueFinishTidy() bool {
@@ -1869,7 +1870,7 @@ func (ma *_Account__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Account__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Account__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -1887,7 +1888,7 @@ func (ma *_Account__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 	switch k {
 	case "Nonce":
 		if ma.s&fieldBit__Account_Nonce != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Account_Nonce}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_Nonce}
 		}
 		ma.s += fieldBit__Account_Nonce
 		ma.state = maState_midValue
@@ -1897,7 +1898,7 @@ func (ma *_Account__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Nonce, nil
 	case "Balance":
 		if ma.s&fieldBit__Account_Balance != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Account_Balance}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_Balance}
 		}
 		ma.s += fieldBit__Account_Balance
 		ma.state = maState_midValue
@@ -1907,7 +1908,7 @@ func (ma *_Account__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Balance, nil
 	case "StorageRootCID":
 		if ma.s&fieldBit__Account_StorageRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Account_StorageRootCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_StorageRootCID}
 		}
 		ma.s += fieldBit__Account_StorageRootCID
 		ma.state = maState_midValue
@@ -1917,7 +1918,7 @@ func (ma *_Account__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_StorageRootCID, nil
 	case "CodeCID":
 		if ma.s&fieldBit__Account_CodeCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Account_CodeCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_CodeCID}
 		}
 		ma.s += fieldBit__Account_CodeCID
 		ma.state = maState_midValue
@@ -1926,9 +1927,9 @@ func (ma *_Account__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		ma.ca_CodeCID.m = &ma.cm
 		return &ma.ca_CodeCID, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Account", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Account", Key: &_String{k}}
 }
-func (ma *_Account__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Account__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -1946,7 +1947,7 @@ func (ma *_Account__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Account__KeyAssembler)(ma)
 }
-func (ma *_Account__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Account__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -1997,7 +1998,7 @@ func (ma *_Account__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Account_sufficient != fieldBits__Account_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Account_Nonce == 0 {
 			err.Missing = append(err.Missing, "Nonce")
 		}
@@ -2016,19 +2017,19 @@ func (ma *_Account__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Account__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Account__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Account__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Account__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _Account__KeyAssembler _Account__Assembler
 
-func (_Account__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Account__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Account.KeyAssembler"}.BeginMap(0)
 }
-func (_Account__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Account__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Account.KeyAssembler"}.BeginList(0)
 }
 func (na *_Account__KeyAssembler) AssignNull() error {
@@ -2050,57 +2051,60 @@ func (ka *_Account__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Nonce":
 		if ka.s&fieldBit__Account_Nonce != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Account_Nonce}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_Nonce}
 		}
 		ka.s += fieldBit__Account_Nonce
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "Balance":
 		if ka.s&fieldBit__Account_Balance != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Account_Balance}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_Balance}
 		}
 		ka.s += fieldBit__Account_Balance
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	case "StorageRootCID":
 		if ka.s&fieldBit__Account_StorageRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Account_StorageRootCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_StorageRootCID}
 		}
 		ka.s += fieldBit__Account_StorageRootCID
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	case "CodeCID":
 		if ka.s&fieldBit__Account_CodeCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Account_CodeCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_CodeCID}
 		}
 		ka.s += fieldBit__Account_CodeCID
 		ka.state = maState_expectValue
 		ka.f = 3
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.Account", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.Account", Key: &_String{k}}
 	}
-	return nil
 }
 func (_Account__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Account.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Account__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Account__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Account.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Account__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Account__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Account__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Account__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Account) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Account) Representation() ipld.Node {
+func (n Account) Representation() datamodel.Node {
 	return (*_Account__Repr)(n)
 }
 
@@ -2112,12 +2116,12 @@ var (
 	fieldName__Account_StorageRootCID_serial = _String{"StorageRootCID"}
 	fieldName__Account_CodeCID_serial        = _String{"CodeCID"}
 )
-var _ ipld.Node = &_Account__Repr{}
+var _ datamodel.Node = &_Account__Repr{}
 
-func (_Account__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_Account__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_Account__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_Account__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Nonce":
 		return n.Nonce.Representation(), nil
@@ -2128,23 +2132,23 @@ func (n *_Account__Repr) LookupByString(key string) (ipld.Node, error) {
 	case "CodeCID":
 		return n.CodeCID.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_Account__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_Account__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_Account__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_Account__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Account.Repr"}.LookupByIndex(0)
 }
-func (n _Account__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Account__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_Account__Repr) MapIterator() ipld.MapIterator {
+func (n *_Account__Repr) MapIterator() datamodel.MapIterator {
 	return &_Account__ReprMapItr{n, 0}
 }
 
@@ -2153,9 +2157,9 @@ type _Account__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_Account__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Account__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 4 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -2179,7 +2183,7 @@ func (itr *_Account__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 func (itr *_Account__ReprMapItr) Done() bool {
 	return itr.idx >= 4
 }
-func (_Account__Repr) ListIterator() ipld.ListIterator {
+func (_Account__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_Account__Repr) Length() int64 {
@@ -2207,16 +2211,16 @@ func (_Account__Repr) AsString() (string, error) {
 func (_Account__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Account.Repr"}.AsBytes()
 }
-func (_Account__Repr) AsLink() (ipld.Link, error) {
+func (_Account__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Account.Repr"}.AsLink()
 }
-func (_Account__Repr) Prototype() ipld.NodePrototype {
+func (_Account__Repr) Prototype() datamodel.NodePrototype {
 	return _Account__ReprPrototype{}
 }
 
 type _Account__ReprPrototype struct{}
 
-func (_Account__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Account__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Account__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -2226,7 +2230,7 @@ type _Account__ReprBuilder struct {
 	_Account__ReprAssembler
 }
 
-func (nb *_Account__ReprBuilder) Build() ipld.Node {
+func (nb *_Account__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -2260,7 +2264,7 @@ func (na *_Account__ReprAssembler) reset() {
 	na.ca_StorageRootCID.reset()
 	na.ca_CodeCID.reset()
 }
-func (na *_Account__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Account__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -2273,7 +2277,7 @@ func (na *_Account__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Account__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Account__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Account.Repr"}.BeginList(0)
 }
 func (na *_Account__ReprAssembler) AssignNull() error {
@@ -2305,10 +2309,10 @@ func (_Account__ReprAssembler) AssignString(string) error {
 func (_Account__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Account.Repr"}.AssignBytes(nil)
 }
-func (_Account__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Account__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Account.Repr"}.AssignLink(nil)
 }
-func (na *_Account__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Account__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -2328,8 +2332,8 @@ func (na *_Account__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Account.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Account.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -2346,7 +2350,7 @@ func (na *_Account__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Account__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Account__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Account__ReprPrototype{}
 }
 func (ma *_Account__ReprAssembler) valueFinishTidy() bool {
@@ -2391,7 +2395,7 @@ func (ma *_Account__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Account__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Account__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -2409,7 +2413,7 @@ func (ma *_Account__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 	switch k {
 	case "Nonce":
 		if ma.s&fieldBit__Account_Nonce != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Account_Nonce_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_Nonce_serial}
 		}
 		ma.s += fieldBit__Account_Nonce
 		ma.state = maState_midValue
@@ -2419,7 +2423,7 @@ func (ma *_Account__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Nonce, nil
 	case "Balance":
 		if ma.s&fieldBit__Account_Balance != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Account_Balance_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_Balance_serial}
 		}
 		ma.s += fieldBit__Account_Balance
 		ma.state = maState_midValue
@@ -2429,7 +2433,7 @@ func (ma *_Account__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Balance, nil
 	case "StorageRootCID":
 		if ma.s&fieldBit__Account_StorageRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Account_StorageRootCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_StorageRootCID_serial}
 		}
 		ma.s += fieldBit__Account_StorageRootCID
 		ma.state = maState_midValue
@@ -2439,7 +2443,7 @@ func (ma *_Account__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_StorageRootCID, nil
 	case "CodeCID":
 		if ma.s&fieldBit__Account_CodeCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Account_CodeCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_CodeCID_serial}
 		}
 		ma.s += fieldBit__Account_CodeCID
 		ma.state = maState_midValue
@@ -2449,9 +2453,9 @@ func (ma *_Account__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_CodeCID, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Account.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Account.Repr", Key: &_String{k}}
 }
-func (ma *_Account__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Account__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -2469,7 +2473,7 @@ func (ma *_Account__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Account__ReprKeyAssembler)(ma)
 }
-func (ma *_Account__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Account__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -2520,7 +2524,7 @@ func (ma *_Account__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Account_sufficient != fieldBits__Account_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Account_Nonce == 0 {
 			err.Missing = append(err.Missing, "Nonce")
 		}
@@ -2539,19 +2543,19 @@ func (ma *_Account__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Account__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Account__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Account__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Account__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _Account__ReprKeyAssembler _Account__ReprAssembler
 
-func (_Account__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Account__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Account.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_Account__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Account__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Account.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_Account__ReprKeyAssembler) AssignNull() error {
@@ -2573,7 +2577,7 @@ func (ka *_Account__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Nonce":
 		if ka.s&fieldBit__Account_Nonce != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Account_Nonce_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_Nonce_serial}
 		}
 		ka.s += fieldBit__Account_Nonce
 		ka.state = maState_expectValue
@@ -2581,7 +2585,7 @@ func (ka *_Account__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Balance":
 		if ka.s&fieldBit__Account_Balance != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Account_Balance_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_Balance_serial}
 		}
 		ka.s += fieldBit__Account_Balance
 		ka.state = maState_expectValue
@@ -2589,7 +2593,7 @@ func (ka *_Account__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "StorageRootCID":
 		if ka.s&fieldBit__Account_StorageRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Account_StorageRootCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_StorageRootCID_serial}
 		}
 		ka.s += fieldBit__Account_StorageRootCID
 		ka.state = maState_expectValue
@@ -2597,29 +2601,29 @@ func (ka *_Account__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "CodeCID":
 		if ka.s&fieldBit__Account_CodeCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Account_CodeCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Account_CodeCID_serial}
 		}
 		ka.s += fieldBit__Account_CodeCID
 		ka.state = maState_expectValue
 		ka.f = 3
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Account.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.Account.Repr", Key: &_String{k}}
 }
 func (_Account__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Account.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Account__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_Account__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Account.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Account__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Account__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Account__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_Account__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -2646,12 +2650,12 @@ func (m MaybeAddress) IsAbsent() bool {
 func (m MaybeAddress) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeAddress) AsNode() ipld.Node {
+func (m MaybeAddress) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -2665,28 +2669,28 @@ func (m MaybeAddress) Must() Address {
 	return &m.v
 }
 
-var _ ipld.Node = (Address)(&_Address{})
+var _ datamodel.Node = (Address)(&_Address{})
 var _ schema.TypedNode = (Address)(&_Address{})
 
-func (Address) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (Address) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (Address) LookupByString(string) (ipld.Node, error) {
+func (Address) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Address"}.LookupByString("")
 }
-func (Address) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Address) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Address"}.LookupByNode(nil)
 }
-func (Address) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Address) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Address"}.LookupByIndex(0)
 }
-func (Address) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Address) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Address"}.LookupBySegment(seg)
 }
-func (Address) MapIterator() ipld.MapIterator {
+func (Address) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Address) ListIterator() ipld.ListIterator {
+func (Address) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Address) Length() int64 {
@@ -2713,16 +2717,16 @@ func (Address) AsString() (string, error) {
 func (n Address) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (Address) AsLink() (ipld.Link, error) {
+func (Address) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.Address"}.AsLink()
 }
-func (Address) Prototype() ipld.NodePrototype {
+func (Address) Prototype() datamodel.NodePrototype {
 	return _Address__Prototype{}
 }
 
 type _Address__Prototype struct{}
 
-func (_Address__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Address__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Address__Builder
 	nb.Reset()
 	return &nb
@@ -2732,7 +2736,7 @@ type _Address__Builder struct {
 	_Address__Assembler
 }
 
-func (nb *_Address__Builder) Build() ipld.Node {
+func (nb *_Address__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -2750,10 +2754,10 @@ type _Address__Assembler struct {
 }
 
 func (na *_Address__Assembler) reset() {}
-func (_Address__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Address__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Address"}.BeginMap(0)
 }
-func (_Address__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Address__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Address"}.BeginList(0)
 }
 func (na *_Address__Assembler) AssignNull() error {
@@ -2789,10 +2793,10 @@ func (na *_Address__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_Address__Assembler) AssignLink(ipld.Link) error {
+func (_Address__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.Address"}.AssignLink(nil)
 }
-func (na *_Address__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Address__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -2811,19 +2815,19 @@ func (na *_Address__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_Address__Assembler) Prototype() ipld.NodePrototype {
+func (_Address__Assembler) Prototype() datamodel.NodePrototype {
 	return _Address__Prototype{}
 }
 func (Address) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Address) Representation() ipld.Node {
+func (n Address) Representation() datamodel.Node {
 	return (*_Address__Repr)(n)
 }
 
 type _Address__Repr = _Address
 
-var _ ipld.Node = &_Address__Repr{}
+var _ datamodel.Node = &_Address__Repr{}
 
 type _Address__ReprPrototype = _Address__Prototype
 type _Address__ReprAssembler = _Address__Assembler
@@ -2851,12 +2855,12 @@ func (m MaybeBalance) IsAbsent() bool {
 func (m MaybeBalance) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeBalance) AsNode() ipld.Node {
+func (m MaybeBalance) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -2870,28 +2874,28 @@ func (m MaybeBalance) Must() Balance {
 	return &m.v
 }
 
-var _ ipld.Node = (Balance)(&_Balance{})
+var _ datamodel.Node = (Balance)(&_Balance{})
 var _ schema.TypedNode = (Balance)(&_Balance{})
 
-func (Balance) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (Balance) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (Balance) LookupByString(string) (ipld.Node, error) {
+func (Balance) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Balance"}.LookupByString("")
 }
-func (Balance) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Balance) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Balance"}.LookupByNode(nil)
 }
-func (Balance) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Balance) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Balance"}.LookupByIndex(0)
 }
-func (Balance) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Balance) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Balance"}.LookupBySegment(seg)
 }
-func (Balance) MapIterator() ipld.MapIterator {
+func (Balance) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Balance) ListIterator() ipld.ListIterator {
+func (Balance) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Balance) Length() int64 {
@@ -2918,16 +2922,16 @@ func (Balance) AsString() (string, error) {
 func (n Balance) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (Balance) AsLink() (ipld.Link, error) {
+func (Balance) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.Balance"}.AsLink()
 }
-func (Balance) Prototype() ipld.NodePrototype {
+func (Balance) Prototype() datamodel.NodePrototype {
 	return _Balance__Prototype{}
 }
 
 type _Balance__Prototype struct{}
 
-func (_Balance__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Balance__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Balance__Builder
 	nb.Reset()
 	return &nb
@@ -2937,7 +2941,7 @@ type _Balance__Builder struct {
 	_Balance__Assembler
 }
 
-func (nb *_Balance__Builder) Build() ipld.Node {
+func (nb *_Balance__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -2955,10 +2959,10 @@ type _Balance__Assembler struct {
 }
 
 func (na *_Balance__Assembler) reset() {}
-func (_Balance__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Balance__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Balance"}.BeginMap(0)
 }
-func (_Balance__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Balance__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Balance"}.BeginList(0)
 }
 func (na *_Balance__Assembler) AssignNull() error {
@@ -2994,10 +2998,10 @@ func (na *_Balance__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_Balance__Assembler) AssignLink(ipld.Link) error {
+func (_Balance__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.Balance"}.AssignLink(nil)
 }
-func (na *_Balance__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Balance__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -3016,19 +3020,19 @@ func (na *_Balance__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_Balance__Assembler) Prototype() ipld.NodePrototype {
+func (_Balance__Assembler) Prototype() datamodel.NodePrototype {
 	return _Balance__Prototype{}
 }
 func (Balance) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Balance) Representation() ipld.Node {
+func (n Balance) Representation() datamodel.Node {
 	return (*_Balance__Repr)(n)
 }
 
 type _Balance__Repr = _Balance
 
-var _ ipld.Node = &_Balance__Repr{}
+var _ datamodel.Node = &_Balance__Repr{}
 
 type _Balance__ReprPrototype = _Balance__Prototype
 type _Balance__ReprAssembler = _Balance__Assembler
@@ -3056,12 +3060,12 @@ func (m MaybeBigInt) IsAbsent() bool {
 func (m MaybeBigInt) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeBigInt) AsNode() ipld.Node {
+func (m MaybeBigInt) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -3075,28 +3079,28 @@ func (m MaybeBigInt) Must() BigInt {
 	return &m.v
 }
 
-var _ ipld.Node = (BigInt)(&_BigInt{})
+var _ datamodel.Node = (BigInt)(&_BigInt{})
 var _ schema.TypedNode = (BigInt)(&_BigInt{})
 
-func (BigInt) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (BigInt) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (BigInt) LookupByString(string) (ipld.Node, error) {
+func (BigInt) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.BigInt"}.LookupByString("")
 }
-func (BigInt) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (BigInt) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.BigInt"}.LookupByNode(nil)
 }
-func (BigInt) LookupByIndex(idx int64) (ipld.Node, error) {
+func (BigInt) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.BigInt"}.LookupByIndex(0)
 }
-func (BigInt) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (BigInt) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.BigInt"}.LookupBySegment(seg)
 }
-func (BigInt) MapIterator() ipld.MapIterator {
+func (BigInt) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (BigInt) ListIterator() ipld.ListIterator {
+func (BigInt) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (BigInt) Length() int64 {
@@ -3123,16 +3127,16 @@ func (BigInt) AsString() (string, error) {
 func (n BigInt) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (BigInt) AsLink() (ipld.Link, error) {
+func (BigInt) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.BigInt"}.AsLink()
 }
-func (BigInt) Prototype() ipld.NodePrototype {
+func (BigInt) Prototype() datamodel.NodePrototype {
 	return _BigInt__Prototype{}
 }
 
 type _BigInt__Prototype struct{}
 
-func (_BigInt__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_BigInt__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _BigInt__Builder
 	nb.Reset()
 	return &nb
@@ -3142,7 +3146,7 @@ type _BigInt__Builder struct {
 	_BigInt__Assembler
 }
 
-func (nb *_BigInt__Builder) Build() ipld.Node {
+func (nb *_BigInt__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -3160,10 +3164,10 @@ type _BigInt__Assembler struct {
 }
 
 func (na *_BigInt__Assembler) reset() {}
-func (_BigInt__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_BigInt__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.BigInt"}.BeginMap(0)
 }
-func (_BigInt__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_BigInt__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.BigInt"}.BeginList(0)
 }
 func (na *_BigInt__Assembler) AssignNull() error {
@@ -3199,10 +3203,10 @@ func (na *_BigInt__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_BigInt__Assembler) AssignLink(ipld.Link) error {
+func (_BigInt__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.BigInt"}.AssignLink(nil)
 }
-func (na *_BigInt__Assembler) AssignNode(v ipld.Node) error {
+func (na *_BigInt__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -3221,31 +3225,31 @@ func (na *_BigInt__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_BigInt__Assembler) Prototype() ipld.NodePrototype {
+func (_BigInt__Assembler) Prototype() datamodel.NodePrototype {
 	return _BigInt__Prototype{}
 }
 func (BigInt) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n BigInt) Representation() ipld.Node {
+func (n BigInt) Representation() datamodel.Node {
 	return (*_BigInt__Repr)(n)
 }
 
 type _BigInt__Repr = _BigInt
 
-var _ ipld.Node = &_BigInt__Repr{}
+var _ datamodel.Node = &_BigInt__Repr{}
 
 type _BigInt__ReprPrototype = _BigInt__Prototype
 type _BigInt__ReprAssembler = _BigInt__Assembler
 
-func (n _Block) FieldHeader() Link {
-	return &n.Header
+func (n _Block) FieldHeaderCID() Link {
+	return &n.HeaderCID
 }
-func (n _Block) FieldTransactions() Link {
-	return &n.Transactions
+func (n _Block) FieldTransactionsCID() Link {
+	return &n.TransactionsCID
 }
-func (n _Block) FieldReceipts() Link {
-	return &n.Receipts
+func (n _Block) FieldReceiptsCID() Link {
+	return &n.ReceiptsCID
 }
 
 type _Block__Maybe struct {
@@ -3263,12 +3267,12 @@ func (m MaybeBlock) IsAbsent() bool {
 func (m MaybeBlock) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeBlock) AsNode() ipld.Node {
+func (m MaybeBlock) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -3283,42 +3287,42 @@ func (m MaybeBlock) Must() Block {
 }
 
 var (
-	fieldName__Block_Header       = _String{"Header"}
-	fieldName__Block_Transactions = _String{"Transactions"}
-	fieldName__Block_Receipts     = _String{"Receipts"}
+	fieldName__Block_HeaderCID       = _String{"HeaderCID"}
+	fieldName__Block_TransactionsCID = _String{"TransactionsCID"}
+	fieldName__Block_ReceiptsCID     = _String{"ReceiptsCID"}
 )
-var _ ipld.Node = (Block)(&_Block{})
+var _ datamodel.Node = (Block)(&_Block{})
 var _ schema.TypedNode = (Block)(&_Block{})
 
-func (Block) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Block) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Block) LookupByString(key string) (ipld.Node, error) {
+func (n Block) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
-	case "Header":
-		return &n.Header, nil
-	case "Transactions":
-		return &n.Transactions, nil
-	case "Receipts":
-		return &n.Receipts, nil
+	case "HeaderCID":
+		return &n.HeaderCID, nil
+	case "TransactionsCID":
+		return &n.TransactionsCID, nil
+	case "ReceiptsCID":
+		return &n.ReceiptsCID, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Block) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Block) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Block) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Block) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Block"}.LookupByIndex(0)
 }
-func (n Block) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Block) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Block) MapIterator() ipld.MapIterator {
+func (n Block) MapIterator() datamodel.MapIterator {
 	return &_Block__MapItr{n, 0}
 }
 
@@ -3327,20 +3331,20 @@ type _Block__MapItr struct {
 	idx int
 }
 
-func (itr *_Block__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Block__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 3 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
-		k = &fieldName__Block_Header
-		v = &itr.n.Header
+		k = &fieldName__Block_HeaderCID
+		v = &itr.n.HeaderCID
 	case 1:
-		k = &fieldName__Block_Transactions
-		v = &itr.n.Transactions
+		k = &fieldName__Block_TransactionsCID
+		v = &itr.n.TransactionsCID
 	case 2:
-		k = &fieldName__Block_Receipts
-		v = &itr.n.Receipts
+		k = &fieldName__Block_ReceiptsCID
+		v = &itr.n.ReceiptsCID
 	default:
 		panic("unreachable")
 	}
@@ -3351,7 +3355,7 @@ func (itr *_Block__MapItr) Done() bool {
 	return itr.idx >= 3
 }
 
-func (Block) ListIterator() ipld.ListIterator {
+func (Block) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Block) Length() int64 {
@@ -3378,16 +3382,16 @@ func (Block) AsString() (string, error) {
 func (Block) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Block"}.AsBytes()
 }
-func (Block) AsLink() (ipld.Link, error) {
+func (Block) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Block"}.AsLink()
 }
-func (Block) Prototype() ipld.NodePrototype {
+func (Block) Prototype() datamodel.NodePrototype {
 	return _Block__Prototype{}
 }
 
 type _Block__Prototype struct{}
 
-func (_Block__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Block__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Block__Builder
 	nb.Reset()
 	return &nb
@@ -3397,7 +3401,7 @@ type _Block__Builder struct {
 	_Block__Assembler
 }
 
-func (nb *_Block__Builder) Build() ipld.Node {
+func (nb *_Block__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -3416,28 +3420,28 @@ type _Block__Assembler struct {
 	s     int
 	f     int
 
-	cm              schema.Maybe
-	ca_Header       _Link__Assembler
-	ca_Transactions _Link__Assembler
-	ca_Receipts     _Link__Assembler
+	cm                 schema.Maybe
+	ca_HeaderCID       _Link__Assembler
+	ca_TransactionsCID _Link__Assembler
+	ca_ReceiptsCID     _Link__Assembler
 }
 
 func (na *_Block__Assembler) reset() {
 	na.state = maState_initial
 	na.s = 0
-	na.ca_Header.reset()
-	na.ca_Transactions.reset()
-	na.ca_Receipts.reset()
+	na.ca_HeaderCID.reset()
+	na.ca_TransactionsCID.reset()
+	na.ca_ReceiptsCID.reset()
 }
 
 var (
-	fieldBit__Block_Header       = 1 << 0
-	fieldBit__Block_Transactions = 1 << 1
-	fieldBit__Block_Receipts     = 1 << 2
-	fieldBits__Block_sufficient  = 0 + 1<<0 + 1<<1 + 1<<2
+	fieldBit__Block_HeaderCID       = 1 << 0
+	fieldBit__Block_TransactionsCID = 1 << 1
+	fieldBit__Block_ReceiptsCID     = 1 << 2
+	fieldBits__Block_sufficient     = 0 + 1<<0 + 1<<1 + 1<<2
 )
 
-func (na *_Block__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Block__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -3450,7 +3454,7 @@ func (na *_Block__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Block__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Block__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Block"}.BeginList(0)
 }
 func (na *_Block__Assembler) AssignNull() error {
@@ -3482,10 +3486,10 @@ func (_Block__Assembler) AssignString(string) error {
 func (_Block__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Block"}.AssignBytes(nil)
 }
-func (_Block__Assembler) AssignLink(ipld.Link) error {
+func (_Block__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Block"}.AssignLink(nil)
 }
-func (na *_Block__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Block__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -3505,8 +3509,8 @@ func (na *_Block__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Block", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Block", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -3523,7 +3527,7 @@ func (na *_Block__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Block__Assembler) Prototype() ipld.NodePrototype {
+func (_Block__Assembler) Prototype() datamodel.NodePrototype {
 	return _Block__Prototype{}
 }
 func (ma *_Block__Assembler) valueFinishTidy() bool {
@@ -3531,7 +3535,7 @@ func (ma *_Block__Assembler) valueFinishTidy() bool {
 	case 0:
 		switch ma.cm {
 		case schema.Maybe_Value:
-			ma.ca_Header.w = nil
+			ma.ca_HeaderCID.w = nil
 			ma.cm = schema.Maybe_Absent
 			ma.state = maState_initial
 			return true
@@ -3541,7 +3545,7 @@ func (ma *_Block__Assembler) valueFinishTidy() bool {
 	case 1:
 		switch ma.cm {
 		case schema.Maybe_Value:
-			ma.ca_Transactions.w = nil
+			ma.ca_TransactionsCID.w = nil
 			ma.cm = schema.Maybe_Absent
 			ma.state = maState_initial
 			return true
@@ -3551,7 +3555,7 @@ func (ma *_Block__Assembler) valueFinishTidy() bool {
 	case 2:
 		switch ma.cm {
 		case schema.Maybe_Value:
-			ma.ca_Receipts.w = nil
+			ma.ca_ReceiptsCID.w = nil
 			ma.cm = schema.Maybe_Absent
 			ma.state = maState_initial
 			return true
@@ -3562,7 +3566,7 @@ func (ma *_Block__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Block__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Block__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -3578,40 +3582,40 @@ func (ma *_Block__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
 	}
 	switch k {
-	case "Header":
-		if ma.s&fieldBit__Block_Header != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Header}
+	case "HeaderCID":
+		if ma.s&fieldBit__Block_HeaderCID != 0 {
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_HeaderCID}
 		}
-		ma.s += fieldBit__Block_Header
+		ma.s += fieldBit__Block_HeaderCID
 		ma.state = maState_midValue
 		ma.f = 0
-		ma.ca_Header.w = &ma.w.Header
-		ma.ca_Header.m = &ma.cm
-		return &ma.ca_Header, nil
-	case "Transactions":
-		if ma.s&fieldBit__Block_Transactions != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Transactions}
-		}
-		ma.s += fieldBit__Block_Transactions
+		ma.ca_HeaderCID.w = &ma.w.HeaderCID
+		ma.ca_HeaderCID.m = &ma.cm
+		return &ma.ca_HeaderCID, nil
+	case "TransactionsCID":
+		if ma.s&fieldBit__Block_TransactionsCID != 0 {
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_TransactionsCID}
+		}
+		ma.s += fieldBit__Block_TransactionsCID
 		ma.state = maState_midValue
 		ma.f = 1
-		ma.ca_Transactions.w = &ma.w.Transactions
-		ma.ca_Transactions.m = &ma.cm
-		return &ma.ca_Transactions, nil
-	case "Receipts":
-		if ma.s&fieldBit__Block_Receipts != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Receipts}
-		}
-		ma.s += fieldBit__Block_Receipts
+		ma.ca_TransactionsCID.w = &ma.w.TransactionsCID
+		ma.ca_TransactionsCID.m = &ma.cm
+		return &ma.ca_TransactionsCID, nil
+	case "ReceiptsCID":
+		if ma.s&fieldBit__Block_ReceiptsCID != 0 {
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_ReceiptsCID}
+		}
+		ma.s += fieldBit__Block_ReceiptsCID
 		ma.state = maState_midValue
 		ma.f = 2
-		ma.ca_Receipts.w = &ma.w.Receipts
-		ma.ca_Receipts.m = &ma.cm
-		return &ma.ca_Receipts, nil
+		ma.ca_ReceiptsCID.w = &ma.w.ReceiptsCID
+		ma.ca_ReceiptsCID.m = &ma.cm
+		return &ma.ca_ReceiptsCID, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Block", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Block", Key: &_String{k}}
 }
-func (ma *_Block__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Block__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -3629,7 +3633,7 @@ func (ma *_Block__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Block__KeyAssembler)(ma)
 }
-func (ma *_Block__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Block__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -3645,17 +3649,17 @@ func (ma *_Block__Assembler) AssembleValue() ipld.NodeAssembler {
 	ma.state = maState_midValue
 	switch ma.f {
 	case 0:
-		ma.ca_Header.w = &ma.w.Header
-		ma.ca_Header.m = &ma.cm
-		return &ma.ca_Header
+		ma.ca_HeaderCID.w = &ma.w.HeaderCID
+		ma.ca_HeaderCID.m = &ma.cm
+		return &ma.ca_HeaderCID
 	case 1:
-		ma.ca_Transactions.w = &ma.w.Transactions
-		ma.ca_Transactions.m = &ma.cm
-		return &ma.ca_Transactions
+		ma.ca_TransactionsCID.w = &ma.w.TransactionsCID
+		ma.ca_TransactionsCID.m = &ma.cm
+		return &ma.ca_TransactionsCID
 	case 2:
-		ma.ca_Receipts.w = &ma.w.Receipts
-		ma.ca_Receipts.m = &ma.cm
-		return &ma.ca_Receipts
+		ma.ca_ReceiptsCID.w = &ma.w.ReceiptsCID
+		ma.ca_ReceiptsCID.m = &ma.cm
+		return &ma.ca_ReceiptsCID
 	default:
 		panic("unreachable")
 	}
@@ -3676,15 +3680,15 @@ func (ma *_Block__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Block_sufficient != fieldBits__Block_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
-		if ma.s&fieldBit__Block_Header == 0 {
-			err.Missing = append(err.Missing, "Header")
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
+		if ma.s&fieldBit__Block_HeaderCID == 0 {
+			err.Missing = append(err.Missing, "HeaderCID")
 		}
-		if ma.s&fieldBit__Block_Transactions == 0 {
-			err.Missing = append(err.Missing, "Transactions")
+		if ma.s&fieldBit__Block_TransactionsCID == 0 {
+			err.Missing = append(err.Missing, "TransactionsCID")
 		}
-		if ma.s&fieldBit__Block_Receipts == 0 {
-			err.Missing = append(err.Missing, "Receipts")
+		if ma.s&fieldBit__Block_ReceiptsCID == 0 {
+			err.Missing = append(err.Missing, "ReceiptsCID")
 		}
 		return err
 	}
@@ -3692,19 +3696,19 @@ func (ma *_Block__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Block__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Block__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Block__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Block__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _Block__KeyAssembler _Block__Assembler
 
-func (_Block__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Block__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Block.KeyAssembler"}.BeginMap(0)
 }
-func (_Block__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Block__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Block.KeyAssembler"}.BeginList(0)
 }
 func (na *_Block__KeyAssembler) AssignNull() error {
@@ -3724,93 +3728,95 @@ func (ka *_Block__KeyAssembler) AssignString(k string) error {
 		panic("misuse: KeyAssembler held beyond its valid lifetime")
 	}
 	switch k {
-	case "Header":
-		if ka.s&fieldBit__Block_Header != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Header}
+	case "HeaderCID":
+		if ka.s&fieldBit__Block_HeaderCID != 0 {
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_HeaderCID}
 		}
-		ka.s += fieldBit__Block_Header
+		ka.s += fieldBit__Block_HeaderCID
 		ka.state = maState_expectValue
 		ka.f = 0
-	case "Transactions":
-		if ka.s&fieldBit__Block_Transactions != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Transactions}
+		return nil
+	case "TransactionsCID":
+		if ka.s&fieldBit__Block_TransactionsCID != 0 {
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_TransactionsCID}
 		}
-		ka.s += fieldBit__Block_Transactions
+		ka.s += fieldBit__Block_TransactionsCID
 		ka.state = maState_expectValue
 		ka.f = 1
-	case "Receipts":
-		if ka.s&fieldBit__Block_Receipts != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Receipts}
+		return nil
+	case "ReceiptsCID":
+		if ka.s&fieldBit__Block_ReceiptsCID != 0 {
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_ReceiptsCID}
 		}
-		ka.s += fieldBit__Block_Receipts
+		ka.s += fieldBit__Block_ReceiptsCID
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.Block", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.Block", Key: &_String{k}}
 	}
-	return nil
 }
 func (_Block__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Block.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Block__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Block__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Block.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Block__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Block__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Block__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Block__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Block) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Block) Representation() ipld.Node {
+func (n Block) Representation() datamodel.Node {
 	return (*_Block__Repr)(n)
 }
 
 type _Block__Repr _Block
 
 var (
-	fieldName__Block_Header_serial       = _String{"Header"}
-	fieldName__Block_Transactions_serial = _String{"Transactions"}
-	fieldName__Block_Receipts_serial     = _String{"Receipts"}
+	fieldName__Block_HeaderCID_serial       = _String{"HeaderCID"}
+	fieldName__Block_TransactionsCID_serial = _String{"TransactionsCID"}
+	fieldName__Block_ReceiptsCID_serial     = _String{"ReceiptsCID"}
 )
-var _ ipld.Node = &_Block__Repr{}
+var _ datamodel.Node = &_Block__Repr{}
 
-func (_Block__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_Block__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_Block__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_Block__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
-	case "Header":
-		return n.Header.Representation(), nil
-	case "Transactions":
-		return n.Transactions.Representation(), nil
-	case "Receipts":
-		return n.Receipts.Representation(), nil
+	case "HeaderCID":
+		return n.HeaderCID.Representation(), nil
+	case "TransactionsCID":
+		return n.TransactionsCID.Representation(), nil
+	case "ReceiptsCID":
+		return n.ReceiptsCID.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_Block__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_Block__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_Block__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_Block__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Block.Repr"}.LookupByIndex(0)
 }
-func (n _Block__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Block__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_Block__Repr) MapIterator() ipld.MapIterator {
+func (n *_Block__Repr) MapIterator() datamodel.MapIterator {
 	return &_Block__ReprMapItr{n, 0}
 }
 
@@ -3819,20 +3825,20 @@ type _Block__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_Block__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Block__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 3 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
-		k = &fieldName__Block_Header_serial
-		v = itr.n.Header.Representation()
+		k = &fieldName__Block_HeaderCID_serial
+		v = itr.n.HeaderCID.Representation()
 	case 1:
-		k = &fieldName__Block_Transactions_serial
-		v = itr.n.Transactions.Representation()
+		k = &fieldName__Block_TransactionsCID_serial
+		v = itr.n.TransactionsCID.Representation()
 	case 2:
-		k = &fieldName__Block_Receipts_serial
-		v = itr.n.Receipts.Representation()
+		k = &fieldName__Block_ReceiptsCID_serial
+		v = itr.n.ReceiptsCID.Representation()
 	default:
 		panic("unreachable")
 	}
@@ -3842,7 +3848,7 @@ func (itr *_Block__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 func (itr *_Block__ReprMapItr) Done() bool {
 	return itr.idx >= 3
 }
-func (_Block__Repr) ListIterator() ipld.ListIterator {
+func (_Block__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_Block__Repr) Length() int64 {
@@ -3870,16 +3876,16 @@ func (_Block__Repr) AsString() (string, error) {
 func (_Block__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Block.Repr"}.AsBytes()
 }
-func (_Block__Repr) AsLink() (ipld.Link, error) {
+func (_Block__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Block.Repr"}.AsLink()
 }
-func (_Block__Repr) Prototype() ipld.NodePrototype {
+func (_Block__Repr) Prototype() datamodel.NodePrototype {
 	return _Block__ReprPrototype{}
 }
 
 type _Block__ReprPrototype struct{}
 
-func (_Block__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Block__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Block__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -3889,7 +3895,7 @@ type _Block__ReprBuilder struct {
 	_Block__ReprAssembler
 }
 
-func (nb *_Block__ReprBuilder) Build() ipld.Node {
+func (nb *_Block__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -3908,20 +3914,20 @@ type _Block__ReprAssembler struct {
 	s     int
 	f     int
 
-	cm              schema.Maybe
-	ca_Header       _Link__ReprAssembler
-	ca_Transactions _Link__ReprAssembler
-	ca_Receipts     _Link__ReprAssembler
+	cm                 schema.Maybe
+	ca_HeaderCID       _Link__ReprAssembler
+	ca_TransactionsCID _Link__ReprAssembler
+	ca_ReceiptsCID     _Link__ReprAssembler
 }
 
 func (na *_Block__ReprAssembler) reset() {
 	na.state = maState_initial
 	na.s = 0
-	na.ca_Header.reset()
-	na.ca_Transactions.reset()
-	na.ca_Receipts.reset()
+	na.ca_HeaderCID.reset()
+	na.ca_TransactionsCID.reset()
+	na.ca_ReceiptsCID.reset()
 }
-func (na *_Block__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Block__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -3934,7 +3940,7 @@ func (na *_Block__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Block__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Block__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Block.Repr"}.BeginList(0)
 }
 func (na *_Block__ReprAssembler) AssignNull() error {
@@ -3966,10 +3972,10 @@ func (_Block__ReprAssembler) AssignString(string) error {
 func (_Block__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Block.Repr"}.AssignBytes(nil)
 }
-func (_Block__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Block__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Block.Repr"}.AssignLink(nil)
 }
-func (na *_Block__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Block__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -3989,8 +3995,8 @@ func (na *_Block__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Block.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Block.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -4007,7 +4013,7 @@ func (na *_Block__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Block__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Block__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Block__ReprPrototype{}
 }
 func (ma *_Block__ReprAssembler) valueFinishTidy() bool {
@@ -4043,7 +4049,7 @@ func (ma *_Block__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Block__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Block__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -4059,41 +4065,41 @@ func (ma *_Block__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
 	}
 	switch k {
-	case "Header":
-		if ma.s&fieldBit__Block_Header != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Header_serial}
+	case "HeaderCID":
+		if ma.s&fieldBit__Block_HeaderCID != 0 {
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_HeaderCID_serial}
 		}
-		ma.s += fieldBit__Block_Header
+		ma.s += fieldBit__Block_HeaderCID
 		ma.state = maState_midValue
 		ma.f = 0
-		ma.ca_Header.w = &ma.w.Header
-		ma.ca_Header.m = &ma.cm
-		return &ma.ca_Header, nil
-	case "Transactions":
-		if ma.s&fieldBit__Block_Transactions != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Transactions_serial}
-		}
-		ma.s += fieldBit__Block_Transactions
+		ma.ca_HeaderCID.w = &ma.w.HeaderCID
+		ma.ca_HeaderCID.m = &ma.cm
+		return &ma.ca_HeaderCID, nil
+	case "TransactionsCID":
+		if ma.s&fieldBit__Block_TransactionsCID != 0 {
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_TransactionsCID_serial}
+		}
+		ma.s += fieldBit__Block_TransactionsCID
 		ma.state = maState_midValue
 		ma.f = 1
-		ma.ca_Transactions.w = &ma.w.Transactions
-		ma.ca_Transactions.m = &ma.cm
-		return &ma.ca_Transactions, nil
-	case "Receipts":
-		if ma.s&fieldBit__Block_Receipts != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Receipts_serial}
-		}
-		ma.s += fieldBit__Block_Receipts
+		ma.ca_TransactionsCID.w = &ma.w.TransactionsCID
+		ma.ca_TransactionsCID.m = &ma.cm
+		return &ma.ca_TransactionsCID, nil
+	case "ReceiptsCID":
+		if ma.s&fieldBit__Block_ReceiptsCID != 0 {
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_ReceiptsCID_serial}
+		}
+		ma.s += fieldBit__Block_ReceiptsCID
 		ma.state = maState_midValue
 		ma.f = 2
-		ma.ca_Receipts.w = &ma.w.Receipts
-		ma.ca_Receipts.m = &ma.cm
-		return &ma.ca_Receipts, nil
+		ma.ca_ReceiptsCID.w = &ma.w.ReceiptsCID
+		ma.ca_ReceiptsCID.m = &ma.cm
+		return &ma.ca_ReceiptsCID, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Block.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Block.Repr", Key: &_String{k}}
 }
-func (ma *_Block__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Block__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -4111,7 +4117,7 @@ func (ma *_Block__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Block__ReprKeyAssembler)(ma)
 }
-func (ma *_Block__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Block__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -4127,17 +4133,17 @@ func (ma *_Block__ReprAssembler) AssembleValue() ipld.NodeAssembler {
 	ma.state = maState_midValue
 	switch ma.f {
 	case 0:
-		ma.ca_Header.w = &ma.w.Header
-		ma.ca_Header.m = &ma.cm
-		return &ma.ca_Header
+		ma.ca_HeaderCID.w = &ma.w.HeaderCID
+		ma.ca_HeaderCID.m = &ma.cm
+		return &ma.ca_HeaderCID
 	case 1:
-		ma.ca_Transactions.w = &ma.w.Transactions
-		ma.ca_Transactions.m = &ma.cm
-		return &ma.ca_Transactions
+		ma.ca_TransactionsCID.w = &ma.w.TransactionsCID
+		ma.ca_TransactionsCID.m = &ma.cm
+		return &ma.ca_TransactionsCID
 	case 2:
-		ma.ca_Receipts.w = &ma.w.Receipts
-		ma.ca_Receipts.m = &ma.cm
-		return &ma.ca_Receipts
+		ma.ca_ReceiptsCID.w = &ma.w.ReceiptsCID
+		ma.ca_ReceiptsCID.m = &ma.cm
+		return &ma.ca_ReceiptsCID
 	default:
 		panic("unreachable")
 	}
@@ -4158,15 +4164,15 @@ func (ma *_Block__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Block_sufficient != fieldBits__Block_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
-		if ma.s&fieldBit__Block_Header == 0 {
-			err.Missing = append(err.Missing, "Header")
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
+		if ma.s&fieldBit__Block_HeaderCID == 0 {
+			err.Missing = append(err.Missing, "HeaderCID")
 		}
-		if ma.s&fieldBit__Block_Transactions == 0 {
-			err.Missing = append(err.Missing, "Transactions")
+		if ma.s&fieldBit__Block_TransactionsCID == 0 {
+			err.Missing = append(err.Missing, "TransactionsCID")
 		}
-		if ma.s&fieldBit__Block_Receipts == 0 {
-			err.Missing = append(err.Missing, "Receipts")
+		if ma.s&fieldBit__Block_ReceiptsCID == 0 {
+			err.Missing = append(err.Missing, "ReceiptsCID")
 		}
 		return err
 	}
@@ -4174,19 +4180,19 @@ func (ma *_Block__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Block__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Block__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Block__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Block__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _Block__ReprKeyAssembler _Block__ReprAssembler
 
-func (_Block__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Block__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Block.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_Block__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Block__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Block.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_Block__ReprKeyAssembler) AssignNull() error {
@@ -4206,47 +4212,47 @@ func (ka *_Block__ReprKeyAssembler) AssignString(k string) error {
 		panic("misuse: KeyAssembler held beyond its valid lifetime")
 	}
 	switch k {
-	case "Header":
-		if ka.s&fieldBit__Block_Header != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Header_serial}
+	case "HeaderCID":
+		if ka.s&fieldBit__Block_HeaderCID != 0 {
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_HeaderCID_serial}
 		}
-		ka.s += fieldBit__Block_Header
+		ka.s += fieldBit__Block_HeaderCID
 		ka.state = maState_expectValue
 		ka.f = 0
 		return nil
-	case "Transactions":
-		if ka.s&fieldBit__Block_Transactions != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Transactions_serial}
+	case "TransactionsCID":
+		if ka.s&fieldBit__Block_TransactionsCID != 0 {
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_TransactionsCID_serial}
 		}
-		ka.s += fieldBit__Block_Transactions
+		ka.s += fieldBit__Block_TransactionsCID
 		ka.state = maState_expectValue
 		ka.f = 1
 		return nil
-	case "Receipts":
-		if ka.s&fieldBit__Block_Receipts != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Block_Receipts_serial}
+	case "ReceiptsCID":
+		if ka.s&fieldBit__Block_ReceiptsCID != 0 {
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Block_ReceiptsCID_serial}
 		}
-		ka.s += fieldBit__Block_Receipts
+		ka.s += fieldBit__Block_ReceiptsCID
 		ka.state = maState_expectValue
 		ka.f = 2
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Block.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.Block.Repr", Key: &_String{k}}
 }
 func (_Block__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Block.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Block__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_Block__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Block.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Block__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Block__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Block__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_Block__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -4273,12 +4279,12 @@ func (m MaybeBloom) IsAbsent() bool {
 func (m MaybeBloom) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeBloom) AsNode() ipld.Node {
+func (m MaybeBloom) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -4292,28 +4298,28 @@ func (m MaybeBloom) Must() Bloom {
 	return &m.v
 }
 
-var _ ipld.Node = (Bloom)(&_Bloom{})
+var _ datamodel.Node = (Bloom)(&_Bloom{})
 var _ schema.TypedNode = (Bloom)(&_Bloom{})
 
-func (Bloom) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (Bloom) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (Bloom) LookupByString(string) (ipld.Node, error) {
+func (Bloom) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Bloom"}.LookupByString("")
 }
-func (Bloom) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Bloom) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Bloom"}.LookupByNode(nil)
 }
-func (Bloom) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Bloom) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Bloom"}.LookupByIndex(0)
 }
-func (Bloom) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Bloom) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Bloom"}.LookupBySegment(seg)
 }
-func (Bloom) MapIterator() ipld.MapIterator {
+func (Bloom) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Bloom) ListIterator() ipld.ListIterator {
+func (Bloom) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Bloom) Length() int64 {
@@ -4340,16 +4346,16 @@ func (Bloom) AsString() (string, error) {
 func (n Bloom) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (Bloom) AsLink() (ipld.Link, error) {
+func (Bloom) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.Bloom"}.AsLink()
 }
-func (Bloom) Prototype() ipld.NodePrototype {
+func (Bloom) Prototype() datamodel.NodePrototype {
 	return _Bloom__Prototype{}
 }
 
 type _Bloom__Prototype struct{}
 
-func (_Bloom__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Bloom__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Bloom__Builder
 	nb.Reset()
 	return &nb
@@ -4359,7 +4365,7 @@ type _Bloom__Builder struct {
 	_Bloom__Assembler
 }
 
-func (nb *_Bloom__Builder) Build() ipld.Node {
+func (nb *_Bloom__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -4377,10 +4383,10 @@ type _Bloom__Assembler struct {
 }
 
 func (na *_Bloom__Assembler) reset() {}
-func (_Bloom__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Bloom__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Bloom"}.BeginMap(0)
 }
-func (_Bloom__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Bloom__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Bloom"}.BeginList(0)
 }
 func (na *_Bloom__Assembler) AssignNull() error {
@@ -4416,10 +4422,10 @@ func (na *_Bloom__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_Bloom__Assembler) AssignLink(ipld.Link) error {
+func (_Bloom__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.Bloom"}.AssignLink(nil)
 }
-func (na *_Bloom__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Bloom__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -4438,19 +4444,19 @@ func (na *_Bloom__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_Bloom__Assembler) Prototype() ipld.NodePrototype {
+func (_Bloom__Assembler) Prototype() datamodel.NodePrototype {
 	return _Bloom__Prototype{}
 }
 func (Bloom) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Bloom) Representation() ipld.Node {
+func (n Bloom) Representation() datamodel.Node {
 	return (*_Bloom__Repr)(n)
 }
 
 type _Bloom__Repr = _Bloom
 
-var _ ipld.Node = &_Bloom__Repr{}
+var _ datamodel.Node = &_Bloom__Repr{}
 
 type _Bloom__ReprPrototype = _Bloom__Prototype
 type _Bloom__ReprAssembler = _Bloom__Assembler
@@ -4478,12 +4484,12 @@ func (m MaybeBool) IsAbsent() bool {
 func (m MaybeBool) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeBool) AsNode() ipld.Node {
+func (m MaybeBool) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -4497,28 +4503,28 @@ func (m MaybeBool) Must() Bool {
 	return &m.v
 }
 
-var _ ipld.Node = (Bool)(&_Bool{})
+var _ datamodel.Node = (Bool)(&_Bool{})
 var _ schema.TypedNode = (Bool)(&_Bool{})
 
-func (Bool) Kind() ipld.Kind {
-	return ipld.Kind_Bool
+func (Bool) Kind() datamodel.Kind {
+	return datamodel.Kind_Bool
 }
-func (Bool) LookupByString(string) (ipld.Node, error) {
+func (Bool) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bool{TypeName: "dageth.Bool"}.LookupByString("")
 }
-func (Bool) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Bool) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bool{TypeName: "dageth.Bool"}.LookupByNode(nil)
 }
-func (Bool) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Bool) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bool{TypeName: "dageth.Bool"}.LookupByIndex(0)
 }
-func (Bool) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Bool) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bool{TypeName: "dageth.Bool"}.LookupBySegment(seg)
 }
-func (Bool) MapIterator() ipld.MapIterator {
+func (Bool) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Bool) ListIterator() ipld.ListIterator {
+func (Bool) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Bool) Length() int64 {
@@ -4545,16 +4551,16 @@ func (Bool) AsString() (string, error) {
 func (Bool) AsBytes() ([]byte, error) {
 	return mixins.Bool{TypeName: "dageth.Bool"}.AsBytes()
 }
-func (Bool) AsLink() (ipld.Link, error) {
+func (Bool) AsLink() (datamodel.Link, error) {
 	return mixins.Bool{TypeName: "dageth.Bool"}.AsLink()
 }
-func (Bool) Prototype() ipld.NodePrototype {
+func (Bool) Prototype() datamodel.NodePrototype {
 	return _Bool__Prototype{}
 }
 
 type _Bool__Prototype struct{}
 
-func (_Bool__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Bool__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Bool__Builder
 	nb.Reset()
 	return &nb
@@ -4564,7 +4570,7 @@ type _Bool__Builder struct {
 	_Bool__Assembler
 }
 
-func (nb *_Bool__Builder) Build() ipld.Node {
+func (nb *_Bool__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -4582,10 +4588,10 @@ type _Bool__Assembler struct {
 }
 
 func (na *_Bool__Assembler) reset() {}
-func (_Bool__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Bool__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BoolAssembler{TypeName: "dageth.Bool"}.BeginMap(0)
 }
-func (_Bool__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Bool__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BoolAssembler{TypeName: "dageth.Bool"}.BeginList(0)
 }
 func (na *_Bool__Assembler) AssignNull() error {
@@ -4621,10 +4627,10 @@ func (_Bool__Assembler) AssignString(string) error {
 func (_Bool__Assembler) AssignBytes([]byte) error {
 	return mixins.BoolAssembler{TypeName: "dageth.Bool"}.AssignBytes(nil)
 }
-func (_Bool__Assembler) AssignLink(ipld.Link) error {
+func (_Bool__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BoolAssembler{TypeName: "dageth.Bool"}.AssignLink(nil)
 }
-func (na *_Bool__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Bool__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -4643,19 +4649,19 @@ func (na *_Bool__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBool(v2)
 	}
 }
-func (_Bool__Assembler) Prototype() ipld.NodePrototype {
+func (_Bool__Assembler) Prototype() datamodel.NodePrototype {
 	return _Bool__Prototype{}
 }
 func (Bool) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Bool) Representation() ipld.Node {
+func (n Bool) Representation() datamodel.Node {
 	return (*_Bool__Repr)(n)
 }
 
 type _Bool__Repr = _Bool
 
-var _ ipld.Node = &_Bool__Repr{}
+var _ datamodel.Node = &_Bool__Repr{}
 
 type _Bool__ReprPrototype = _Bool__Prototype
 type _Bool__ReprAssembler = _Bool__Assembler
@@ -4683,12 +4689,12 @@ func (m MaybeByteCode) IsAbsent() bool {
 func (m MaybeByteCode) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeByteCode) AsNode() ipld.Node {
+func (m MaybeByteCode) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -4702,28 +4708,28 @@ func (m MaybeByteCode) Must() ByteCode {
 	return &m.v
 }
 
-var _ ipld.Node = (ByteCode)(&_ByteCode{})
+var _ datamodel.Node = (ByteCode)(&_ByteCode{})
 var _ schema.TypedNode = (ByteCode)(&_ByteCode{})
 
-func (ByteCode) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (ByteCode) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (ByteCode) LookupByString(string) (ipld.Node, error) {
+func (ByteCode) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.ByteCode"}.LookupByString("")
 }
-func (ByteCode) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (ByteCode) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.ByteCode"}.LookupByNode(nil)
 }
-func (ByteCode) LookupByIndex(idx int64) (ipld.Node, error) {
+func (ByteCode) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.ByteCode"}.LookupByIndex(0)
 }
-func (ByteCode) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (ByteCode) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.ByteCode"}.LookupBySegment(seg)
 }
-func (ByteCode) MapIterator() ipld.MapIterator {
+func (ByteCode) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (ByteCode) ListIterator() ipld.ListIterator {
+func (ByteCode) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (ByteCode) Length() int64 {
@@ -4750,16 +4756,16 @@ func (ByteCode) AsString() (string, error) {
 func (n ByteCode) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (ByteCode) AsLink() (ipld.Link, error) {
+func (ByteCode) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.ByteCode"}.AsLink()
 }
-func (ByteCode) Prototype() ipld.NodePrototype {
+func (ByteCode) Prototype() datamodel.NodePrototype {
 	return _ByteCode__Prototype{}
 }
 
 type _ByteCode__Prototype struct{}
 
-func (_ByteCode__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_ByteCode__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _ByteCode__Builder
 	nb.Reset()
 	return &nb
@@ -4769,7 +4775,7 @@ type _ByteCode__Builder struct {
 	_ByteCode__Assembler
 }
 
-func (nb *_ByteCode__Builder) Build() ipld.Node {
+func (nb *_ByteCode__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -4787,10 +4793,10 @@ type _ByteCode__Assembler struct {
 }
 
 func (na *_ByteCode__Assembler) reset() {}
-func (_ByteCode__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_ByteCode__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.ByteCode"}.BeginMap(0)
 }
-func (_ByteCode__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_ByteCode__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.ByteCode"}.BeginList(0)
 }
 func (na *_ByteCode__Assembler) AssignNull() error {
@@ -4826,10 +4832,10 @@ func (na *_ByteCode__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_ByteCode__Assembler) AssignLink(ipld.Link) error {
+func (_ByteCode__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.ByteCode"}.AssignLink(nil)
 }
-func (na *_ByteCode__Assembler) AssignNode(v ipld.Node) error {
+func (na *_ByteCode__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -4848,19 +4854,19 @@ func (na *_ByteCode__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_ByteCode__Assembler) Prototype() ipld.NodePrototype {
+func (_ByteCode__Assembler) Prototype() datamodel.NodePrototype {
 	return _ByteCode__Prototype{}
 }
 func (ByteCode) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n ByteCode) Representation() ipld.Node {
+func (n ByteCode) Representation() datamodel.Node {
 	return (*_ByteCode__Repr)(n)
 }
 
 type _ByteCode__Repr = _ByteCode
 
-var _ ipld.Node = &_ByteCode__Repr{}
+var _ datamodel.Node = &_ByteCode__Repr{}
 
 type _ByteCode__ReprPrototype = _ByteCode__Prototype
 type _ByteCode__ReprAssembler = _ByteCode__Assembler
@@ -4888,12 +4894,12 @@ func (m MaybeBytes) IsAbsent() bool {
 func (m MaybeBytes) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeBytes) AsNode() ipld.Node {
+func (m MaybeBytes) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -4907,28 +4913,28 @@ func (m MaybeBytes) Must() Bytes {
 	return &m.v
 }
 
-var _ ipld.Node = (Bytes)(&_Bytes{})
+var _ datamodel.Node = (Bytes)(&_Bytes{})
 var _ schema.TypedNode = (Bytes)(&_Bytes{})
 
-func (Bytes) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (Bytes) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (Bytes) LookupByString(string) (ipld.Node, error) {
+func (Bytes) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Bytes"}.LookupByString("")
 }
-func (Bytes) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Bytes) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Bytes"}.LookupByNode(nil)
 }
-func (Bytes) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Bytes) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Bytes"}.LookupByIndex(0)
 }
-func (Bytes) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Bytes) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Bytes"}.LookupBySegment(seg)
 }
-func (Bytes) MapIterator() ipld.MapIterator {
+func (Bytes) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Bytes) ListIterator() ipld.ListIterator {
+func (Bytes) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Bytes) Length() int64 {
@@ -4955,16 +4961,16 @@ func (Bytes) AsString() (string, error) {
 func (n Bytes) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (Bytes) AsLink() (ipld.Link, error) {
+func (Bytes) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.Bytes"}.AsLink()
 }
-func (Bytes) Prototype() ipld.NodePrototype {
+func (Bytes) Prototype() datamodel.NodePrototype {
 	return _Bytes__Prototype{}
 }
 
 type _Bytes__Prototype struct{}
 
-func (_Bytes__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Bytes__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Bytes__Builder
 	nb.Reset()
 	return &nb
@@ -4974,7 +4980,7 @@ type _Bytes__Builder struct {
 	_Bytes__Assembler
 }
 
-func (nb *_Bytes__Builder) Build() ipld.Node {
+func (nb *_Bytes__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -4992,10 +4998,10 @@ type _Bytes__Assembler struct {
 }
 
 func (na *_Bytes__Assembler) reset() {}
-func (_Bytes__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Bytes__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Bytes"}.BeginMap(0)
 }
-func (_Bytes__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Bytes__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Bytes"}.BeginList(0)
 }
 func (na *_Bytes__Assembler) AssignNull() error {
@@ -5031,10 +5037,10 @@ func (na *_Bytes__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_Bytes__Assembler) AssignLink(ipld.Link) error {
+func (_Bytes__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.Bytes"}.AssignLink(nil)
 }
-func (na *_Bytes__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Bytes__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -5053,32 +5059,25 @@ func (na *_Bytes__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_Bytes__Assembler) Prototype() ipld.NodePrototype {
+func (_Bytes__Assembler) Prototype() datamodel.NodePrototype {
 	return _Bytes__Prototype{}
 }
 func (Bytes) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Bytes) Representation() ipld.Node {
+func (n Bytes) Representation() datamodel.Node {
 	return (*_Bytes__Repr)(n)
 }
 
 type _Bytes__Repr = _Bytes
 
-var _ ipld.Node = &_Bytes__Repr{}
+var _ datamodel.Node = &_Bytes__Repr{}
 
 type _Bytes__ReprPrototype = _Bytes__Prototype
 type _Bytes__ReprAssembler = _Bytes__Assembler
 
 func (n _Child) AsInterface() _Child__iface {
-	switch n.tag {
-	case 1:
-		return &n.x1
-	case 2:
-		return &n.x2
-	default:
-		panic("invalid union state; how did you create this object?")
-	}
+	return n.x
 }
 
 type _Child__Maybe struct {
@@ -5096,12 +5095,12 @@ func (m MaybeChild) IsAbsent() bool {
 func (m MaybeChild) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeChild) AsNode() ipld.Node {
+func (m MaybeChild) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -5119,42 +5118,44 @@ var (
 	memberName__Child_Link     = _String{"Link"}
 	memberName__Child_TrieNode = _String{"TrieNode"}
 )
-var _ ipld.Node = (Child)(&_Child{})
+var _ datamodel.Node = (Child)(&_Child{})
 var _ schema.TypedNode = (Child)(&_Child{})
 
-func (Child) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Child) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Child) LookupByString(key string) (ipld.Node, error) {
+func (n Child) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Link":
-		if n.tag != 1 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+		if n2, ok := n.x.(Link); ok {
+			return n2, nil
+		} else {
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
-		return &n.x1, nil
 	case "TrieNode":
-		if n.tag != 2 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+		if n2, ok := n.x.(TrieNode); ok {
+			return n2, nil
+		} else {
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
-		return &n.x2, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Child) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Child) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Child) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Child) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Child"}.LookupByIndex(0)
 }
-func (n Child) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Child) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Child) MapIterator() ipld.MapIterator {
+func (n Child) MapIterator() datamodel.MapIterator {
 	return &_Child__MapItr{n, false}
 }
 
@@ -5163,15 +5164,15 @@ type _Child__MapItr struct {
 	done bool
 }
 
-func (itr *_Child__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Child__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.done {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
-	switch itr.n.tag {
-	case 1:
-		k, v = &memberName__Child_Link, &itr.n.x1
-	case 2:
-		k, v = &memberName__Child_TrieNode, &itr.n.x2
+	switch n2 := itr.n.x.(type) {
+	case Link:
+		k, v = &memberName__Child_Link, n2
+	case TrieNode:
+		k, v = &memberName__Child_TrieNode, n2
 	default:
 		panic("unreachable")
 	}
@@ -5182,7 +5183,7 @@ func (itr *_Child__MapItr) Done() bool {
 	return itr.done
 }
 
-func (Child) ListIterator() ipld.ListIterator {
+func (Child) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Child) Length() int64 {
@@ -5209,16 +5210,16 @@ func (Child) AsString() (string, error) {
 func (Child) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Child"}.AsBytes()
 }
-func (Child) AsLink() (ipld.Link, error) {
+func (Child) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Child"}.AsLink()
 }
-func (Child) Prototype() ipld.NodePrototype {
+func (Child) Prototype() datamodel.NodePrototype {
 	return _Child__Prototype{}
 }
 
 type _Child__Prototype struct{}
 
-func (_Child__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Child__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Child__Builder
 	nb.Reset()
 	return &nb
@@ -5228,7 +5229,7 @@ type _Child__Builder struct {
 	_Child__Assembler
 }
 
-func (nb *_Child__Builder) Build() ipld.Node {
+func (nb *_Child__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -5246,7 +5247,7 @@ type _Child__Assembler struct {
 	state maState
 
 	cm  schema.Maybe
-	ca1 _Link__Assembler
+	ca1 *_Link__Assembler
 
 	ca2 *_TrieNode__Assembler
 	ca  uint
@@ -5268,7 +5269,7 @@ func (na *_Child__Assembler) reset() {
 	na.ca = 0
 	na.cm = schema.Maybe_Absent
 }
-func (na *_Child__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Child__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -5281,7 +5282,7 @@ func (na *_Child__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Child__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Child__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Child"}.BeginList(0)
 }
 func (na *_Child__Assembler) AssignNull() error {
@@ -5313,10 +5314,10 @@ func (_Child__Assembler) AssignString(string) error {
 func (_Child__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Child"}.AssignBytes(nil)
 }
-func (_Child__Assembler) AssignLink(ipld.Link) error {
+func (_Child__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Child"}.AssignLink(nil)
 }
-func (na *_Child__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Child__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -5336,8 +5337,8 @@ func (na *_Child__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Child", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Child", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -5354,7 +5355,7 @@ func (na *_Child__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Child__Assembler) Prototype() ipld.NodePrototype {
+func (_Child__Assembler) Prototype() datamodel.NodePrototype {
 	return _Child__Prototype{}
 }
 func (ma *_Child__Assembler) valueFinishTidy() bool {
@@ -5366,7 +5367,7 @@ func (ma *_Child__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (ma *_Child__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Child__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -5388,21 +5389,29 @@ func (ma *_Child__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 	case "Link":
 		ma.state = maState_midValue
 		ma.ca = 1
-		ma.w.tag = 1
-		ma.ca1.w = &ma.w.x1
+		x := &_Link{}
+		ma.w.x = x
+		if ma.ca1 == nil {
+			ma.ca1 = &_Link__Assembler{}
+		}
+		ma.ca1.w = x
 		ma.ca1.m = &ma.cm
-		return &ma.ca1, nil
+		return ma.ca1, nil
 	case "TrieNode":
 		ma.state = maState_midValue
 		ma.ca = 2
-		ma.w.tag = 2
-		ma.ca2.w = &ma.w.x2
+		x := &_TrieNode{}
+		ma.w.x = x
+		if ma.ca2 == nil {
+			ma.ca2 = &_TrieNode__Assembler{}
+		}
+		ma.ca2.w = x
 		ma.ca2.m = &ma.cm
 		return ma.ca2, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Child", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Child", Key: &_String{k}}
 }
-func (ma *_Child__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Child__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -5420,7 +5429,7 @@ func (ma *_Child__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Child__KeyAssembler)(ma)
 }
-func (ma *_Child__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Child__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -5436,14 +5445,21 @@ func (ma *_Child__Assembler) AssembleValue() ipld.NodeAssembler {
 	ma.state = maState_midValue
 	switch ma.ca {
 	case 1:
-		ma.ca1.w = &ma.w.x1
+		x := &_Link{}
+		ma.w.x = x
+		if ma.ca1 == nil {
+			ma.ca1 = &_Link__Assembler{}
+		}
+		ma.ca1.w = x
 		ma.ca1.m = &ma.cm
-		return &ma.ca1
+		return ma.ca1
 	case 2:
+		x := &_TrieNode{}
+		ma.w.x = x
 		if ma.ca2 == nil {
-			ma.ca2 = new(_TrieNode__Assembler)
+			ma.ca2 = &_TrieNode__Assembler{}
 		}
-		ma.ca2.w = &ma.w.x2
+		ma.ca2.w = x
 		ma.ca2.m = &ma.cm
 		return ma.ca2
 	default:
@@ -5472,10 +5488,10 @@ func (ma *_Child__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Child__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Child__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Child__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Child__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	switch k {
 	case "Link":
 		return _Link__Prototype{}
@@ -5488,10 +5504,10 @@ func (ma *_Child__Assembler) ValuePrototype(k string) ipld.NodePrototype {
 
 type _Child__KeyAssembler _Child__Assembler
 
-func (_Child__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Child__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Child.KeyAssembler"}.BeginMap(0)
 }
-func (_Child__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Child__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Child.KeyAssembler"}.BeginList(0)
 }
 func (na *_Child__KeyAssembler) AssignNull() error {
@@ -5516,96 +5532,94 @@ func (ka *_Child__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Link":
 		ka.ca = 1
-		ka.w.tag = 1
 		ka.state = maState_expectValue
 		return nil
 	case "TrieNode":
 		ka.ca = 2
-		ka.w.tag = 2
 		ka.state = maState_expectValue
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Child", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	return schema.ErrInvalidKey{TypeName: "dageth.Child", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
 }
 func (_Child__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Child.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Child__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Child__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Child.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Child__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Child__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Child__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Child__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Child) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Child) Representation() ipld.Node {
+func (n Child) Representation() datamodel.Node {
 	return (*_Child__Repr)(n)
 }
 
 type _Child__Repr _Child
 
-var _ ipld.Node = &_Child__Repr{}
+var _ datamodel.Node = &_Child__Repr{}
 
-func (n *_Child__Repr) Kind() ipld.Kind {
-	switch n.tag {
-	case 1:
-		return ipld.Kind_Link
-	case 2:
-		return ipld.Kind_Map
+func (n *_Child__Repr) Kind() datamodel.Kind {
+	switch n.x.(type) {
+	case Link:
+		return datamodel.Kind_Link
+	case TrieNode:
+		return datamodel.Kind_Map
 	default:
 		panic("unreachable")
 	}
 }
-func (n *_Child__Repr) LookupByString(key string) (ipld.Node, error) {
-	switch n.tag {
-	case 2:
-		return n.x2.Representation().LookupByString(key)
+func (n *_Child__Repr) LookupByString(key string) (datamodel.Node, error) {
+	switch n2 := n.x.(type) {
+	case TrieNode:
+		return n2.Representation().LookupByString(key)
 	default:
-		return nil, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "LookupByString", AppropriateKind: ipld.KindSet_JustMap, ActualKind: n.Kind()}
+		return nil, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "LookupByString", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: n.Kind()}
 	}
 }
-func (n *_Child__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
-	switch n.tag {
-	case 2:
-		return n.x2.Representation().LookupByNode(key)
+func (n *_Child__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
+	switch n2 := n.x.(type) {
+	case TrieNode:
+		return n2.Representation().LookupByNode(key)
 	default:
-		return nil, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "LookupByNode", AppropriateKind: ipld.KindSet_Recursive, ActualKind: n.Kind()}
+		return nil, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "LookupByNode", AppropriateKind: datamodel.KindSet_Recursive, ActualKind: n.Kind()}
 	}
 }
-func (n *_Child__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
-	return nil, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "LookupByIndex", AppropriateKind: ipld.KindSet_JustList, ActualKind: n.Kind()}
+func (n *_Child__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
+	return nil, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "LookupByIndex", AppropriateKind: datamodel.KindSet_JustList, ActualKind: n.Kind()}
 }
-func (n *_Child__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
-	switch n.tag {
-	case 2:
-		return n.x2.Representation().LookupBySegment(seg)
+func (n *_Child__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
+	switch n2 := n.x.(type) {
+	case TrieNode:
+		return n2.Representation().LookupBySegment(seg)
 	default:
-		return nil, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "LookupBySegment", AppropriateKind: ipld.KindSet_Recursive, ActualKind: n.Kind()}
+		return nil, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "LookupBySegment", AppropriateKind: datamodel.KindSet_Recursive, ActualKind: n.Kind()}
 	}
 }
-func (n *_Child__Repr) MapIterator() ipld.MapIterator {
-	switch n.tag {
-	case 2:
-		return n.x2.Representation().MapIterator()
+func (n *_Child__Repr) MapIterator() datamodel.MapIterator {
+	switch n2 := n.x.(type) {
+	case TrieNode:
+		return n2.Representation().MapIterator()
 	default:
 		return nil
 	}
 }
-func (n *_Child__Repr) ListIterator() ipld.ListIterator {
+func (n *_Child__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (n *_Child__Repr) Length() int64 {
-	switch n.tag {
-	case 2:
-		return n.x2.Representation().Length()
+	switch n2 := n.x.(type) {
+	case TrieNode:
+		return n2.Representation().Length()
 	default:
 		return -1
 	}
@@ -5617,35 +5631,35 @@ func (n *_Child__Repr) IsNull() bool {
 	return false
 }
 func (n *_Child__Repr) AsBool() (bool, error) {
-	return false, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsBool", AppropriateKind: ipld.KindSet_JustBool, ActualKind: n.Kind()}
+	return false, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsBool", AppropriateKind: datamodel.KindSet_JustBool, ActualKind: n.Kind()}
 }
 func (n *_Child__Repr) AsInt() (int64, error) {
-	return 0, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsInt", AppropriateKind: ipld.KindSet_JustInt, ActualKind: n.Kind()}
+	return 0, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsInt", AppropriateKind: datamodel.KindSet_JustInt, ActualKind: n.Kind()}
 }
 func (n *_Child__Repr) AsFloat() (float64, error) {
-	return 0, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsFloat", AppropriateKind: ipld.KindSet_JustFloat, ActualKind: n.Kind()}
+	return 0, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsFloat", AppropriateKind: datamodel.KindSet_JustFloat, ActualKind: n.Kind()}
 }
 func (n *_Child__Repr) AsString() (string, error) {
-	return "", ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsString", AppropriateKind: ipld.KindSet_JustString, ActualKind: n.Kind()}
+	return "", datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsString", AppropriateKind: datamodel.KindSet_JustString, ActualKind: n.Kind()}
 }
 func (n *_Child__Repr) AsBytes() ([]byte, error) {
-	return nil, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsBytes", AppropriateKind: ipld.KindSet_JustBytes, ActualKind: n.Kind()}
+	return nil, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsBytes", AppropriateKind: datamodel.KindSet_JustBytes, ActualKind: n.Kind()}
 }
-func (n *_Child__Repr) AsLink() (ipld.Link, error) {
-	switch n.tag {
-	case 1:
-		return n.x1.Representation().AsLink()
+func (n *_Child__Repr) AsLink() (datamodel.Link, error) {
+	switch n2 := n.x.(type) {
+	case Link:
+		return n2.Representation().AsLink()
 	default:
-		return nil, ipld.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsLink", AppropriateKind: ipld.KindSet_JustLink, ActualKind: n.Kind()}
+		return nil, datamodel.ErrWrongKind{TypeName: "dageth.Child.Repr", MethodName: "AsLink", AppropriateKind: datamodel.KindSet_JustLink, ActualKind: n.Kind()}
 	}
 }
-func (_Child__Repr) Prototype() ipld.NodePrototype {
+func (_Child__Repr) Prototype() datamodel.NodePrototype {
 	return _Child__ReprPrototype{}
 }
 
 type _Child__ReprPrototype struct{}
 
-func (_Child__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Child__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Child__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -5655,7 +5669,7 @@ type _Child__ReprBuilder struct {
 	_Child__ReprAssembler
 }
 
-func (nb *_Child__ReprBuilder) Build() ipld.Node {
+func (nb *_Child__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -5670,7 +5684,7 @@ func (nb *_Child__ReprBuilder) Reset() {
 type _Child__ReprAssembler struct {
 	w   *_Child
 	m   *schema.Maybe
-	ca1 _Link__ReprAssembler
+	ca1 *_Link__ReprAssembler
 	ca2 *_TrieNode__ReprAssembler
 	ca  uint
 }
@@ -5688,7 +5702,7 @@ func (na *_Child__ReprAssembler) reset() {
 	}
 	na.ca = 0
 }
-func (na *_Child__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (na *_Child__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -5699,12 +5713,16 @@ func (na *_Child__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, er
 		na.w = &_Child{}
 	}
 	na.ca = 2
-	na.w.tag = 2
-	na.ca2.w = &na.w.x2
+	x := &_TrieNode{}
+	na.w.x = x
+	if na.ca2 == nil {
+		na.ca2 = &_TrieNode__ReprAssembler{}
+	}
+	na.ca2.w = x
 	na.ca2.m = na.m
 	return na.ca2.BeginMap(sizeHint)
 }
-func (na *_Child__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Child__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -5767,7 +5785,7 @@ func (na *_Child__ReprAssembler) AssignBytes(v []byte) error {
 	}
 	return schema.ErrNotUnionStructure{TypeName: "dageth.Child.Repr", Detail: "AssignBytes called but is not valid for any of the kinds that are valid members of this union"}
 }
-func (na *_Child__ReprAssembler) AssignLink(v ipld.Link) error {
+func (na *_Child__ReprAssembler) AssignLink(v datamodel.Link) error {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -5778,12 +5796,16 @@ func (na *_Child__ReprAssembler) AssignLink(v ipld.Link) error {
 		na.w = &_Child{}
 	}
 	na.ca = 1
-	na.w.tag = 1
-	na.ca1.w = &na.w.x1
+	x := &_Link{}
+	na.w.x = x
+	if na.ca1 == nil {
+		na.ca1 = &_Link__ReprAssembler{}
+	}
+	na.ca1.w = x
 	na.ca1.m = na.m
 	return na.ca1.AssignLink(v)
 }
-func (na *_Child__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Child__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -5804,22 +5826,22 @@ func (na *_Child__ReprAssembler) AssignNode(v ipld.Node) error {
 		return nil
 	}
 	switch v.Kind() {
-	case ipld.Kind_Bool:
+	case datamodel.Kind_Bool:
 		v2, _ := v.AsBool()
 		return na.AssignBool(v2)
-	case ipld.Kind_Int:
+	case datamodel.Kind_Int:
 		v2, _ := v.AsInt()
 		return na.AssignInt(v2)
-	case ipld.Kind_Float:
+	case datamodel.Kind_Float:
 		v2, _ := v.AsFloat()
 		return na.AssignFloat(v2)
-	case ipld.Kind_String:
+	case datamodel.Kind_String:
 		v2, _ := v.AsString()
 		return na.AssignString(v2)
-	case ipld.Kind_Bytes:
+	case datamodel.Kind_Bytes:
 		v2, _ := v.AsBytes()
 		return na.AssignBytes(v2)
-	case ipld.Kind_Map:
+	case datamodel.Kind_Map:
 		na, err := na.BeginMap(v.Length())
 		if err != nil {
 			return err
@@ -5838,7 +5860,7 @@ func (na *_Child__ReprAssembler) AssignNode(v ipld.Node) error {
 			}
 		}
 		return na.Finish()
-	case ipld.Kind_List:
+	case datamodel.Kind_List:
 		na, err := na.BeginList(v.Length())
 		if err != nil {
 			return err
@@ -5854,14 +5876,14 @@ func (na *_Child__ReprAssembler) AssignNode(v ipld.Node) error {
 			}
 		}
 		return na.Finish()
-	case ipld.Kind_Link:
+	case datamodel.Kind_Link:
 		v2, _ := v.AsLink()
 		return na.AssignLink(v2)
 	default:
 		panic("unreachable")
 	}
 }
-func (na *_Child__ReprAssembler) Prototype() ipld.NodePrototype {
+func (na *_Child__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Child__ReprPrototype{}
 }
 
@@ -5905,12 +5927,12 @@ func (m MaybeFrame) IsAbsent() bool {
 func (m MaybeFrame) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeFrame) AsNode() ipld.Node {
+func (m MaybeFrame) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -5934,13 +5956,13 @@ var (
 	fieldName__Frame_Cost   = _String{"Cost"}
 	fieldName__Frame_Value  = _String{"Value"}
 )
-var _ ipld.Node = (Frame)(&_Frame{})
+var _ datamodel.Node = (Frame)(&_Frame{})
 var _ schema.TypedNode = (Frame)(&_Frame{})
 
-func (Frame) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Frame) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Frame) LookupByString(key string) (ipld.Node, error) {
+func (n Frame) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Op":
 		return &n.Op, nil
@@ -5959,23 +5981,23 @@ func (n Frame) LookupByString(key string) (ipld.Node, error) {
 	case "Value":
 		return &n.Value, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Frame) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Frame) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Frame) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Frame) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Frame"}.LookupByIndex(0)
 }
-func (n Frame) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Frame) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Frame) MapIterator() ipld.MapIterator {
+func (n Frame) MapIterator() datamodel.MapIterator {
 	return &_Frame__MapItr{n, 0}
 }
 
@@ -5984,9 +6006,9 @@ type _Frame__MapItr struct {
 	idx int
 }
 
-func (itr *_Frame__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Frame__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 8 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -6023,7 +6045,7 @@ func (itr *_Frame__MapItr) Done() bool {
 	return itr.idx >= 8
 }
 
-func (Frame) ListIterator() ipld.ListIterator {
+func (Frame) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Frame) Length() int64 {
@@ -6050,16 +6072,16 @@ func (Frame) AsString() (string, error) {
 func (Frame) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Frame"}.AsBytes()
 }
-func (Frame) AsLink() (ipld.Link, error) {
+func (Frame) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Frame"}.AsLink()
 }
-func (Frame) Prototype() ipld.NodePrototype {
+func (Frame) Prototype() datamodel.NodePrototype {
 	return _Frame__Prototype{}
 }
 
 type _Frame__Prototype struct{}
 
-func (_Frame__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Frame__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Frame__Builder
 	nb.Reset()
 	return &nb
@@ -6069,7 +6091,7 @@ type _Frame__Builder struct {
 	_Frame__Assembler
 }
 
-func (nb *_Frame__Builder) Build() ipld.Node {
+func (nb *_Frame__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -6124,7 +6146,7 @@ var (
 	fieldBits__Frame_sufficient = 0 + 1<<0 + 1<<1 + 1<<2 + 1<<3 + 1<<4 + 1<<5 + 1<<6 + 1<<7
 )
 
-func (na *_Frame__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Frame__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -6137,7 +6159,7 @@ func (na *_Frame__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Frame__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Frame__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Frame"}.BeginList(0)
 }
 func (na *_Frame__Assembler) AssignNull() error {
@@ -6169,10 +6191,10 @@ func (_Frame__Assembler) AssignString(string) error {
 func (_Frame__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Frame"}.AssignBytes(nil)
 }
-func (_Frame__Assembler) AssignLink(ipld.Link) error {
+func (_Frame__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Frame"}.AssignLink(nil)
 }
-func (na *_Frame__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Frame__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -6192,8 +6214,8 @@ func (na *_Frame__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Frame", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Frame", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -6210,7 +6232,7 @@ func (na *_Frame__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Frame__Assembler) Prototype() ipld.NodePrototype {
+func (_Frame__Assembler) Prototype() datamodel.NodePrototype {
 	return _Frame__Prototype{}
 }
 func (ma *_Frame__Assembler) valueFinishTidy() bool {
@@ -6299,7 +6321,7 @@ func (ma *_Frame__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Frame__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -6317,7 +6339,7 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 	switch k {
 	case "Op":
 		if ma.s&fieldBit__Frame_Op != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Op}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Op}
 		}
 		ma.s += fieldBit__Frame_Op
 		ma.state = maState_midValue
@@ -6327,7 +6349,7 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		return &ma.ca_Op, nil
 	case "From":
 		if ma.s&fieldBit__Frame_From != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_From}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_From}
 		}
 		ma.s += fieldBit__Frame_From
 		ma.state = maState_midValue
@@ -6337,7 +6359,7 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		return &ma.ca_From, nil
 	case "To":
 		if ma.s&fieldBit__Frame_To != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_To}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_To}
 		}
 		ma.s += fieldBit__Frame_To
 		ma.state = maState_midValue
@@ -6347,7 +6369,7 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		return &ma.ca_To, nil
 	case "Input":
 		if ma.s&fieldBit__Frame_Input != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Input}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Input}
 		}
 		ma.s += fieldBit__Frame_Input
 		ma.state = maState_midValue
@@ -6357,7 +6379,7 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		return &ma.ca_Input, nil
 	case "Output":
 		if ma.s&fieldBit__Frame_Output != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Output}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Output}
 		}
 		ma.s += fieldBit__Frame_Output
 		ma.state = maState_midValue
@@ -6367,7 +6389,7 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		return &ma.ca_Output, nil
 	case "Gas":
 		if ma.s&fieldBit__Frame_Gas != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Gas}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Gas}
 		}
 		ma.s += fieldBit__Frame_Gas
 		ma.state = maState_midValue
@@ -6377,7 +6399,7 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		return &ma.ca_Gas, nil
 	case "Cost":
 		if ma.s&fieldBit__Frame_Cost != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Cost}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Cost}
 		}
 		ma.s += fieldBit__Frame_Cost
 		ma.state = maState_midValue
@@ -6387,7 +6409,7 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		return &ma.ca_Cost, nil
 	case "Value":
 		if ma.s&fieldBit__Frame_Value != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Value}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Value}
 		}
 		ma.s += fieldBit__Frame_Value
 		ma.state = maState_midValue
@@ -6396,9 +6418,9 @@ func (ma *_Frame__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		ma.ca_Value.m = &ma.cm
 		return &ma.ca_Value, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Frame", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Frame", Key: &_String{k}}
 }
-func (ma *_Frame__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Frame__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -6416,7 +6438,7 @@ func (ma *_Frame__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Frame__KeyAssembler)(ma)
 }
-func (ma *_Frame__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Frame__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -6483,7 +6505,7 @@ func (ma *_Frame__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Frame_sufficient != fieldBits__Frame_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Frame_Op == 0 {
 			err.Missing = append(err.Missing, "Op")
 		}
@@ -6514,19 +6536,19 @@ func (ma *_Frame__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Frame__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Frame__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Frame__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Frame__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _Frame__KeyAssembler _Frame__Assembler
 
-func (_Frame__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Frame__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Frame.KeyAssembler"}.BeginMap(0)
 }
-func (_Frame__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Frame__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Frame.KeyAssembler"}.BeginList(0)
 }
 func (na *_Frame__KeyAssembler) AssignNull() error {
@@ -6548,85 +6570,92 @@ func (ka *_Frame__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Op":
 		if ka.s&fieldBit__Frame_Op != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Op}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Op}
 		}
 		ka.s += fieldBit__Frame_Op
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "From":
 		if ka.s&fieldBit__Frame_From != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_From}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_From}
 		}
 		ka.s += fieldBit__Frame_From
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	case "To":
 		if ka.s&fieldBit__Frame_To != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_To}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_To}
 		}
 		ka.s += fieldBit__Frame_To
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	case "Input":
 		if ka.s&fieldBit__Frame_Input != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Input}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Input}
 		}
 		ka.s += fieldBit__Frame_Input
 		ka.state = maState_expectValue
 		ka.f = 3
+		return nil
 	case "Output":
 		if ka.s&fieldBit__Frame_Output != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Output}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Output}
 		}
 		ka.s += fieldBit__Frame_Output
 		ka.state = maState_expectValue
 		ka.f = 4
+		return nil
 	case "Gas":
 		if ka.s&fieldBit__Frame_Gas != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Gas}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Gas}
 		}
 		ka.s += fieldBit__Frame_Gas
 		ka.state = maState_expectValue
 		ka.f = 5
+		return nil
 	case "Cost":
 		if ka.s&fieldBit__Frame_Cost != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Cost}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Cost}
 		}
 		ka.s += fieldBit__Frame_Cost
 		ka.state = maState_expectValue
 		ka.f = 6
+		return nil
 	case "Value":
 		if ka.s&fieldBit__Frame_Value != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Value}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Value}
 		}
 		ka.s += fieldBit__Frame_Value
 		ka.state = maState_expectValue
 		ka.f = 7
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.Frame", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.Frame", Key: &_String{k}}
 	}
-	return nil
 }
 func (_Frame__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Frame.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Frame__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Frame__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Frame.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Frame__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Frame__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Frame__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Frame__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Frame) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Frame) Representation() ipld.Node {
+func (n Frame) Representation() datamodel.Node {
 	return (*_Frame__Repr)(n)
 }
 
@@ -6642,12 +6671,12 @@ var (
 	fieldName__Frame_Cost_serial   = _String{"Cost"}
 	fieldName__Frame_Value_serial  = _String{"Value"}
 )
-var _ ipld.Node = &_Frame__Repr{}
+var _ datamodel.Node = &_Frame__Repr{}
 
-func (_Frame__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_Frame__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_Frame__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_Frame__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Op":
 		return n.Op.Representation(), nil
@@ -6666,23 +6695,23 @@ func (n *_Frame__Repr) LookupByString(key string) (ipld.Node, error) {
 	case "Value":
 		return n.Value.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_Frame__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_Frame__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_Frame__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_Frame__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Frame.Repr"}.LookupByIndex(0)
 }
-func (n _Frame__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Frame__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_Frame__Repr) MapIterator() ipld.MapIterator {
+func (n *_Frame__Repr) MapIterator() datamodel.MapIterator {
 	return &_Frame__ReprMapItr{n, 0}
 }
 
@@ -6691,9 +6720,9 @@ type _Frame__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_Frame__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Frame__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 8 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -6729,7 +6758,7 @@ func (itr *_Frame__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 func (itr *_Frame__ReprMapItr) Done() bool {
 	return itr.idx >= 8
 }
-func (_Frame__Repr) ListIterator() ipld.ListIterator {
+func (_Frame__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_Frame__Repr) Length() int64 {
@@ -6757,16 +6786,16 @@ func (_Frame__Repr) AsString() (string, error) {
 func (_Frame__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Frame.Repr"}.AsBytes()
 }
-func (_Frame__Repr) AsLink() (ipld.Link, error) {
+func (_Frame__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Frame.Repr"}.AsLink()
 }
-func (_Frame__Repr) Prototype() ipld.NodePrototype {
+func (_Frame__Repr) Prototype() datamodel.NodePrototype {
 	return _Frame__ReprPrototype{}
 }
 
 type _Frame__ReprPrototype struct{}
 
-func (_Frame__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Frame__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Frame__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -6776,7 +6805,7 @@ type _Frame__ReprBuilder struct {
 	_Frame__ReprAssembler
 }
 
-func (nb *_Frame__ReprBuilder) Build() ipld.Node {
+func (nb *_Frame__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -6818,7 +6847,7 @@ func (na *_Frame__ReprAssembler) reset() {
 	na.ca_Cost.reset()
 	na.ca_Value.reset()
 }
-func (na *_Frame__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Frame__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -6831,7 +6860,7 @@ func (na *_Frame__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Frame__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Frame__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Frame.Repr"}.BeginList(0)
 }
 func (na *_Frame__ReprAssembler) AssignNull() error {
@@ -6863,10 +6892,10 @@ func (_Frame__ReprAssembler) AssignString(string) error {
 func (_Frame__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Frame.Repr"}.AssignBytes(nil)
 }
-func (_Frame__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Frame__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Frame.Repr"}.AssignLink(nil)
 }
-func (na *_Frame__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Frame__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -6886,8 +6915,8 @@ func (na *_Frame__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Frame.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Frame.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -6904,7 +6933,7 @@ func (na *_Frame__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Frame__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Frame__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Frame__ReprPrototype{}
 }
 func (ma *_Frame__ReprAssembler) valueFinishTidy() bool {
@@ -6985,7 +7014,7 @@ func (ma *_Frame__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -7003,7 +7032,7 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 	switch k {
 	case "Op":
 		if ma.s&fieldBit__Frame_Op != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Op_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Op_serial}
 		}
 		ma.s += fieldBit__Frame_Op
 		ma.state = maState_midValue
@@ -7013,7 +7042,7 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		return &ma.ca_Op, nil
 	case "From":
 		if ma.s&fieldBit__Frame_From != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_From_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_From_serial}
 		}
 		ma.s += fieldBit__Frame_From
 		ma.state = maState_midValue
@@ -7023,7 +7052,7 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		return &ma.ca_From, nil
 	case "To":
 		if ma.s&fieldBit__Frame_To != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_To_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_To_serial}
 		}
 		ma.s += fieldBit__Frame_To
 		ma.state = maState_midValue
@@ -7033,7 +7062,7 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		return &ma.ca_To, nil
 	case "Input":
 		if ma.s&fieldBit__Frame_Input != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Input_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Input_serial}
 		}
 		ma.s += fieldBit__Frame_Input
 		ma.state = maState_midValue
@@ -7043,7 +7072,7 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		return &ma.ca_Input, nil
 	case "Output":
 		if ma.s&fieldBit__Frame_Output != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Output_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Output_serial}
 		}
 		ma.s += fieldBit__Frame_Output
 		ma.state = maState_midValue
@@ -7053,7 +7082,7 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		return &ma.ca_Output, nil
 	case "Gas":
 		if ma.s&fieldBit__Frame_Gas != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Gas_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Gas_serial}
 		}
 		ma.s += fieldBit__Frame_Gas
 		ma.state = maState_midValue
@@ -7063,7 +7092,7 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		return &ma.ca_Gas, nil
 	case "Cost":
 		if ma.s&fieldBit__Frame_Cost != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Cost_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Cost_serial}
 		}
 		ma.s += fieldBit__Frame_Cost
 		ma.state = maState_midValue
@@ -7073,7 +7102,7 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		return &ma.ca_Cost, nil
 	case "Value":
 		if ma.s&fieldBit__Frame_Value != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Value_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Value_serial}
 		}
 		ma.s += fieldBit__Frame_Value
 		ma.state = maState_midValue
@@ -7083,9 +7112,9 @@ func (ma *_Frame__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		return &ma.ca_Value, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Frame.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Frame.Repr", Key: &_String{k}}
 }
-func (ma *_Frame__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Frame__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -7103,7 +7132,7 @@ func (ma *_Frame__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Frame__ReprKeyAssembler)(ma)
 }
-func (ma *_Frame__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Frame__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -7170,7 +7199,7 @@ func (ma *_Frame__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Frame_sufficient != fieldBits__Frame_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Frame_Op == 0 {
 			err.Missing = append(err.Missing, "Op")
 		}
@@ -7201,19 +7230,19 @@ func (ma *_Frame__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Frame__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Frame__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Frame__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Frame__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _Frame__ReprKeyAssembler _Frame__ReprAssembler
 
-func (_Frame__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Frame__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Frame.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_Frame__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Frame__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Frame.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_Frame__ReprKeyAssembler) AssignNull() error {
@@ -7235,7 +7264,7 @@ func (ka *_Frame__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Op":
 		if ka.s&fieldBit__Frame_Op != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Op_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Op_serial}
 		}
 		ka.s += fieldBit__Frame_Op
 		ka.state = maState_expectValue
@@ -7243,7 +7272,7 @@ func (ka *_Frame__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "From":
 		if ka.s&fieldBit__Frame_From != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_From_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_From_serial}
 		}
 		ka.s += fieldBit__Frame_From
 		ka.state = maState_expectValue
@@ -7251,7 +7280,7 @@ func (ka *_Frame__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "To":
 		if ka.s&fieldBit__Frame_To != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_To_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_To_serial}
 		}
 		ka.s += fieldBit__Frame_To
 		ka.state = maState_expectValue
@@ -7259,7 +7288,7 @@ func (ka *_Frame__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Input":
 		if ka.s&fieldBit__Frame_Input != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Input_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Input_serial}
 		}
 		ka.s += fieldBit__Frame_Input
 		ka.state = maState_expectValue
@@ -7267,7 +7296,7 @@ func (ka *_Frame__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Output":
 		if ka.s&fieldBit__Frame_Output != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Output_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Output_serial}
 		}
 		ka.s += fieldBit__Frame_Output
 		ka.state = maState_expectValue
@@ -7275,7 +7304,7 @@ func (ka *_Frame__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Gas":
 		if ka.s&fieldBit__Frame_Gas != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Gas_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Gas_serial}
 		}
 		ka.s += fieldBit__Frame_Gas
 		ka.state = maState_expectValue
@@ -7283,7 +7312,7 @@ func (ka *_Frame__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Cost":
 		if ka.s&fieldBit__Frame_Cost != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Cost_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Cost_serial}
 		}
 		ka.s += fieldBit__Frame_Cost
 		ka.state = maState_expectValue
@@ -7291,29 +7320,29 @@ func (ka *_Frame__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Value":
 		if ka.s&fieldBit__Frame_Value != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Frame_Value_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Frame_Value_serial}
 		}
 		ka.s += fieldBit__Frame_Value
 		ka.state = maState_expectValue
 		ka.f = 7
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Frame.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.Frame.Repr", Key: &_String{k}}
 }
 func (_Frame__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Frame.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Frame__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_Frame__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Frame.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Frame__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Frame__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Frame__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_Frame__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -7374,12 +7403,12 @@ func (m MaybeFrameList) IsAbsent() bool {
 func (m MaybeFrameList) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeFrameList) AsNode() ipld.Node {
+func (m MaybeFrameList) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -7393,40 +7422,40 @@ func (m MaybeFrameList) Must() FrameList {
 	return &m.v
 }
 
-var _ ipld.Node = (FrameList)(&_FrameList{})
+var _ datamodel.Node = (FrameList)(&_FrameList{})
 var _ schema.TypedNode = (FrameList)(&_FrameList{})
 
-func (FrameList) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (FrameList) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (FrameList) LookupByString(string) (ipld.Node, error) {
+func (FrameList) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.FrameList"}.LookupByString("")
 }
-func (n FrameList) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n FrameList) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n FrameList) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n FrameList) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n FrameList) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n FrameList) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.FrameList", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.FrameList", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (FrameList) MapIterator() ipld.MapIterator {
+func (FrameList) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n FrameList) ListIterator() ipld.ListIterator {
+func (n FrameList) ListIterator() datamodel.ListIterator {
 	return &_FrameList__ListItr{n, 0}
 }
 
@@ -7435,9 +7464,9 @@ type _FrameList__ListItr struct {
 	idx int
 }
 
-func (itr *_FrameList__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_FrameList__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -7473,16 +7502,16 @@ func (FrameList) AsString() (string, error) {
 func (FrameList) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.FrameList"}.AsBytes()
 }
-func (FrameList) AsLink() (ipld.Link, error) {
+func (FrameList) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.FrameList"}.AsLink()
 }
-func (FrameList) Prototype() ipld.NodePrototype {
+func (FrameList) Prototype() datamodel.NodePrototype {
 	return _FrameList__Prototype{}
 }
 
 type _FrameList__Prototype struct{}
 
-func (_FrameList__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_FrameList__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _FrameList__Builder
 	nb.Reset()
 	return &nb
@@ -7492,7 +7521,7 @@ type _FrameList__Builder struct {
 	_FrameList__Assembler
 }
 
-func (nb *_FrameList__Builder) Build() ipld.Node {
+func (nb *_FrameList__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -7517,10 +7546,10 @@ func (na *_FrameList__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_FrameList__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_FrameList__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.FrameList"}.BeginMap(0)
 }
-func (na *_FrameList__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_FrameList__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -7565,10 +7594,10 @@ func (_FrameList__Assembler) AssignString(string) error {
 func (_FrameList__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.FrameList"}.AssignBytes(nil)
 }
-func (_FrameList__Assembler) AssignLink(ipld.Link) error {
+func (_FrameList__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.FrameList"}.AssignLink(nil)
 }
-func (na *_FrameList__Assembler) AssignNode(v ipld.Node) error {
+func (na *_FrameList__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -7583,8 +7612,8 @@ func (na *_FrameList__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.FrameList", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.FrameList", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -7598,7 +7627,7 @@ func (na *_FrameList__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_FrameList__Assembler) Prototype() ipld.NodePrototype {
+func (_FrameList__Assembler) Prototype() datamodel.NodePrototype {
 	return _FrameList__Prototype{}
 }
 func (la *_FrameList__Assembler) valueFinishTidy() bool {
@@ -7613,7 +7642,7 @@ func (la *_FrameList__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_FrameList__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_FrameList__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -7646,59 +7675,59 @@ func (la *_FrameList__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_FrameList__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_FrameList__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Frame__Prototype{}
 }
 func (FrameList) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n FrameList) Representation() ipld.Node {
+func (n FrameList) Representation() datamodel.Node {
 	return (*_FrameList__Repr)(n)
 }
 
 type _FrameList__Repr _FrameList
 
-var _ ipld.Node = &_FrameList__Repr{}
+var _ datamodel.Node = &_FrameList__Repr{}
 
-func (_FrameList__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_FrameList__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_FrameList__Repr) LookupByString(string) (ipld.Node, error) {
+func (_FrameList__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.FrameList.Repr"}.LookupByString("")
 }
-func (nr *_FrameList__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_FrameList__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (FrameList)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Frame).Representation(), nil
 }
-func (nr *_FrameList__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_FrameList__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (FrameList)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Frame).Representation(), nil
 }
-func (n _FrameList__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _FrameList__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.FrameList.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.FrameList.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_FrameList__Repr) MapIterator() ipld.MapIterator {
+func (_FrameList__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_FrameList__Repr) ListIterator() ipld.ListIterator {
+func (nr *_FrameList__Repr) ListIterator() datamodel.ListIterator {
 	return &_FrameList__ReprListItr{(FrameList)(nr), 0}
 }
 
 type _FrameList__ReprListItr _FrameList__ListItr
 
-func (itr *_FrameList__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_FrameList__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_FrameList__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(Frame).Representation(), nil
@@ -7731,16 +7760,16 @@ func (_FrameList__Repr) AsString() (string, error) {
 func (_FrameList__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.FrameList.Repr"}.AsBytes()
 }
-func (_FrameList__Repr) AsLink() (ipld.Link, error) {
+func (_FrameList__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.FrameList.Repr"}.AsLink()
 }
-func (_FrameList__Repr) Prototype() ipld.NodePrototype {
+func (_FrameList__Repr) Prototype() datamodel.NodePrototype {
 	return _FrameList__ReprPrototype{}
 }
 
 type _FrameList__ReprPrototype struct{}
 
-func (_FrameList__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_FrameList__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _FrameList__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -7750,7 +7779,7 @@ type _FrameList__ReprBuilder struct {
 	_FrameList__ReprAssembler
 }
 
-func (nb *_FrameList__ReprBuilder) Build() ipld.Node {
+func (nb *_FrameList__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -7775,10 +7804,10 @@ func (na *_FrameList__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_FrameList__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_FrameList__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.FrameList.Repr"}.BeginMap(0)
 }
-func (na *_FrameList__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_FrameList__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -7823,10 +7852,10 @@ func (_FrameList__ReprAssembler) AssignString(string) error {
 func (_FrameList__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.FrameList.Repr"}.AssignBytes(nil)
 }
-func (_FrameList__ReprAssembler) AssignLink(ipld.Link) error {
+func (_FrameList__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.FrameList.Repr"}.AssignLink(nil)
 }
-func (na *_FrameList__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_FrameList__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -7841,8 +7870,8 @@ func (na *_FrameList__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.FrameList.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.FrameList.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -7856,7 +7885,7 @@ func (na *_FrameList__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_FrameList__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_FrameList__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _FrameList__ReprPrototype{}
 }
 func (la *_FrameList__ReprAssembler) valueFinishTidy() bool {
@@ -7871,7 +7900,7 @@ func (la *_FrameList__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_FrameList__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_FrameList__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -7904,7 +7933,7 @@ func (la *_FrameList__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_FrameList__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_FrameList__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Frame__ReprPrototype{}
 }
 
@@ -7931,12 +7960,12 @@ func (m MaybeHash) IsAbsent() bool {
 func (m MaybeHash) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeHash) AsNode() ipld.Node {
+func (m MaybeHash) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -7950,28 +7979,28 @@ func (m MaybeHash) Must() Hash {
 	return &m.v
 }
 
-var _ ipld.Node = (Hash)(&_Hash{})
+var _ datamodel.Node = (Hash)(&_Hash{})
 var _ schema.TypedNode = (Hash)(&_Hash{})
 
-func (Hash) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (Hash) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (Hash) LookupByString(string) (ipld.Node, error) {
+func (Hash) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Hash"}.LookupByString("")
 }
-func (Hash) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Hash) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Hash"}.LookupByNode(nil)
 }
-func (Hash) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Hash) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Hash"}.LookupByIndex(0)
 }
-func (Hash) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Hash) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Hash"}.LookupBySegment(seg)
 }
-func (Hash) MapIterator() ipld.MapIterator {
+func (Hash) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Hash) ListIterator() ipld.ListIterator {
+func (Hash) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Hash) Length() int64 {
@@ -7998,16 +8027,16 @@ func (Hash) AsString() (string, error) {
 func (n Hash) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (Hash) AsLink() (ipld.Link, error) {
+func (Hash) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.Hash"}.AsLink()
 }
-func (Hash) Prototype() ipld.NodePrototype {
+func (Hash) Prototype() datamodel.NodePrototype {
 	return _Hash__Prototype{}
 }
 
 type _Hash__Prototype struct{}
 
-func (_Hash__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Hash__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Hash__Builder
 	nb.Reset()
 	return &nb
@@ -8017,7 +8046,7 @@ type _Hash__Builder struct {
 	_Hash__Assembler
 }
 
-func (nb *_Hash__Builder) Build() ipld.Node {
+func (nb *_Hash__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -8035,10 +8064,10 @@ type _Hash__Assembler struct {
 }
 
 func (na *_Hash__Assembler) reset() {}
-func (_Hash__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Hash__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Hash"}.BeginMap(0)
 }
-func (_Hash__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Hash__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Hash"}.BeginList(0)
 }
 func (na *_Hash__Assembler) AssignNull() error {
@@ -8074,10 +8103,10 @@ func (na *_Hash__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_Hash__Assembler) AssignLink(ipld.Link) error {
+func (_Hash__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.Hash"}.AssignLink(nil)
 }
-func (na *_Hash__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Hash__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -8096,19 +8125,19 @@ func (na *_Hash__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_Hash__Assembler) Prototype() ipld.NodePrototype {
+func (_Hash__Assembler) Prototype() datamodel.NodePrototype {
 	return _Hash__Prototype{}
 }
 func (Hash) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Hash) Representation() ipld.Node {
+func (n Hash) Representation() datamodel.Node {
 	return (*_Hash__Repr)(n)
 }
 
 type _Hash__Repr = _Hash
 
-var _ ipld.Node = &_Hash__Repr{}
+var _ datamodel.Node = &_Hash__Repr{}
 
 type _Hash__ReprPrototype = _Hash__Prototype
 type _Hash__ReprAssembler = _Hash__Assembler
@@ -8177,12 +8206,12 @@ func (m MaybeHeader) IsAbsent() bool {
 func (m MaybeHeader) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeHeader) AsNode() ipld.Node {
+func (m MaybeHeader) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -8214,13 +8243,13 @@ var (
 	fieldName__Header_Nonce        = _String{"Nonce"}
 	fieldName__Header_BaseFee      = _String{"BaseFee"}
 )
-var _ ipld.Node = (Header)(&_Header{})
+var _ datamodel.Node = (Header)(&_Header{})
 var _ schema.TypedNode = (Header)(&_Header{})
 
-func (Header) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Header) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Header) LookupByString(key string) (ipld.Node, error) {
+func (n Header) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "ParentCID":
 		return &n.ParentCID, nil
@@ -8254,27 +8283,27 @@ func (n Header) LookupByString(key string) (ipld.Node, error) {
 		return &n.Nonce, nil
 	case "BaseFee":
 		if n.BaseFee.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.BaseFee.v, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Header) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Header) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Header) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Header) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Header"}.LookupByIndex(0)
 }
-func (n Header) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Header) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Header) MapIterator() ipld.MapIterator {
+func (n Header) MapIterator() datamodel.MapIterator {
 	return &_Header__MapItr{n, 0}
 }
 
@@ -8283,9 +8312,9 @@ type _Header__MapItr struct {
 	idx int
 }
 
-func (itr *_Header__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Header__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 16 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -8336,7 +8365,7 @@ func (itr *_Header__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	case 15:
 		k = &fieldName__Header_BaseFee
 		if itr.n.BaseFee.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.BaseFee.v
@@ -8350,7 +8379,7 @@ func (itr *_Header__MapItr) Done() bool {
 	return itr.idx >= 16
 }
 
-func (Header) ListIterator() ipld.ListIterator {
+func (Header) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Header) Length() int64 {
@@ -8377,16 +8406,16 @@ func (Header) AsString() (string, error) {
 func (Header) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Header"}.AsBytes()
 }
-func (Header) AsLink() (ipld.Link, error) {
+func (Header) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Header"}.AsLink()
 }
-func (Header) Prototype() ipld.NodePrototype {
+func (Header) Prototype() datamodel.NodePrototype {
 	return _Header__Prototype{}
 }
 
 type _Header__Prototype struct{}
 
-func (_Header__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Header__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Header__Builder
 	nb.Reset()
 	return &nb
@@ -8396,7 +8425,7 @@ type _Header__Builder struct {
 	_Header__Assembler
 }
 
-func (nb *_Header__Builder) Build() ipld.Node {
+func (nb *_Header__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -8475,7 +8504,7 @@ var (
 	fieldBits__Header_sufficient  = 0 + 1<<0 + 1<<1 + 1<<2 + 1<<3 + 1<<4 + 1<<5 + 1<<6 + 1<<7 + 1<<8 + 1<<9 + 1<<10 + 1<<11 + 1<<12 + 1<<13 + 1<<14 + 1<<15
 )
 
-func (na *_Header__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Header__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -8488,7 +8517,7 @@ func (na *_Header__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Header__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Header__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Header"}.BeginList(0)
 }
 func (na *_Header__Assembler) AssignNull() error {
@@ -8520,10 +8549,10 @@ func (_Header__Assembler) AssignString(string) error {
 func (_Header__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Header"}.AssignBytes(nil)
 }
-func (_Header__Assembler) AssignLink(ipld.Link) error {
+func (_Header__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Header"}.AssignLink(nil)
 }
-func (na *_Header__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Header__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -8543,8 +8572,8 @@ func (na *_Header__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Header", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Header", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -8561,7 +8590,7 @@ func (na *_Header__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Header__Assembler) Prototype() ipld.NodePrototype {
+func (_Header__Assembler) Prototype() datamodel.NodePrototype {
 	return _Header__Prototype{}
 }
 func (ma *_Header__Assembler) valueFinishTidy() bool {
@@ -8731,7 +8760,7 @@ func (ma *_Header__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Header__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -8749,7 +8778,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 	switch k {
 	case "ParentCID":
 		if ma.s&fieldBit__Header_ParentCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_ParentCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_ParentCID}
 		}
 		ma.s += fieldBit__Header_ParentCID
 		ma.state = maState_midValue
@@ -8759,7 +8788,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_ParentCID, nil
 	case "UnclesCID":
 		if ma.s&fieldBit__Header_UnclesCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_UnclesCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_UnclesCID}
 		}
 		ma.s += fieldBit__Header_UnclesCID
 		ma.state = maState_midValue
@@ -8769,7 +8798,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_UnclesCID, nil
 	case "Coinbase":
 		if ma.s&fieldBit__Header_Coinbase != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Coinbase}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Coinbase}
 		}
 		ma.s += fieldBit__Header_Coinbase
 		ma.state = maState_midValue
@@ -8779,7 +8808,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_Coinbase, nil
 	case "StateRootCID":
 		if ma.s&fieldBit__Header_StateRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_StateRootCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_StateRootCID}
 		}
 		ma.s += fieldBit__Header_StateRootCID
 		ma.state = maState_midValue
@@ -8789,7 +8818,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_StateRootCID, nil
 	case "TxRootCID":
 		if ma.s&fieldBit__Header_TxRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_TxRootCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_TxRootCID}
 		}
 		ma.s += fieldBit__Header_TxRootCID
 		ma.state = maState_midValue
@@ -8799,7 +8828,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_TxRootCID, nil
 	case "RctRootCID":
 		if ma.s&fieldBit__Header_RctRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_RctRootCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_RctRootCID}
 		}
 		ma.s += fieldBit__Header_RctRootCID
 		ma.state = maState_midValue
@@ -8809,7 +8838,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_RctRootCID, nil
 	case "Bloom":
 		if ma.s&fieldBit__Header_Bloom != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Bloom}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Bloom}
 		}
 		ma.s += fieldBit__Header_Bloom
 		ma.state = maState_midValue
@@ -8819,7 +8848,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_Bloom, nil
 	case "Difficulty":
 		if ma.s&fieldBit__Header_Difficulty != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Difficulty}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Difficulty}
 		}
 		ma.s += fieldBit__Header_Difficulty
 		ma.state = maState_midValue
@@ -8829,7 +8858,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_Difficulty, nil
 	case "Number":
 		if ma.s&fieldBit__Header_Number != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Number}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Number}
 		}
 		ma.s += fieldBit__Header_Number
 		ma.state = maState_midValue
@@ -8839,7 +8868,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_Number, nil
 	case "GasLimit":
 		if ma.s&fieldBit__Header_GasLimit != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_GasLimit}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_GasLimit}
 		}
 		ma.s += fieldBit__Header_GasLimit
 		ma.state = maState_midValue
@@ -8849,7 +8878,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_GasLimit, nil
 	case "GasUsed":
 		if ma.s&fieldBit__Header_GasUsed != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_GasUsed}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_GasUsed}
 		}
 		ma.s += fieldBit__Header_GasUsed
 		ma.state = maState_midValue
@@ -8859,7 +8888,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_GasUsed, nil
 	case "Time":
 		if ma.s&fieldBit__Header_Time != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Time}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Time}
 		}
 		ma.s += fieldBit__Header_Time
 		ma.state = maState_midValue
@@ -8869,7 +8898,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_Time, nil
 	case "Extra":
 		if ma.s&fieldBit__Header_Extra != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Extra}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Extra}
 		}
 		ma.s += fieldBit__Header_Extra
 		ma.state = maState_midValue
@@ -8879,7 +8908,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_Extra, nil
 	case "MixDigest":
 		if ma.s&fieldBit__Header_MixDigest != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_MixDigest}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_MixDigest}
 		}
 		ma.s += fieldBit__Header_MixDigest
 		ma.state = maState_midValue
@@ -8889,7 +8918,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_MixDigest, nil
 	case "Nonce":
 		if ma.s&fieldBit__Header_Nonce != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Nonce}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Nonce}
 		}
 		ma.s += fieldBit__Header_Nonce
 		ma.state = maState_midValue
@@ -8899,7 +8928,7 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		return &ma.ca_Nonce, nil
 	case "BaseFee":
 		if ma.s&fieldBit__Header_BaseFee != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_BaseFee}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_BaseFee}
 		}
 		ma.s += fieldBit__Header_BaseFee
 		ma.state = maState_midValue
@@ -8909,9 +8938,9 @@ func (ma *_Header__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error
 		ma.w.BaseFee.m = allowNull
 		return &ma.ca_BaseFee, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Header", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Header", Key: &_String{k}}
 }
-func (ma *_Header__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Header__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -8929,7 +8958,7 @@ func (ma *_Header__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Header__KeyAssembler)(ma)
 }
-func (ma *_Header__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Header__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -9029,7 +9058,7 @@ func (ma *_Header__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Header_sufficient != fieldBits__Header_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Header_ParentCID == 0 {
 			err.Missing = append(err.Missing, "ParentCID")
 		}
@@ -9081,19 +9110,19 @@ func (ma *_Header__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Header__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Header__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Header__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Header__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _Header__KeyAssembler _Header__Assembler
 
-func (_Header__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Header__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Header.KeyAssembler"}.BeginMap(0)
 }
-func (_Header__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Header__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Header.KeyAssembler"}.BeginList(0)
 }
 func (na *_Header__KeyAssembler) AssignNull() error {
@@ -9115,141 +9144,156 @@ func (ka *_Header__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "ParentCID":
 		if ka.s&fieldBit__Header_ParentCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_ParentCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_ParentCID}
 		}
 		ka.s += fieldBit__Header_ParentCID
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "UnclesCID":
 		if ka.s&fieldBit__Header_UnclesCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_UnclesCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_UnclesCID}
 		}
 		ka.s += fieldBit__Header_UnclesCID
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	case "Coinbase":
 		if ka.s&fieldBit__Header_Coinbase != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Coinbase}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Coinbase}
 		}
 		ka.s += fieldBit__Header_Coinbase
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	case "StateRootCID":
 		if ka.s&fieldBit__Header_StateRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_StateRootCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_StateRootCID}
 		}
 		ka.s += fieldBit__Header_StateRootCID
 		ka.state = maState_expectValue
 		ka.f = 3
+		return nil
 	case "TxRootCID":
 		if ka.s&fieldBit__Header_TxRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_TxRootCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_TxRootCID}
 		}
 		ka.s += fieldBit__Header_TxRootCID
 		ka.state = maState_expectValue
 		ka.f = 4
+		return nil
 	case "RctRootCID":
 		if ka.s&fieldBit__Header_RctRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_RctRootCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_RctRootCID}
 		}
 		ka.s += fieldBit__Header_RctRootCID
 		ka.state = maState_expectValue
 		ka.f = 5
+		return nil
 	case "Bloom":
 		if ka.s&fieldBit__Header_Bloom != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Bloom}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Bloom}
 		}
 		ka.s += fieldBit__Header_Bloom
 		ka.state = maState_expectValue
 		ka.f = 6
+		return nil
 	case "Difficulty":
 		if ka.s&fieldBit__Header_Difficulty != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Difficulty}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Difficulty}
 		}
 		ka.s += fieldBit__Header_Difficulty
 		ka.state = maState_expectValue
 		ka.f = 7
+		return nil
 	case "Number":
 		if ka.s&fieldBit__Header_Number != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Number}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Number}
 		}
 		ka.s += fieldBit__Header_Number
 		ka.state = maState_expectValue
 		ka.f = 8
+		return nil
 	case "GasLimit":
 		if ka.s&fieldBit__Header_GasLimit != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_GasLimit}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_GasLimit}
 		}
 		ka.s += fieldBit__Header_GasLimit
 		ka.state = maState_expectValue
 		ka.f = 9
+		return nil
 	case "GasUsed":
 		if ka.s&fieldBit__Header_GasUsed != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_GasUsed}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_GasUsed}
 		}
 		ka.s += fieldBit__Header_GasUsed
 		ka.state = maState_expectValue
 		ka.f = 10
+		return nil
 	case "Time":
 		if ka.s&fieldBit__Header_Time != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Time}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Time}
 		}
 		ka.s += fieldBit__Header_Time
 		ka.state = maState_expectValue
 		ka.f = 11
+		return nil
 	case "Extra":
 		if ka.s&fieldBit__Header_Extra != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Extra}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Extra}
 		}
 		ka.s += fieldBit__Header_Extra
 		ka.state = maState_expectValue
 		ka.f = 12
+		return nil
 	case "MixDigest":
 		if ka.s&fieldBit__Header_MixDigest != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_MixDigest}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_MixDigest}
 		}
 		ka.s += fieldBit__Header_MixDigest
 		ka.state = maState_expectValue
 		ka.f = 13
+		return nil
 	case "Nonce":
 		if ka.s&fieldBit__Header_Nonce != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Nonce}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Nonce}
 		}
 		ka.s += fieldBit__Header_Nonce
 		ka.state = maState_expectValue
 		ka.f = 14
+		return nil
 	case "BaseFee":
 		if ka.s&fieldBit__Header_BaseFee != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_BaseFee}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_BaseFee}
 		}
 		ka.s += fieldBit__Header_BaseFee
 		ka.state = maState_expectValue
 		ka.f = 15
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.Header", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.Header", Key: &_String{k}}
 	}
-	return nil
 }
 func (_Header__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Header.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Header__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Header__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Header.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Header__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Header__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Header__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Header__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Header) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Header) Representation() ipld.Node {
+func (n Header) Representation() datamodel.Node {
 	return (*_Header__Repr)(n)
 }
 
@@ -9273,12 +9317,12 @@ var (
 	fieldName__Header_Nonce_serial        = _String{"Nonce"}
 	fieldName__Header_BaseFee_serial      = _String{"BaseFee"}
 )
-var _ ipld.Node = &_Header__Repr{}
+var _ datamodel.Node = &_Header__Repr{}
 
-func (_Header__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_Header__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_Header__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_Header__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "ParentCID":
 		return n.ParentCID.Representation(), nil
@@ -9312,27 +9356,27 @@ func (n *_Header__Repr) LookupByString(key string) (ipld.Node, error) {
 		return n.Nonce.Representation(), nil
 	case "BaseFee":
 		if n.BaseFee.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.BaseFee.v.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_Header__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_Header__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_Header__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_Header__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Header.Repr"}.LookupByIndex(0)
 }
-func (n _Header__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Header__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_Header__Repr) MapIterator() ipld.MapIterator {
+func (n *_Header__Repr) MapIterator() datamodel.MapIterator {
 	return &_Header__ReprMapItr{n, 0}
 }
 
@@ -9341,9 +9385,9 @@ type _Header__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_Header__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Header__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 16 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -9394,7 +9438,7 @@ func (itr *_Header__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	case 15:
 		k = &fieldName__Header_BaseFee_serial
 		if itr.n.BaseFee.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.BaseFee.v.Representation()
@@ -9407,7 +9451,7 @@ func (itr *_Header__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 func (itr *_Header__ReprMapItr) Done() bool {
 	return itr.idx >= 16
 }
-func (_Header__Repr) ListIterator() ipld.ListIterator {
+func (_Header__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_Header__Repr) Length() int64 {
@@ -9435,16 +9479,16 @@ func (_Header__Repr) AsString() (string, error) {
 func (_Header__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Header.Repr"}.AsBytes()
 }
-func (_Header__Repr) AsLink() (ipld.Link, error) {
+func (_Header__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Header.Repr"}.AsLink()
 }
-func (_Header__Repr) Prototype() ipld.NodePrototype {
+func (_Header__Repr) Prototype() datamodel.NodePrototype {
 	return _Header__ReprPrototype{}
 }
 
 type _Header__ReprPrototype struct{}
 
-func (_Header__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Header__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Header__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -9454,7 +9498,7 @@ type _Header__ReprBuilder struct {
 	_Header__ReprAssembler
 }
 
-func (nb *_Header__ReprBuilder) Build() ipld.Node {
+func (nb *_Header__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -9512,7 +9556,7 @@ func (na *_Header__ReprAssembler) reset() {
 	na.ca_Nonce.reset()
 	na.ca_BaseFee.reset()
 }
-func (na *_Header__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Header__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -9525,7 +9569,7 @@ func (na *_Header__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Header__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Header__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Header.Repr"}.BeginList(0)
 }
 func (na *_Header__ReprAssembler) AssignNull() error {
@@ -9557,10 +9601,10 @@ func (_Header__ReprAssembler) AssignString(string) error {
 func (_Header__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Header.Repr"}.AssignBytes(nil)
 }
-func (_Header__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Header__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Header.Repr"}.AssignLink(nil)
 }
-func (na *_Header__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Header__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -9580,8 +9624,8 @@ func (na *_Header__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Header.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Header.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -9598,7 +9642,7 @@ func (na *_Header__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Header__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Header__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Header__ReprPrototype{}
 }
 func (ma *_Header__ReprAssembler) valueFinishTidy() bool {
@@ -9753,7 +9797,7 @@ func (ma *_Header__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Header__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -9771,7 +9815,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 	switch k {
 	case "ParentCID":
 		if ma.s&fieldBit__Header_ParentCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_ParentCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_ParentCID_serial}
 		}
 		ma.s += fieldBit__Header_ParentCID
 		ma.state = maState_midValue
@@ -9781,7 +9825,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_ParentCID, nil
 	case "UnclesCID":
 		if ma.s&fieldBit__Header_UnclesCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_UnclesCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_UnclesCID_serial}
 		}
 		ma.s += fieldBit__Header_UnclesCID
 		ma.state = maState_midValue
@@ -9791,7 +9835,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_UnclesCID, nil
 	case "Coinbase":
 		if ma.s&fieldBit__Header_Coinbase != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Coinbase_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Coinbase_serial}
 		}
 		ma.s += fieldBit__Header_Coinbase
 		ma.state = maState_midValue
@@ -9801,7 +9845,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_Coinbase, nil
 	case "StateRootCID":
 		if ma.s&fieldBit__Header_StateRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_StateRootCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_StateRootCID_serial}
 		}
 		ma.s += fieldBit__Header_StateRootCID
 		ma.state = maState_midValue
@@ -9811,7 +9855,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_StateRootCID, nil
 	case "TxRootCID":
 		if ma.s&fieldBit__Header_TxRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_TxRootCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_TxRootCID_serial}
 		}
 		ma.s += fieldBit__Header_TxRootCID
 		ma.state = maState_midValue
@@ -9821,7 +9865,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_TxRootCID, nil
 	case "RctRootCID":
 		if ma.s&fieldBit__Header_RctRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_RctRootCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_RctRootCID_serial}
 		}
 		ma.s += fieldBit__Header_RctRootCID
 		ma.state = maState_midValue
@@ -9831,7 +9875,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_RctRootCID, nil
 	case "Bloom":
 		if ma.s&fieldBit__Header_Bloom != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Bloom_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Bloom_serial}
 		}
 		ma.s += fieldBit__Header_Bloom
 		ma.state = maState_midValue
@@ -9841,7 +9885,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_Bloom, nil
 	case "Difficulty":
 		if ma.s&fieldBit__Header_Difficulty != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Difficulty_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Difficulty_serial}
 		}
 		ma.s += fieldBit__Header_Difficulty
 		ma.state = maState_midValue
@@ -9851,7 +9895,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_Difficulty, nil
 	case "Number":
 		if ma.s&fieldBit__Header_Number != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Number_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Number_serial}
 		}
 		ma.s += fieldBit__Header_Number
 		ma.state = maState_midValue
@@ -9861,7 +9905,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_Number, nil
 	case "GasLimit":
 		if ma.s&fieldBit__Header_GasLimit != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_GasLimit_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_GasLimit_serial}
 		}
 		ma.s += fieldBit__Header_GasLimit
 		ma.state = maState_midValue
@@ -9871,7 +9915,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_GasLimit, nil
 	case "GasUsed":
 		if ma.s&fieldBit__Header_GasUsed != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_GasUsed_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_GasUsed_serial}
 		}
 		ma.s += fieldBit__Header_GasUsed
 		ma.state = maState_midValue
@@ -9881,7 +9925,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_GasUsed, nil
 	case "Time":
 		if ma.s&fieldBit__Header_Time != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Time_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Time_serial}
 		}
 		ma.s += fieldBit__Header_Time
 		ma.state = maState_midValue
@@ -9891,7 +9935,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_Time, nil
 	case "Extra":
 		if ma.s&fieldBit__Header_Extra != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Extra_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Extra_serial}
 		}
 		ma.s += fieldBit__Header_Extra
 		ma.state = maState_midValue
@@ -9901,7 +9945,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_Extra, nil
 	case "MixDigest":
 		if ma.s&fieldBit__Header_MixDigest != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_MixDigest_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_MixDigest_serial}
 		}
 		ma.s += fieldBit__Header_MixDigest
 		ma.state = maState_midValue
@@ -9911,7 +9955,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_MixDigest, nil
 	case "Nonce":
 		if ma.s&fieldBit__Header_Nonce != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Nonce_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Nonce_serial}
 		}
 		ma.s += fieldBit__Header_Nonce
 		ma.state = maState_midValue
@@ -9921,7 +9965,7 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_Nonce, nil
 	case "BaseFee":
 		if ma.s&fieldBit__Header_BaseFee != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Header_BaseFee_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_BaseFee_serial}
 		}
 		ma.s += fieldBit__Header_BaseFee
 		ma.state = maState_midValue
@@ -9932,9 +9976,9 @@ func (ma *_Header__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, e
 		return &ma.ca_BaseFee, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Header.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Header.Repr", Key: &_String{k}}
 }
-func (ma *_Header__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Header__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -9952,7 +9996,7 @@ func (ma *_Header__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Header__ReprKeyAssembler)(ma)
 }
-func (ma *_Header__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Header__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -10052,7 +10096,7 @@ func (ma *_Header__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Header_sufficient != fieldBits__Header_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Header_ParentCID == 0 {
 			err.Missing = append(err.Missing, "ParentCID")
 		}
@@ -10104,19 +10148,19 @@ func (ma *_Header__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Header__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Header__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Header__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Header__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _Header__ReprKeyAssembler _Header__ReprAssembler
 
-func (_Header__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Header__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Header.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_Header__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Header__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Header.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_Header__ReprKeyAssembler) AssignNull() error {
@@ -10138,7 +10182,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "ParentCID":
 		if ka.s&fieldBit__Header_ParentCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_ParentCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_ParentCID_serial}
 		}
 		ka.s += fieldBit__Header_ParentCID
 		ka.state = maState_expectValue
@@ -10146,7 +10190,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "UnclesCID":
 		if ka.s&fieldBit__Header_UnclesCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_UnclesCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_UnclesCID_serial}
 		}
 		ka.s += fieldBit__Header_UnclesCID
 		ka.state = maState_expectValue
@@ -10154,7 +10198,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Coinbase":
 		if ka.s&fieldBit__Header_Coinbase != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Coinbase_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Coinbase_serial}
 		}
 		ka.s += fieldBit__Header_Coinbase
 		ka.state = maState_expectValue
@@ -10162,7 +10206,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "StateRootCID":
 		if ka.s&fieldBit__Header_StateRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_StateRootCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_StateRootCID_serial}
 		}
 		ka.s += fieldBit__Header_StateRootCID
 		ka.state = maState_expectValue
@@ -10170,7 +10214,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "TxRootCID":
 		if ka.s&fieldBit__Header_TxRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_TxRootCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_TxRootCID_serial}
 		}
 		ka.s += fieldBit__Header_TxRootCID
 		ka.state = maState_expectValue
@@ -10178,7 +10222,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "RctRootCID":
 		if ka.s&fieldBit__Header_RctRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_RctRootCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_RctRootCID_serial}
 		}
 		ka.s += fieldBit__Header_RctRootCID
 		ka.state = maState_expectValue
@@ -10186,7 +10230,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Bloom":
 		if ka.s&fieldBit__Header_Bloom != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Bloom_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Bloom_serial}
 		}
 		ka.s += fieldBit__Header_Bloom
 		ka.state = maState_expectValue
@@ -10194,7 +10238,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Difficulty":
 		if ka.s&fieldBit__Header_Difficulty != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Difficulty_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Difficulty_serial}
 		}
 		ka.s += fieldBit__Header_Difficulty
 		ka.state = maState_expectValue
@@ -10202,7 +10246,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Number":
 		if ka.s&fieldBit__Header_Number != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Number_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Number_serial}
 		}
 		ka.s += fieldBit__Header_Number
 		ka.state = maState_expectValue
@@ -10210,7 +10254,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "GasLimit":
 		if ka.s&fieldBit__Header_GasLimit != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_GasLimit_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_GasLimit_serial}
 		}
 		ka.s += fieldBit__Header_GasLimit
 		ka.state = maState_expectValue
@@ -10218,7 +10262,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "GasUsed":
 		if ka.s&fieldBit__Header_GasUsed != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_GasUsed_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_GasUsed_serial}
 		}
 		ka.s += fieldBit__Header_GasUsed
 		ka.state = maState_expectValue
@@ -10226,7 +10270,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Time":
 		if ka.s&fieldBit__Header_Time != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Time_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Time_serial}
 		}
 		ka.s += fieldBit__Header_Time
 		ka.state = maState_expectValue
@@ -10234,7 +10278,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Extra":
 		if ka.s&fieldBit__Header_Extra != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Extra_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Extra_serial}
 		}
 		ka.s += fieldBit__Header_Extra
 		ka.state = maState_expectValue
@@ -10242,7 +10286,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "MixDigest":
 		if ka.s&fieldBit__Header_MixDigest != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_MixDigest_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_MixDigest_serial}
 		}
 		ka.s += fieldBit__Header_MixDigest
 		ka.state = maState_expectValue
@@ -10250,7 +10294,7 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Nonce":
 		if ka.s&fieldBit__Header_Nonce != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_Nonce_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_Nonce_serial}
 		}
 		ka.s += fieldBit__Header_Nonce
 		ka.state = maState_expectValue
@@ -10258,36 +10302,36 @@ func (ka *_Header__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "BaseFee":
 		if ka.s&fieldBit__Header_BaseFee != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Header_BaseFee_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Header_BaseFee_serial}
 		}
 		ka.s += fieldBit__Header_BaseFee
 		ka.state = maState_expectValue
 		ka.f = 15
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Header.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.Header.Repr", Key: &_String{k}}
 }
 func (_Header__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Header.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Header__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_Header__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Header.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Header__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Header__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Header__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_Header__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
-func (n Link) Link() ipld.Link {
+func (n Link) Link() datamodel.Link {
 	return n.x
 }
-func (_Link__Prototype) FromLink(v ipld.Link) (Link, error) {
+func (_Link__Prototype) FromLink(v datamodel.Link) (Link, error) {
 	n := _Link{v}
 	return &n, nil
 }
@@ -10307,12 +10351,12 @@ func (m MaybeLink) IsAbsent() bool {
 func (m MaybeLink) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeLink) AsNode() ipld.Node {
+func (m MaybeLink) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -10326,28 +10370,28 @@ func (m MaybeLink) Must() Link {
 	return &m.v
 }
 
-var _ ipld.Node = (Link)(&_Link{})
+var _ datamodel.Node = (Link)(&_Link{})
 var _ schema.TypedNode = (Link)(&_Link{})
 
-func (Link) Kind() ipld.Kind {
-	return ipld.Kind_Link
+func (Link) Kind() datamodel.Kind {
+	return datamodel.Kind_Link
 }
-func (Link) LookupByString(string) (ipld.Node, error) {
+func (Link) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Link{TypeName: "dageth.Link"}.LookupByString("")
 }
-func (Link) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Link) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Link{TypeName: "dageth.Link"}.LookupByNode(nil)
 }
-func (Link) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Link) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Link{TypeName: "dageth.Link"}.LookupByIndex(0)
 }
-func (Link) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Link) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Link{TypeName: "dageth.Link"}.LookupBySegment(seg)
 }
-func (Link) MapIterator() ipld.MapIterator {
+func (Link) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Link) ListIterator() ipld.ListIterator {
+func (Link) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Link) Length() int64 {
@@ -10374,16 +10418,16 @@ func (Link) AsString() (string, error) {
 func (Link) AsBytes() ([]byte, error) {
 	return mixins.Link{TypeName: "dageth.Link"}.AsBytes()
 }
-func (n Link) AsLink() (ipld.Link, error) {
+func (n Link) AsLink() (datamodel.Link, error) {
 	return n.x, nil
 }
-func (Link) Prototype() ipld.NodePrototype {
+func (Link) Prototype() datamodel.NodePrototype {
 	return _Link__Prototype{}
 }
 
 type _Link__Prototype struct{}
 
-func (_Link__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Link__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Link__Builder
 	nb.Reset()
 	return &nb
@@ -10393,7 +10437,7 @@ type _Link__Builder struct {
 	_Link__Assembler
 }
 
-func (nb *_Link__Builder) Build() ipld.Node {
+func (nb *_Link__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -10411,10 +10455,10 @@ type _Link__Assembler struct {
 }
 
 func (na *_Link__Assembler) reset() {}
-func (_Link__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Link__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.LinkAssembler{TypeName: "dageth.Link"}.BeginMap(0)
 }
-func (_Link__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Link__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.LinkAssembler{TypeName: "dageth.Link"}.BeginList(0)
 }
 func (na *_Link__Assembler) AssignNull() error {
@@ -10444,7 +10488,7 @@ func (_Link__Assembler) AssignString(string) error {
 func (_Link__Assembler) AssignBytes([]byte) error {
 	return mixins.LinkAssembler{TypeName: "dageth.Link"}.AssignBytes(nil)
 }
-func (na *_Link__Assembler) AssignLink(v ipld.Link) error {
+func (na *_Link__Assembler) AssignLink(v datamodel.Link) error {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -10453,7 +10497,7 @@ func (na *_Link__Assembler) AssignLink(v ipld.Link) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (na *_Link__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Link__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -10472,19 +10516,19 @@ func (na *_Link__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignLink(v2)
 	}
 }
-func (_Link__Assembler) Prototype() ipld.NodePrototype {
+func (_Link__Assembler) Prototype() datamodel.NodePrototype {
 	return _Link__Prototype{}
 }
 func (Link) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Link) Representation() ipld.Node {
+func (n Link) Representation() datamodel.Node {
 	return (*_Link__Repr)(n)
 }
 
 type _Link__Repr = _Link
 
-var _ ipld.Node = &_Link__Repr{}
+var _ datamodel.Node = &_Link__Repr{}
 
 type _Link__ReprPrototype = _Link__Prototype
 type _Link__ReprAssembler = _Link__Assembler
@@ -10514,12 +10558,12 @@ func (m MaybeLog) IsAbsent() bool {
 func (m MaybeLog) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeLog) AsNode() ipld.Node {
+func (m MaybeLog) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -10538,13 +10582,13 @@ var (
 	fieldName__Log_Topics  = _String{"Topics"}
 	fieldName__Log_Data    = _String{"Data"}
 )
-var _ ipld.Node = (Log)(&_Log{})
+var _ datamodel.Node = (Log)(&_Log{})
 var _ schema.TypedNode = (Log)(&_Log{})
 
-func (Log) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Log) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Log) LookupByString(key string) (ipld.Node, error) {
+func (n Log) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Address":
 		return &n.Address, nil
@@ -10553,23 +10597,23 @@ func (n Log) LookupByString(key string) (ipld.Node, error) {
 	case "Data":
 		return &n.Data, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Log) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Log) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Log) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Log) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Log"}.LookupByIndex(0)
 }
-func (n Log) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Log) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Log) MapIterator() ipld.MapIterator {
+func (n Log) MapIterator() datamodel.MapIterator {
 	return &_Log__MapItr{n, 0}
 }
 
@@ -10578,9 +10622,9 @@ type _Log__MapItr struct {
 	idx int
 }
 
-func (itr *_Log__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Log__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 3 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -10602,7 +10646,7 @@ func (itr *_Log__MapItr) Done() bool {
 	return itr.idx >= 3
 }
 
-func (Log) ListIterator() ipld.ListIterator {
+func (Log) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Log) Length() int64 {
@@ -10629,16 +10673,16 @@ func (Log) AsString() (string, error) {
 func (Log) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Log"}.AsBytes()
 }
-func (Log) AsLink() (ipld.Link, error) {
+func (Log) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Log"}.AsLink()
 }
-func (Log) Prototype() ipld.NodePrototype {
+func (Log) Prototype() datamodel.NodePrototype {
 	return _Log__Prototype{}
 }
 
 type _Log__Prototype struct{}
 
-func (_Log__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Log__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Log__Builder
 	nb.Reset()
 	return &nb
@@ -10648,7 +10692,7 @@ type _Log__Builder struct {
 	_Log__Assembler
 }
 
-func (nb *_Log__Builder) Build() ipld.Node {
+func (nb *_Log__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -10688,7 +10732,7 @@ var (
 	fieldBits__Log_sufficient = 0 + 1<<0 + 1<<1 + 1<<2
 )
 
-func (na *_Log__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Log__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -10701,7 +10745,7 @@ func (na *_Log__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Log__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Log__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Log"}.BeginList(0)
 }
 func (na *_Log__Assembler) AssignNull() error {
@@ -10733,10 +10777,10 @@ func (_Log__Assembler) AssignString(string) error {
 func (_Log__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Log"}.AssignBytes(nil)
 }
-func (_Log__Assembler) AssignLink(ipld.Link) error {
+func (_Log__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Log"}.AssignLink(nil)
 }
-func (na *_Log__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Log__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -10756,8 +10800,8 @@ func (na *_Log__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Log", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Log", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -10774,7 +10818,7 @@ func (na *_Log__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Log__Assembler) Prototype() ipld.NodePrototype {
+func (_Log__Assembler) Prototype() datamodel.NodePrototype {
 	return _Log__Prototype{}
 }
 func (ma *_Log__Assembler) valueFinishTidy() bool {
@@ -10813,7 +10857,7 @@ func (ma *_Log__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Log__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Log__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -10831,7 +10875,7 @@ func (ma *_Log__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
 	switch k {
 	case "Address":
 		if ma.s&fieldBit__Log_Address != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Address}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Address}
 		}
 		ma.s += fieldBit__Log_Address
 		ma.state = maState_midValue
@@ -10841,7 +10885,7 @@ func (ma *_Log__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
 		return &ma.ca_Address, nil
 	case "Topics":
 		if ma.s&fieldBit__Log_Topics != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Topics}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Topics}
 		}
 		ma.s += fieldBit__Log_Topics
 		ma.state = maState_midValue
@@ -10851,7 +10895,7 @@ func (ma *_Log__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
 		return &ma.ca_Topics, nil
 	case "Data":
 		if ma.s&fieldBit__Log_Data != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Data}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Data}
 		}
 		ma.s += fieldBit__Log_Data
 		ma.state = maState_midValue
@@ -10860,9 +10904,9 @@ func (ma *_Log__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
 		ma.ca_Data.m = &ma.cm
 		return &ma.ca_Data, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Log", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Log", Key: &_String{k}}
 }
-func (ma *_Log__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Log__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -10880,7 +10924,7 @@ func (ma *_Log__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Log__KeyAssembler)(ma)
 }
-func (ma *_Log__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Log__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -10927,7 +10971,7 @@ func (ma *_Log__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Log_sufficient != fieldBits__Log_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Log_Address == 0 {
 			err.Missing = append(err.Missing, "Address")
 		}
@@ -10943,19 +10987,19 @@ func (ma *_Log__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Log__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Log__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Log__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Log__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _Log__KeyAssembler _Log__Assembler
 
-func (_Log__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Log__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Log.KeyAssembler"}.BeginMap(0)
 }
-func (_Log__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Log__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Log.KeyAssembler"}.BeginList(0)
 }
 func (na *_Log__KeyAssembler) AssignNull() error {
@@ -10977,50 +11021,52 @@ func (ka *_Log__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Address":
 		if ka.s&fieldBit__Log_Address != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Address}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Address}
 		}
 		ka.s += fieldBit__Log_Address
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "Topics":
 		if ka.s&fieldBit__Log_Topics != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Topics}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Topics}
 		}
 		ka.s += fieldBit__Log_Topics
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	case "Data":
 		if ka.s&fieldBit__Log_Data != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Data}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Data}
 		}
 		ka.s += fieldBit__Log_Data
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.Log", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.Log", Key: &_String{k}}
 	}
-	return nil
 }
 func (_Log__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Log.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Log__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Log__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Log.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Log__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Log__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Log__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Log__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Log) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Log) Representation() ipld.Node {
+func (n Log) Representation() datamodel.Node {
 	return (*_Log__Repr)(n)
 }
 
@@ -11031,12 +11077,12 @@ var (
 	fieldName__Log_Topics_serial  = _String{"Topics"}
 	fieldName__Log_Data_serial    = _String{"Data"}
 )
-var _ ipld.Node = &_Log__Repr{}
+var _ datamodel.Node = &_Log__Repr{}
 
-func (_Log__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_Log__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_Log__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_Log__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Address":
 		return n.Address.Representation(), nil
@@ -11045,23 +11091,23 @@ func (n *_Log__Repr) LookupByString(key string) (ipld.Node, error) {
 	case "Data":
 		return n.Data.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_Log__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_Log__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_Log__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_Log__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Log.Repr"}.LookupByIndex(0)
 }
-func (n _Log__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Log__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_Log__Repr) MapIterator() ipld.MapIterator {
+func (n *_Log__Repr) MapIterator() datamodel.MapIterator {
 	return &_Log__ReprMapItr{n, 0}
 }
 
@@ -11070,9 +11116,9 @@ type _Log__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_Log__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Log__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 3 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -11093,7 +11139,7 @@ func (itr *_Log__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 func (itr *_Log__ReprMapItr) Done() bool {
 	return itr.idx >= 3
 }
-func (_Log__Repr) ListIterator() ipld.ListIterator {
+func (_Log__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_Log__Repr) Length() int64 {
@@ -11121,16 +11167,16 @@ func (_Log__Repr) AsString() (string, error) {
 func (_Log__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Log.Repr"}.AsBytes()
 }
-func (_Log__Repr) AsLink() (ipld.Link, error) {
+func (_Log__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Log.Repr"}.AsLink()
 }
-func (_Log__Repr) Prototype() ipld.NodePrototype {
+func (_Log__Repr) Prototype() datamodel.NodePrototype {
 	return _Log__ReprPrototype{}
 }
 
 type _Log__ReprPrototype struct{}
 
-func (_Log__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Log__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Log__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -11140,7 +11186,7 @@ type _Log__ReprBuilder struct {
 	_Log__ReprAssembler
 }
 
-func (nb *_Log__ReprBuilder) Build() ipld.Node {
+func (nb *_Log__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -11172,7 +11218,7 @@ func (na *_Log__ReprAssembler) reset() {
 	na.ca_Topics.reset()
 	na.ca_Data.reset()
 }
-func (na *_Log__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Log__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -11185,7 +11231,7 @@ func (na *_Log__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Log__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Log__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Log.Repr"}.BeginList(0)
 }
 func (na *_Log__ReprAssembler) AssignNull() error {
@@ -11217,10 +11263,10 @@ func (_Log__ReprAssembler) AssignString(string) error {
 func (_Log__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Log.Repr"}.AssignBytes(nil)
 }
-func (_Log__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Log__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Log.Repr"}.AssignLink(nil)
 }
-func (na *_Log__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Log__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -11240,8 +11286,8 @@ func (na *_Log__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Log.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Log.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -11258,7 +11304,7 @@ func (na *_Log__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Log__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Log__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Log__ReprPrototype{}
 }
 func (ma *_Log__ReprAssembler) valueFinishTidy() bool {
@@ -11294,7 +11340,7 @@ func (ma *_Log__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Log__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Log__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -11312,7 +11358,7 @@ func (ma *_Log__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 	switch k {
 	case "Address":
 		if ma.s&fieldBit__Log_Address != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Address_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Address_serial}
 		}
 		ma.s += fieldBit__Log_Address
 		ma.state = maState_midValue
@@ -11322,7 +11368,7 @@ func (ma *_Log__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Address, nil
 	case "Topics":
 		if ma.s&fieldBit__Log_Topics != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Topics_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Topics_serial}
 		}
 		ma.s += fieldBit__Log_Topics
 		ma.state = maState_midValue
@@ -11332,7 +11378,7 @@ func (ma *_Log__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Topics, nil
 	case "Data":
 		if ma.s&fieldBit__Log_Data != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Data_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Data_serial}
 		}
 		ma.s += fieldBit__Log_Data
 		ma.state = maState_midValue
@@ -11342,9 +11388,9 @@ func (ma *_Log__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Data, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Log.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Log.Repr", Key: &_String{k}}
 }
-func (ma *_Log__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Log__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -11362,7 +11408,7 @@ func (ma *_Log__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Log__ReprKeyAssembler)(ma)
 }
-func (ma *_Log__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Log__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -11409,7 +11455,7 @@ func (ma *_Log__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Log_sufficient != fieldBits__Log_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Log_Address == 0 {
 			err.Missing = append(err.Missing, "Address")
 		}
@@ -11425,19 +11471,19 @@ func (ma *_Log__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Log__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Log__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Log__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Log__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _Log__ReprKeyAssembler _Log__ReprAssembler
 
-func (_Log__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Log__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Log.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_Log__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Log__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Log.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_Log__ReprKeyAssembler) AssignNull() error {
@@ -11459,7 +11505,7 @@ func (ka *_Log__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Address":
 		if ka.s&fieldBit__Log_Address != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Address_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Address_serial}
 		}
 		ka.s += fieldBit__Log_Address
 		ka.state = maState_expectValue
@@ -11467,7 +11513,7 @@ func (ka *_Log__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Topics":
 		if ka.s&fieldBit__Log_Topics != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Topics_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Topics_serial}
 		}
 		ka.s += fieldBit__Log_Topics
 		ka.state = maState_expectValue
@@ -11475,29 +11521,29 @@ func (ka *_Log__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Data":
 		if ka.s&fieldBit__Log_Data != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Log_Data_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Log_Data_serial}
 		}
 		ka.s += fieldBit__Log_Data
 		ka.state = maState_expectValue
 		ka.f = 2
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Log.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.Log.Repr", Key: &_String{k}}
 }
 func (_Log__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Log.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Log__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_Log__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Log.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Log__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Log__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Log__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_Log__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -11558,12 +11604,12 @@ func (m MaybeLogs) IsAbsent() bool {
 func (m MaybeLogs) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeLogs) AsNode() ipld.Node {
+func (m MaybeLogs) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -11577,40 +11623,40 @@ func (m MaybeLogs) Must() Logs {
 	return &m.v
 }
 
-var _ ipld.Node = (Logs)(&_Logs{})
+var _ datamodel.Node = (Logs)(&_Logs{})
 var _ schema.TypedNode = (Logs)(&_Logs{})
 
-func (Logs) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (Logs) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (Logs) LookupByString(string) (ipld.Node, error) {
+func (Logs) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Logs"}.LookupByString("")
 }
-func (n Logs) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n Logs) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n Logs) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n Logs) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n Logs) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Logs) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Logs", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Logs", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (Logs) MapIterator() ipld.MapIterator {
+func (Logs) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n Logs) ListIterator() ipld.ListIterator {
+func (n Logs) ListIterator() datamodel.ListIterator {
 	return &_Logs__ListItr{n, 0}
 }
 
@@ -11619,9 +11665,9 @@ type _Logs__ListItr struct {
 	idx int
 }
 
-func (itr *_Logs__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_Logs__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -11657,16 +11703,16 @@ func (Logs) AsString() (string, error) {
 func (Logs) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Logs"}.AsBytes()
 }
-func (Logs) AsLink() (ipld.Link, error) {
+func (Logs) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Logs"}.AsLink()
 }
-func (Logs) Prototype() ipld.NodePrototype {
+func (Logs) Prototype() datamodel.NodePrototype {
 	return _Logs__Prototype{}
 }
 
 type _Logs__Prototype struct{}
 
-func (_Logs__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Logs__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Logs__Builder
 	nb.Reset()
 	return &nb
@@ -11676,7 +11722,7 @@ type _Logs__Builder struct {
 	_Logs__Assembler
 }
 
-func (nb *_Logs__Builder) Build() ipld.Node {
+func (nb *_Logs__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -11701,10 +11747,10 @@ func (na *_Logs__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Logs__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Logs__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Logs"}.BeginMap(0)
 }
-func (na *_Logs__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Logs__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -11749,10 +11795,10 @@ func (_Logs__Assembler) AssignString(string) error {
 func (_Logs__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Logs"}.AssignBytes(nil)
 }
-func (_Logs__Assembler) AssignLink(ipld.Link) error {
+func (_Logs__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Logs"}.AssignLink(nil)
 }
-func (na *_Logs__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Logs__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -11767,8 +11813,8 @@ func (na *_Logs__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Logs", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Logs", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -11782,7 +11828,7 @@ func (na *_Logs__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Logs__Assembler) Prototype() ipld.NodePrototype {
+func (_Logs__Assembler) Prototype() datamodel.NodePrototype {
 	return _Logs__Prototype{}
 }
 func (la *_Logs__Assembler) valueFinishTidy() bool {
@@ -11797,7 +11843,7 @@ func (la *_Logs__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Logs__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Logs__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -11830,59 +11876,59 @@ func (la *_Logs__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Logs__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Logs__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Log__Prototype{}
 }
 func (Logs) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Logs) Representation() ipld.Node {
+func (n Logs) Representation() datamodel.Node {
 	return (*_Logs__Repr)(n)
 }
 
 type _Logs__Repr _Logs
 
-var _ ipld.Node = &_Logs__Repr{}
+var _ datamodel.Node = &_Logs__Repr{}
 
-func (_Logs__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_Logs__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_Logs__Repr) LookupByString(string) (ipld.Node, error) {
+func (_Logs__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Logs.Repr"}.LookupByString("")
 }
-func (nr *_Logs__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_Logs__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (Logs)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Log).Representation(), nil
 }
-func (nr *_Logs__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_Logs__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (Logs)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Log).Representation(), nil
 }
-func (n _Logs__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Logs__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Logs.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Logs.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_Logs__Repr) MapIterator() ipld.MapIterator {
+func (_Logs__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_Logs__Repr) ListIterator() ipld.ListIterator {
+func (nr *_Logs__Repr) ListIterator() datamodel.ListIterator {
 	return &_Logs__ReprListItr{(Logs)(nr), 0}
 }
 
 type _Logs__ReprListItr _Logs__ListItr
 
-func (itr *_Logs__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_Logs__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_Logs__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(Log).Representation(), nil
@@ -11915,16 +11961,16 @@ func (_Logs__Repr) AsString() (string, error) {
 func (_Logs__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Logs.Repr"}.AsBytes()
 }
-func (_Logs__Repr) AsLink() (ipld.Link, error) {
+func (_Logs__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Logs.Repr"}.AsLink()
 }
-func (_Logs__Repr) Prototype() ipld.NodePrototype {
+func (_Logs__Repr) Prototype() datamodel.NodePrototype {
 	return _Logs__ReprPrototype{}
 }
 
 type _Logs__ReprPrototype struct{}
 
-func (_Logs__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Logs__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Logs__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -11934,7 +11980,7 @@ type _Logs__ReprBuilder struct {
 	_Logs__ReprAssembler
 }
 
-func (nb *_Logs__ReprBuilder) Build() ipld.Node {
+func (nb *_Logs__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -11959,10 +12005,10 @@ func (na *_Logs__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Logs__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Logs__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Logs.Repr"}.BeginMap(0)
 }
-func (na *_Logs__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Logs__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -12007,10 +12053,10 @@ func (_Logs__ReprAssembler) AssignString(string) error {
 func (_Logs__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Logs.Repr"}.AssignBytes(nil)
 }
-func (_Logs__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Logs__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Logs.Repr"}.AssignLink(nil)
 }
-func (na *_Logs__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Logs__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -12025,8 +12071,8 @@ func (na *_Logs__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Logs.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Logs.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -12040,7 +12086,7 @@ func (na *_Logs__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Logs__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Logs__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Logs__ReprPrototype{}
 }
 func (la *_Logs__ReprAssembler) valueFinishTidy() bool {
@@ -12055,7 +12101,7 @@ func (la *_Logs__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Logs__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Logs__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -12088,7 +12134,7 @@ func (la *_Logs__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Logs__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Logs__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Log__ReprPrototype{}
 }
 
@@ -12115,12 +12161,12 @@ func (m MaybeOpCode) IsAbsent() bool {
 func (m MaybeOpCode) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeOpCode) AsNode() ipld.Node {
+func (m MaybeOpCode) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -12134,28 +12180,28 @@ func (m MaybeOpCode) Must() OpCode {
 	return &m.v
 }
 
-var _ ipld.Node = (OpCode)(&_OpCode{})
+var _ datamodel.Node = (OpCode)(&_OpCode{})
 var _ schema.TypedNode = (OpCode)(&_OpCode{})
 
-func (OpCode) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (OpCode) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (OpCode) LookupByString(string) (ipld.Node, error) {
+func (OpCode) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.OpCode"}.LookupByString("")
 }
-func (OpCode) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (OpCode) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.OpCode"}.LookupByNode(nil)
 }
-func (OpCode) LookupByIndex(idx int64) (ipld.Node, error) {
+func (OpCode) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.OpCode"}.LookupByIndex(0)
 }
-func (OpCode) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (OpCode) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.OpCode"}.LookupBySegment(seg)
 }
-func (OpCode) MapIterator() ipld.MapIterator {
+func (OpCode) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (OpCode) ListIterator() ipld.ListIterator {
+func (OpCode) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (OpCode) Length() int64 {
@@ -12182,16 +12228,16 @@ func (OpCode) AsString() (string, error) {
 func (n OpCode) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (OpCode) AsLink() (ipld.Link, error) {
+func (OpCode) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.OpCode"}.AsLink()
 }
-func (OpCode) Prototype() ipld.NodePrototype {
+func (OpCode) Prototype() datamodel.NodePrototype {
 	return _OpCode__Prototype{}
 }
 
 type _OpCode__Prototype struct{}
 
-func (_OpCode__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_OpCode__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _OpCode__Builder
 	nb.Reset()
 	return &nb
@@ -12201,7 +12247,7 @@ type _OpCode__Builder struct {
 	_OpCode__Assembler
 }
 
-func (nb *_OpCode__Builder) Build() ipld.Node {
+func (nb *_OpCode__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -12219,10 +12265,10 @@ type _OpCode__Assembler struct {
 }
 
 func (na *_OpCode__Assembler) reset() {}
-func (_OpCode__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_OpCode__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.OpCode"}.BeginMap(0)
 }
-func (_OpCode__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_OpCode__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.OpCode"}.BeginList(0)
 }
 func (na *_OpCode__Assembler) AssignNull() error {
@@ -12258,10 +12304,10 @@ func (na *_OpCode__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_OpCode__Assembler) AssignLink(ipld.Link) error {
+func (_OpCode__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.OpCode"}.AssignLink(nil)
 }
-func (na *_OpCode__Assembler) AssignNode(v ipld.Node) error {
+func (na *_OpCode__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -12280,19 +12326,19 @@ func (na *_OpCode__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_OpCode__Assembler) Prototype() ipld.NodePrototype {
+func (_OpCode__Assembler) Prototype() datamodel.NodePrototype {
 	return _OpCode__Prototype{}
 }
 func (OpCode) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n OpCode) Representation() ipld.Node {
+func (n OpCode) Representation() datamodel.Node {
 	return (*_OpCode__Repr)(n)
 }
 
 type _OpCode__Repr = _OpCode
 
-var _ ipld.Node = &_OpCode__Repr{}
+var _ datamodel.Node = &_OpCode__Repr{}
 
 type _OpCode__ReprPrototype = _OpCode__Prototype
 type _OpCode__ReprAssembler = _OpCode__Assembler
@@ -12315,7 +12361,7 @@ func (n _Receipt) FieldBloom() Bloom {
 func (n _Receipt) FieldLogs() Logs {
 	return &n.Logs
 }
-func (n _Receipt) FieldLogRootCID() Link {
+func (n _Receipt) FieldLogRootCID() MaybeLink {
 	return &n.LogRootCID
 }
 
@@ -12334,12 +12380,12 @@ func (m MaybeReceipt) IsAbsent() bool {
 func (m MaybeReceipt) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeReceipt) AsNode() ipld.Node {
+func (m MaybeReceipt) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -12362,24 +12408,24 @@ var (
 	fieldName__Receipt_Logs              = _String{"Logs"}
 	fieldName__Receipt_LogRootCID        = _String{"LogRootCID"}
 )
-var _ ipld.Node = (Receipt)(&_Receipt{})
+var _ datamodel.Node = (Receipt)(&_Receipt{})
 var _ schema.TypedNode = (Receipt)(&_Receipt{})
 
-func (Receipt) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Receipt) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Receipt) LookupByString(key string) (ipld.Node, error) {
+func (n Receipt) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "TxType":
 		return &n.TxType, nil
 	case "PostState":
 		if n.PostState.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.PostState.v, nil
 	case "Status":
 		if n.Status.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.Status.v, nil
 	case "CumulativeGasUsed":
@@ -12389,25 +12435,28 @@ func (n Receipt) LookupByString(key string) (ipld.Node, error) {
 	case "Logs":
 		return &n.Logs, nil
 	case "LogRootCID":
-		return &n.LogRootCID, nil
+		if n.LogRootCID.m == schema.Maybe_Absent {
+			return datamodel.Absent, nil
+		}
+		return &n.LogRootCID.v, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Receipt) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Receipt) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Receipt) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Receipt) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Receipt"}.LookupByIndex(0)
 }
-func (n Receipt) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Receipt) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Receipt) MapIterator() ipld.MapIterator {
+func (n Receipt) MapIterator() datamodel.MapIterator {
 	return &_Receipt__MapItr{n, 0}
 }
 
@@ -12416,9 +12465,9 @@ type _Receipt__MapItr struct {
 	idx int
 }
 
-func (itr *_Receipt__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Receipt__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 7 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -12427,14 +12476,14 @@ func (itr *_Receipt__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	case 1:
 		k = &fieldName__Receipt_PostState
 		if itr.n.PostState.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.PostState.v
 	case 2:
 		k = &fieldName__Receipt_Status
 		if itr.n.Status.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.Status.v
@@ -12449,7 +12498,11 @@ func (itr *_Receipt__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 		v = &itr.n.Logs
 	case 6:
 		k = &fieldName__Receipt_LogRootCID
-		v = &itr.n.LogRootCID
+		if itr.n.LogRootCID.m == schema.Maybe_Absent {
+			v = datamodel.Absent
+			break
+		}
+		v = &itr.n.LogRootCID.v
 	default:
 		panic("unreachable")
 	}
@@ -12460,7 +12513,7 @@ func (itr *_Receipt__MapItr) Done() bool {
 	return itr.idx >= 7
 }
 
-func (Receipt) ListIterator() ipld.ListIterator {
+func (Receipt) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Receipt) Length() int64 {
@@ -12487,16 +12540,16 @@ func (Receipt) AsString() (string, error) {
 func (Receipt) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Receipt"}.AsBytes()
 }
-func (Receipt) AsLink() (ipld.Link, error) {
+func (Receipt) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Receipt"}.AsLink()
 }
-func (Receipt) Prototype() ipld.NodePrototype {
+func (Receipt) Prototype() datamodel.NodePrototype {
 	return _Receipt__Prototype{}
 }
 
 type _Receipt__Prototype struct{}
 
-func (_Receipt__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Receipt__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Receipt__Builder
 	nb.Reset()
 	return &nb
@@ -12506,7 +12559,7 @@ type _Receipt__Builder struct {
 	_Receipt__Assembler
 }
 
-func (nb *_Receipt__Builder) Build() ipld.Node {
+func (nb *_Receipt__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -12555,10 +12608,10 @@ var (
 	fieldBit__Receipt_Bloom             = 1 << 4
 	fieldBit__Receipt_Logs              = 1 << 5
 	fieldBit__Receipt_LogRootCID        = 1 << 6
-	fieldBits__Receipt_sufficient       = 0 + 1<<0 + 1<<1 + 1<<2 + 1<<3 + 1<<4 + 1<<5 + 1<<6
+	fieldBits__Receipt_sufficient       = 0 + 1<<0 + 1<<1 + 1<<2 + 1<<3 + 1<<4 + 1<<5
 )
 
-func (na *_Receipt__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Receipt__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -12571,7 +12624,7 @@ func (na *_Receipt__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Receipt__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Receipt__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Receipt"}.BeginList(0)
 }
 func (na *_Receipt__Assembler) AssignNull() error {
@@ -12603,10 +12656,10 @@ func (_Receipt__Assembler) AssignString(string) error {
 func (_Receipt__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Receipt"}.AssignBytes(nil)
 }
-func (_Receipt__Assembler) AssignLink(ipld.Link) error {
+func (_Receipt__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Receipt"}.AssignLink(nil)
 }
-func (na *_Receipt__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Receipt__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -12626,8 +12679,8 @@ func (na *_Receipt__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Receipt", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Receipt", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -12644,7 +12697,7 @@ func (na *_Receipt__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Receipt__Assembler) Prototype() ipld.NodePrototype {
+func (_Receipt__Assembler) Prototype() datamodel.NodePrototype {
 	return _Receipt__Prototype{}
 }
 func (ma *_Receipt__Assembler) valueFinishTidy() bool {
@@ -12712,10 +12765,8 @@ func (ma *_Receipt__Assembler) valueFinishTidy() bool {
 			return false
 		}
 	case 6:
-		switch ma.cm {
+		switch ma.w.LogRootCID.m {
 		case schema.Maybe_Value:
-			ma.ca_LogRootCID.w = nil
-			ma.cm = schema.Maybe_Absent
 			ma.state = maState_initial
 			return true
 		default:
@@ -12725,7 +12776,7 @@ func (ma *_Receipt__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Receipt__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Receipt__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -12743,7 +12794,7 @@ func (ma *_Receipt__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 	switch k {
 	case "TxType":
 		if ma.s&fieldBit__Receipt_TxType != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_TxType}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_TxType}
 		}
 		ma.s += fieldBit__Receipt_TxType
 		ma.state = maState_midValue
@@ -12753,7 +12804,7 @@ func (ma *_Receipt__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_TxType, nil
 	case "PostState":
 		if ma.s&fieldBit__Receipt_PostState != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_PostState}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_PostState}
 		}
 		ma.s += fieldBit__Receipt_PostState
 		ma.state = maState_midValue
@@ -12764,7 +12815,7 @@ func (ma *_Receipt__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_PostState, nil
 	case "Status":
 		if ma.s&fieldBit__Receipt_Status != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Status}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Status}
 		}
 		ma.s += fieldBit__Receipt_Status
 		ma.state = maState_midValue
@@ -12775,7 +12826,7 @@ func (ma *_Receipt__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Status, nil
 	case "CumulativeGasUsed":
 		if ma.s&fieldBit__Receipt_CumulativeGasUsed != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_CumulativeGasUsed}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_CumulativeGasUsed}
 		}
 		ma.s += fieldBit__Receipt_CumulativeGasUsed
 		ma.state = maState_midValue
@@ -12785,7 +12836,7 @@ func (ma *_Receipt__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_CumulativeGasUsed, nil
 	case "Bloom":
 		if ma.s&fieldBit__Receipt_Bloom != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Bloom}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Bloom}
 		}
 		ma.s += fieldBit__Receipt_Bloom
 		ma.state = maState_midValue
@@ -12795,7 +12846,7 @@ func (ma *_Receipt__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Bloom, nil
 	case "Logs":
 		if ma.s&fieldBit__Receipt_Logs != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Logs}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Logs}
 		}
 		ma.s += fieldBit__Receipt_Logs
 		ma.state = maState_midValue
@@ -12805,18 +12856,18 @@ func (ma *_Receipt__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Logs, nil
 	case "LogRootCID":
 		if ma.s&fieldBit__Receipt_LogRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_LogRootCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_LogRootCID}
 		}
 		ma.s += fieldBit__Receipt_LogRootCID
 		ma.state = maState_midValue
 		ma.f = 6
-		ma.ca_LogRootCID.w = &ma.w.LogRootCID
-		ma.ca_LogRootCID.m = &ma.cm
+		ma.ca_LogRootCID.w = &ma.w.LogRootCID.v
+		ma.ca_LogRootCID.m = &ma.w.LogRootCID.m
 		return &ma.ca_LogRootCID, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Receipt", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Receipt", Key: &_String{k}}
 }
-func (ma *_Receipt__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Receipt__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -12834,7 +12885,7 @@ func (ma *_Receipt__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Receipt__KeyAssembler)(ma)
 }
-func (ma *_Receipt__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Receipt__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -12876,8 +12927,8 @@ func (ma *_Receipt__Assembler) AssembleValue() ipld.NodeAssembler {
 		ma.ca_Logs.m = &ma.cm
 		return &ma.ca_Logs
 	case 6:
-		ma.ca_LogRootCID.w = &ma.w.LogRootCID
-		ma.ca_LogRootCID.m = &ma.cm
+		ma.ca_LogRootCID.w = &ma.w.LogRootCID.v
+		ma.ca_LogRootCID.m = &ma.w.LogRootCID.m
 		return &ma.ca_LogRootCID
 	default:
 		panic("unreachable")
@@ -12899,7 +12950,7 @@ func (ma *_Receipt__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Receipt_sufficient != fieldBits__Receipt_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Receipt_TxType == 0 {
 			err.Missing = append(err.Missing, "TxType")
 		}
@@ -12912,28 +12963,25 @@ func (ma *_Receipt__Assembler) Finish() error {
 		if ma.s&fieldBit__Receipt_Logs == 0 {
 			err.Missing = append(err.Missing, "Logs")
 		}
-		if ma.s&fieldBit__Receipt_LogRootCID == 0 {
-			err.Missing = append(err.Missing, "LogRootCID")
-		}
 		return err
 	}
 	ma.state = maState_finished
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Receipt__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Receipt__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Receipt__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Receipt__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _Receipt__KeyAssembler _Receipt__Assembler
 
-func (_Receipt__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Receipt__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Receipt.KeyAssembler"}.BeginMap(0)
 }
-func (_Receipt__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Receipt__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Receipt.KeyAssembler"}.BeginList(0)
 }
 func (na *_Receipt__KeyAssembler) AssignNull() error {
@@ -12955,78 +13003,84 @@ func (ka *_Receipt__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "TxType":
 		if ka.s&fieldBit__Receipt_TxType != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_TxType}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_TxType}
 		}
 		ka.s += fieldBit__Receipt_TxType
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "PostState":
 		if ka.s&fieldBit__Receipt_PostState != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_PostState}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_PostState}
 		}
 		ka.s += fieldBit__Receipt_PostState
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	case "Status":
 		if ka.s&fieldBit__Receipt_Status != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Status}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Status}
 		}
 		ka.s += fieldBit__Receipt_Status
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	case "CumulativeGasUsed":
 		if ka.s&fieldBit__Receipt_CumulativeGasUsed != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_CumulativeGasUsed}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_CumulativeGasUsed}
 		}
 		ka.s += fieldBit__Receipt_CumulativeGasUsed
 		ka.state = maState_expectValue
 		ka.f = 3
+		return nil
 	case "Bloom":
 		if ka.s&fieldBit__Receipt_Bloom != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Bloom}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Bloom}
 		}
 		ka.s += fieldBit__Receipt_Bloom
 		ka.state = maState_expectValue
 		ka.f = 4
+		return nil
 	case "Logs":
 		if ka.s&fieldBit__Receipt_Logs != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Logs}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Logs}
 		}
 		ka.s += fieldBit__Receipt_Logs
 		ka.state = maState_expectValue
 		ka.f = 5
+		return nil
 	case "LogRootCID":
 		if ka.s&fieldBit__Receipt_LogRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_LogRootCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_LogRootCID}
 		}
 		ka.s += fieldBit__Receipt_LogRootCID
 		ka.state = maState_expectValue
 		ka.f = 6
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.Receipt", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.Receipt", Key: &_String{k}}
 	}
-	return nil
 }
 func (_Receipt__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Receipt.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Receipt__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Receipt__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Receipt.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Receipt__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Receipt__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Receipt__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Receipt__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Receipt) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Receipt) Representation() ipld.Node {
+func (n Receipt) Representation() datamodel.Node {
 	return (*_Receipt__Repr)(n)
 }
 
@@ -13041,23 +13095,23 @@ var (
 	fieldName__Receipt_Logs_serial              = _String{"Logs"}
 	fieldName__Receipt_LogRootCID_serial        = _String{"LogRootCID"}
 )
-var _ ipld.Node = &_Receipt__Repr{}
+var _ datamodel.Node = &_Receipt__Repr{}
 
-func (_Receipt__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_Receipt__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_Receipt__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_Receipt__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "TxType":
 		return n.TxType.Representation(), nil
 	case "PostState":
 		if n.PostState.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.PostState.v.Representation(), nil
 	case "Status":
 		if n.Status.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Status.v.Representation(), nil
 	case "CumulativeGasUsed":
@@ -13067,36 +13121,48 @@ func (n *_Receipt__Repr) LookupByString(key string) (ipld.Node, error) {
 	case "Logs":
 		return n.Logs.Representation(), nil
 	case "LogRootCID":
-		return n.LogRootCID.Representation(), nil
+		if n.LogRootCID.m == schema.Maybe_Absent {
+			return datamodel.Absent, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
+		}
+		return n.LogRootCID.v.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_Receipt__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_Receipt__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_Receipt__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_Receipt__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Receipt.Repr"}.LookupByIndex(0)
 }
-func (n _Receipt__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Receipt__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_Receipt__Repr) MapIterator() ipld.MapIterator {
-	return &_Receipt__ReprMapItr{n, 0}
+func (n *_Receipt__Repr) MapIterator() datamodel.MapIterator {
+	end := 7
+	if n.LogRootCID.m == schema.Maybe_Absent {
+		end = 6
+	} else {
+		goto done
+	}
+done:
+	return &_Receipt__ReprMapItr{n, 0, end}
 }
 
 type _Receipt__ReprMapItr struct {
 	n   *_Receipt__Repr
 	idx int
+	end int
 }
 
-func (itr *_Receipt__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Receipt__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
+advance:
 	if itr.idx >= 7 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -13105,14 +13171,14 @@ func (itr *_Receipt__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	case 1:
 		k = &fieldName__Receipt_PostState_serial
 		if itr.n.PostState.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.PostState.v.Representation()
 	case 2:
 		k = &fieldName__Receipt_Status_serial
 		if itr.n.Status.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Status.v.Representation()
@@ -13127,7 +13193,11 @@ func (itr *_Receipt__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 		v = itr.n.Logs.Representation()
 	case 6:
 		k = &fieldName__Receipt_LogRootCID_serial
-		v = itr.n.LogRootCID.Representation()
+		if itr.n.LogRootCID.m == schema.Maybe_Absent {
+			itr.idx++
+			goto advance
+		}
+		v = itr.n.LogRootCID.v.Representation()
 	default:
 		panic("unreachable")
 	}
@@ -13135,13 +13205,16 @@ func (itr *_Receipt__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	return
 }
 func (itr *_Receipt__ReprMapItr) Done() bool {
-	return itr.idx >= 7
+	return itr.idx >= itr.end
 }
-func (_Receipt__Repr) ListIterator() ipld.ListIterator {
+func (_Receipt__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_Receipt__Repr) Length() int64 {
 	l := 7
+	if rn.LogRootCID.m == schema.Maybe_Absent {
+		l--
+	}
 	return int64(l)
 }
 func (_Receipt__Repr) IsAbsent() bool {
@@ -13165,16 +13238,16 @@ func (_Receipt__Repr) AsString() (string, error) {
 func (_Receipt__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Receipt.Repr"}.AsBytes()
 }
-func (_Receipt__Repr) AsLink() (ipld.Link, error) {
+func (_Receipt__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Receipt.Repr"}.AsLink()
 }
-func (_Receipt__Repr) Prototype() ipld.NodePrototype {
+func (_Receipt__Repr) Prototype() datamodel.NodePrototype {
 	return _Receipt__ReprPrototype{}
 }
 
 type _Receipt__ReprPrototype struct{}
 
-func (_Receipt__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Receipt__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Receipt__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -13184,7 +13257,7 @@ type _Receipt__ReprBuilder struct {
 	_Receipt__ReprAssembler
 }
 
-func (nb *_Receipt__ReprBuilder) Build() ipld.Node {
+func (nb *_Receipt__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -13224,7 +13297,7 @@ func (na *_Receipt__ReprAssembler) reset() {
 	na.ca_Logs.reset()
 	na.ca_LogRootCID.reset()
 }
-func (na *_Receipt__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Receipt__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -13237,7 +13310,7 @@ func (na *_Receipt__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Receipt__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Receipt__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Receipt.Repr"}.BeginList(0)
 }
 func (na *_Receipt__ReprAssembler) AssignNull() error {
@@ -13269,10 +13342,10 @@ func (_Receipt__ReprAssembler) AssignString(string) error {
 func (_Receipt__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Receipt.Repr"}.AssignBytes(nil)
 }
-func (_Receipt__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Receipt__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Receipt.Repr"}.AssignLink(nil)
 }
-func (na *_Receipt__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Receipt__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -13292,8 +13365,8 @@ func (na *_Receipt__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Receipt.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Receipt.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -13310,7 +13383,7 @@ func (na *_Receipt__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Receipt__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Receipt__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Receipt__ReprPrototype{}
 }
 func (ma *_Receipt__ReprAssembler) valueFinishTidy() bool {
@@ -13374,9 +13447,8 @@ func (ma *_Receipt__ReprAssembler) valueFinishTidy() bool {
 			return false
 		}
 	case 6:
-		switch ma.cm {
+		switch ma.w.LogRootCID.m {
 		case schema.Maybe_Value:
-			ma.cm = schema.Maybe_Absent
 			ma.state = maState_initial
 			return true
 		default:
@@ -13386,7 +13458,7 @@ func (ma *_Receipt__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -13404,7 +13476,7 @@ func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 	switch k {
 	case "TxType":
 		if ma.s&fieldBit__Receipt_TxType != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_TxType_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_TxType_serial}
 		}
 		ma.s += fieldBit__Receipt_TxType
 		ma.state = maState_midValue
@@ -13414,7 +13486,7 @@ func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_TxType, nil
 	case "PostState":
 		if ma.s&fieldBit__Receipt_PostState != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_PostState_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_PostState_serial}
 		}
 		ma.s += fieldBit__Receipt_PostState
 		ma.state = maState_midValue
@@ -13425,7 +13497,7 @@ func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_PostState, nil
 	case "Status":
 		if ma.s&fieldBit__Receipt_Status != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Status_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Status_serial}
 		}
 		ma.s += fieldBit__Receipt_Status
 		ma.state = maState_midValue
@@ -13436,7 +13508,7 @@ func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Status, nil
 	case "CumulativeGasUsed":
 		if ma.s&fieldBit__Receipt_CumulativeGasUsed != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_CumulativeGasUsed_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_CumulativeGasUsed_serial}
 		}
 		ma.s += fieldBit__Receipt_CumulativeGasUsed
 		ma.state = maState_midValue
@@ -13446,7 +13518,7 @@ func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_CumulativeGasUsed, nil
 	case "Bloom":
 		if ma.s&fieldBit__Receipt_Bloom != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Bloom_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Bloom_serial}
 		}
 		ma.s += fieldBit__Receipt_Bloom
 		ma.state = maState_midValue
@@ -13456,7 +13528,7 @@ func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Bloom, nil
 	case "Logs":
 		if ma.s&fieldBit__Receipt_Logs != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Logs_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Logs_serial}
 		}
 		ma.s += fieldBit__Receipt_Logs
 		ma.state = maState_midValue
@@ -13466,19 +13538,20 @@ func (ma *_Receipt__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Logs, nil
 	case "LogRootCID":
 		if ma.s&fieldBit__Receipt_LogRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_LogRootCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_LogRootCID_serial}
 		}
 		ma.s += fieldBit__Receipt_LogRootCID
 		ma.state = maState_midValue
 		ma.f = 6
-		ma.ca_LogRootCID.w = &ma.w.LogRootCID
-		ma.ca_LogRootCID.m = &ma.cm
+		ma.ca_LogRootCID.w = &ma.w.LogRootCID.v
+		ma.ca_LogRootCID.m = &ma.w.LogRootCID.m
+
 		return &ma.ca_LogRootCID, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Receipt.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Receipt.Repr", Key: &_String{k}}
 }
-func (ma *_Receipt__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Receipt__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -13496,7 +13569,7 @@ func (ma *_Receipt__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Receipt__ReprKeyAssembler)(ma)
 }
-func (ma *_Receipt__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Receipt__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -13538,8 +13611,9 @@ func (ma *_Receipt__ReprAssembler) AssembleValue() ipld.NodeAssembler {
 		ma.ca_Logs.m = &ma.cm
 		return &ma.ca_Logs
 	case 6:
-		ma.ca_LogRootCID.w = &ma.w.LogRootCID
-		ma.ca_LogRootCID.m = &ma.cm
+		ma.ca_LogRootCID.w = &ma.w.LogRootCID.v
+		ma.ca_LogRootCID.m = &ma.w.LogRootCID.m
+
 		return &ma.ca_LogRootCID
 	default:
 		panic("unreachable")
@@ -13561,7 +13635,7 @@ func (ma *_Receipt__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Receipt_sufficient != fieldBits__Receipt_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Receipt_TxType == 0 {
 			err.Missing = append(err.Missing, "TxType")
 		}
@@ -13574,28 +13648,25 @@ func (ma *_Receipt__ReprAssembler) Finish() error {
 		if ma.s&fieldBit__Receipt_Logs == 0 {
 			err.Missing = append(err.Missing, "Logs")
 		}
-		if ma.s&fieldBit__Receipt_LogRootCID == 0 {
-			err.Missing = append(err.Missing, "LogRootCID")
-		}
 		return err
 	}
 	ma.state = maState_finished
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Receipt__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Receipt__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Receipt__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Receipt__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _Receipt__ReprKeyAssembler _Receipt__ReprAssembler
 
-func (_Receipt__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Receipt__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Receipt.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_Receipt__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Receipt__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Receipt.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_Receipt__ReprKeyAssembler) AssignNull() error {
@@ -13617,7 +13688,7 @@ func (ka *_Receipt__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "TxType":
 		if ka.s&fieldBit__Receipt_TxType != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_TxType_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_TxType_serial}
 		}
 		ka.s += fieldBit__Receipt_TxType
 		ka.state = maState_expectValue
@@ -13625,7 +13696,7 @@ func (ka *_Receipt__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "PostState":
 		if ka.s&fieldBit__Receipt_PostState != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_PostState_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_PostState_serial}
 		}
 		ka.s += fieldBit__Receipt_PostState
 		ka.state = maState_expectValue
@@ -13633,7 +13704,7 @@ func (ka *_Receipt__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Status":
 		if ka.s&fieldBit__Receipt_Status != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Status_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Status_serial}
 		}
 		ka.s += fieldBit__Receipt_Status
 		ka.state = maState_expectValue
@@ -13641,7 +13712,7 @@ func (ka *_Receipt__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "CumulativeGasUsed":
 		if ka.s&fieldBit__Receipt_CumulativeGasUsed != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_CumulativeGasUsed_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_CumulativeGasUsed_serial}
 		}
 		ka.s += fieldBit__Receipt_CumulativeGasUsed
 		ka.state = maState_expectValue
@@ -13649,7 +13720,7 @@ func (ka *_Receipt__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Bloom":
 		if ka.s&fieldBit__Receipt_Bloom != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Bloom_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Bloom_serial}
 		}
 		ka.s += fieldBit__Receipt_Bloom
 		ka.state = maState_expectValue
@@ -13657,7 +13728,7 @@ func (ka *_Receipt__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Logs":
 		if ka.s&fieldBit__Receipt_Logs != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_Logs_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_Logs_serial}
 		}
 		ka.s += fieldBit__Receipt_Logs
 		ka.state = maState_expectValue
@@ -13665,29 +13736,29 @@ func (ka *_Receipt__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "LogRootCID":
 		if ka.s&fieldBit__Receipt_LogRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Receipt_LogRootCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Receipt_LogRootCID_serial}
 		}
 		ka.s += fieldBit__Receipt_LogRootCID
 		ka.state = maState_expectValue
 		ka.f = 6
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Receipt.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.Receipt.Repr", Key: &_String{k}}
 }
 func (_Receipt__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Receipt.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Receipt__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_Receipt__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Receipt.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Receipt__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Receipt__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Receipt__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_Receipt__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -13748,12 +13819,12 @@ func (m MaybeReceipts) IsAbsent() bool {
 func (m MaybeReceipts) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeReceipts) AsNode() ipld.Node {
+func (m MaybeReceipts) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -13767,40 +13838,40 @@ func (m MaybeReceipts) Must() Receipts {
 	return &m.v
 }
 
-var _ ipld.Node = (Receipts)(&_Receipts{})
+var _ datamodel.Node = (Receipts)(&_Receipts{})
 var _ schema.TypedNode = (Receipts)(&_Receipts{})
 
-func (Receipts) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (Receipts) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (Receipts) LookupByString(string) (ipld.Node, error) {
+func (Receipts) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Receipts"}.LookupByString("")
 }
-func (n Receipts) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n Receipts) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n Receipts) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n Receipts) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n Receipts) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Receipts) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Receipts", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Receipts", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (Receipts) MapIterator() ipld.MapIterator {
+func (Receipts) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n Receipts) ListIterator() ipld.ListIterator {
+func (n Receipts) ListIterator() datamodel.ListIterator {
 	return &_Receipts__ListItr{n, 0}
 }
 
@@ -13809,9 +13880,9 @@ type _Receipts__ListItr struct {
 	idx int
 }
 
-func (itr *_Receipts__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_Receipts__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -13847,16 +13918,16 @@ func (Receipts) AsString() (string, error) {
 func (Receipts) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Receipts"}.AsBytes()
 }
-func (Receipts) AsLink() (ipld.Link, error) {
+func (Receipts) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Receipts"}.AsLink()
 }
-func (Receipts) Prototype() ipld.NodePrototype {
+func (Receipts) Prototype() datamodel.NodePrototype {
 	return _Receipts__Prototype{}
 }
 
 type _Receipts__Prototype struct{}
 
-func (_Receipts__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Receipts__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Receipts__Builder
 	nb.Reset()
 	return &nb
@@ -13866,7 +13937,7 @@ type _Receipts__Builder struct {
 	_Receipts__Assembler
 }
 
-func (nb *_Receipts__Builder) Build() ipld.Node {
+func (nb *_Receipts__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -13891,10 +13962,10 @@ func (na *_Receipts__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Receipts__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Receipts__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Receipts"}.BeginMap(0)
 }
-func (na *_Receipts__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Receipts__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -13939,10 +14010,10 @@ func (_Receipts__Assembler) AssignString(string) error {
 func (_Receipts__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Receipts"}.AssignBytes(nil)
 }
-func (_Receipts__Assembler) AssignLink(ipld.Link) error {
+func (_Receipts__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Receipts"}.AssignLink(nil)
 }
-func (na *_Receipts__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Receipts__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -13957,8 +14028,8 @@ func (na *_Receipts__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Receipts", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Receipts", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -13972,7 +14043,7 @@ func (na *_Receipts__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Receipts__Assembler) Prototype() ipld.NodePrototype {
+func (_Receipts__Assembler) Prototype() datamodel.NodePrototype {
 	return _Receipts__Prototype{}
 }
 func (la *_Receipts__Assembler) valueFinishTidy() bool {
@@ -13987,7 +14058,7 @@ func (la *_Receipts__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Receipts__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Receipts__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -14020,59 +14091,59 @@ func (la *_Receipts__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Receipts__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Receipts__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Receipt__Prototype{}
 }
 func (Receipts) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Receipts) Representation() ipld.Node {
+func (n Receipts) Representation() datamodel.Node {
 	return (*_Receipts__Repr)(n)
 }
 
 type _Receipts__Repr _Receipts
 
-var _ ipld.Node = &_Receipts__Repr{}
+var _ datamodel.Node = &_Receipts__Repr{}
 
-func (_Receipts__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_Receipts__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_Receipts__Repr) LookupByString(string) (ipld.Node, error) {
+func (_Receipts__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Receipts.Repr"}.LookupByString("")
 }
-func (nr *_Receipts__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_Receipts__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (Receipts)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Receipt).Representation(), nil
 }
-func (nr *_Receipts__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_Receipts__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (Receipts)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Receipt).Representation(), nil
 }
-func (n _Receipts__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Receipts__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Receipts.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Receipts.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_Receipts__Repr) MapIterator() ipld.MapIterator {
+func (_Receipts__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_Receipts__Repr) ListIterator() ipld.ListIterator {
+func (nr *_Receipts__Repr) ListIterator() datamodel.ListIterator {
 	return &_Receipts__ReprListItr{(Receipts)(nr), 0}
 }
 
 type _Receipts__ReprListItr _Receipts__ListItr
 
-func (itr *_Receipts__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_Receipts__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_Receipts__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(Receipt).Representation(), nil
@@ -14105,16 +14176,16 @@ func (_Receipts__Repr) AsString() (string, error) {
 func (_Receipts__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Receipts.Repr"}.AsBytes()
 }
-func (_Receipts__Repr) AsLink() (ipld.Link, error) {
+func (_Receipts__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Receipts.Repr"}.AsLink()
 }
-func (_Receipts__Repr) Prototype() ipld.NodePrototype {
+func (_Receipts__Repr) Prototype() datamodel.NodePrototype {
 	return _Receipts__ReprPrototype{}
 }
 
 type _Receipts__ReprPrototype struct{}
 
-func (_Receipts__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Receipts__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Receipts__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -14124,7 +14195,7 @@ type _Receipts__ReprBuilder struct {
 	_Receipts__ReprAssembler
 }
 
-func (nb *_Receipts__ReprBuilder) Build() ipld.Node {
+func (nb *_Receipts__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -14149,10 +14220,10 @@ func (na *_Receipts__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Receipts__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Receipts__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Receipts.Repr"}.BeginMap(0)
 }
-func (na *_Receipts__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Receipts__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -14197,10 +14268,10 @@ func (_Receipts__ReprAssembler) AssignString(string) error {
 func (_Receipts__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Receipts.Repr"}.AssignBytes(nil)
 }
-func (_Receipts__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Receipts__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Receipts.Repr"}.AssignLink(nil)
 }
-func (na *_Receipts__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Receipts__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -14215,8 +14286,8 @@ func (na *_Receipts__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Receipts.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Receipts.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -14230,7 +14301,7 @@ func (na *_Receipts__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Receipts__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Receipts__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Receipts__ReprPrototype{}
 }
 func (la *_Receipts__ReprAssembler) valueFinishTidy() bool {
@@ -14245,7 +14316,7 @@ func (la *_Receipts__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Receipts__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Receipts__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -14278,7 +14349,7 @@ func (la *_Receipts__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Receipts__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Receipts__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Receipt__ReprPrototype{}
 }
 
@@ -14339,12 +14410,12 @@ func (m MaybeStorageKeys) IsAbsent() bool {
 func (m MaybeStorageKeys) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeStorageKeys) AsNode() ipld.Node {
+func (m MaybeStorageKeys) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -14358,40 +14429,40 @@ func (m MaybeStorageKeys) Must() StorageKeys {
 	return &m.v
 }
 
-var _ ipld.Node = (StorageKeys)(&_StorageKeys{})
+var _ datamodel.Node = (StorageKeys)(&_StorageKeys{})
 var _ schema.TypedNode = (StorageKeys)(&_StorageKeys{})
 
-func (StorageKeys) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (StorageKeys) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (StorageKeys) LookupByString(string) (ipld.Node, error) {
+func (StorageKeys) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.StorageKeys"}.LookupByString("")
 }
-func (n StorageKeys) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n StorageKeys) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n StorageKeys) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n StorageKeys) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n StorageKeys) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n StorageKeys) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.StorageKeys", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.StorageKeys", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (StorageKeys) MapIterator() ipld.MapIterator {
+func (StorageKeys) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n StorageKeys) ListIterator() ipld.ListIterator {
+func (n StorageKeys) ListIterator() datamodel.ListIterator {
 	return &_StorageKeys__ListItr{n, 0}
 }
 
@@ -14400,9 +14471,9 @@ type _StorageKeys__ListItr struct {
 	idx int
 }
 
-func (itr *_StorageKeys__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_StorageKeys__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -14438,16 +14509,16 @@ func (StorageKeys) AsString() (string, error) {
 func (StorageKeys) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.StorageKeys"}.AsBytes()
 }
-func (StorageKeys) AsLink() (ipld.Link, error) {
+func (StorageKeys) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.StorageKeys"}.AsLink()
 }
-func (StorageKeys) Prototype() ipld.NodePrototype {
+func (StorageKeys) Prototype() datamodel.NodePrototype {
 	return _StorageKeys__Prototype{}
 }
 
 type _StorageKeys__Prototype struct{}
 
-func (_StorageKeys__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_StorageKeys__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _StorageKeys__Builder
 	nb.Reset()
 	return &nb
@@ -14457,7 +14528,7 @@ type _StorageKeys__Builder struct {
 	_StorageKeys__Assembler
 }
 
-func (nb *_StorageKeys__Builder) Build() ipld.Node {
+func (nb *_StorageKeys__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -14482,10 +14553,10 @@ func (na *_StorageKeys__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_StorageKeys__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_StorageKeys__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.StorageKeys"}.BeginMap(0)
 }
-func (na *_StorageKeys__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_StorageKeys__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -14530,10 +14601,10 @@ func (_StorageKeys__Assembler) AssignString(string) error {
 func (_StorageKeys__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.StorageKeys"}.AssignBytes(nil)
 }
-func (_StorageKeys__Assembler) AssignLink(ipld.Link) error {
+func (_StorageKeys__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.StorageKeys"}.AssignLink(nil)
 }
-func (na *_StorageKeys__Assembler) AssignNode(v ipld.Node) error {
+func (na *_StorageKeys__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -14548,8 +14619,8 @@ func (na *_StorageKeys__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.StorageKeys", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.StorageKeys", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -14563,7 +14634,7 @@ func (na *_StorageKeys__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_StorageKeys__Assembler) Prototype() ipld.NodePrototype {
+func (_StorageKeys__Assembler) Prototype() datamodel.NodePrototype {
 	return _StorageKeys__Prototype{}
 }
 func (la *_StorageKeys__Assembler) valueFinishTidy() bool {
@@ -14578,7 +14649,7 @@ func (la *_StorageKeys__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_StorageKeys__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_StorageKeys__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -14611,59 +14682,59 @@ func (la *_StorageKeys__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_StorageKeys__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_StorageKeys__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Hash__Prototype{}
 }
 func (StorageKeys) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n StorageKeys) Representation() ipld.Node {
+func (n StorageKeys) Representation() datamodel.Node {
 	return (*_StorageKeys__Repr)(n)
 }
 
 type _StorageKeys__Repr _StorageKeys
 
-var _ ipld.Node = &_StorageKeys__Repr{}
+var _ datamodel.Node = &_StorageKeys__Repr{}
 
-func (_StorageKeys__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_StorageKeys__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_StorageKeys__Repr) LookupByString(string) (ipld.Node, error) {
+func (_StorageKeys__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.StorageKeys.Repr"}.LookupByString("")
 }
-func (nr *_StorageKeys__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_StorageKeys__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (StorageKeys)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Hash).Representation(), nil
 }
-func (nr *_StorageKeys__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_StorageKeys__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (StorageKeys)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Hash).Representation(), nil
 }
-func (n _StorageKeys__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _StorageKeys__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.StorageKeys.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.StorageKeys.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_StorageKeys__Repr) MapIterator() ipld.MapIterator {
+func (_StorageKeys__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_StorageKeys__Repr) ListIterator() ipld.ListIterator {
+func (nr *_StorageKeys__Repr) ListIterator() datamodel.ListIterator {
 	return &_StorageKeys__ReprListItr{(StorageKeys)(nr), 0}
 }
 
 type _StorageKeys__ReprListItr _StorageKeys__ListItr
 
-func (itr *_StorageKeys__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_StorageKeys__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_StorageKeys__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(Hash).Representation(), nil
@@ -14696,16 +14767,16 @@ func (_StorageKeys__Repr) AsString() (string, error) {
 func (_StorageKeys__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.StorageKeys.Repr"}.AsBytes()
 }
-func (_StorageKeys__Repr) AsLink() (ipld.Link, error) {
+func (_StorageKeys__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.StorageKeys.Repr"}.AsLink()
 }
-func (_StorageKeys__Repr) Prototype() ipld.NodePrototype {
+func (_StorageKeys__Repr) Prototype() datamodel.NodePrototype {
 	return _StorageKeys__ReprPrototype{}
 }
 
 type _StorageKeys__ReprPrototype struct{}
 
-func (_StorageKeys__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_StorageKeys__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _StorageKeys__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -14715,7 +14786,7 @@ type _StorageKeys__ReprBuilder struct {
 	_StorageKeys__ReprAssembler
 }
 
-func (nb *_StorageKeys__ReprBuilder) Build() ipld.Node {
+func (nb *_StorageKeys__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -14740,10 +14811,10 @@ func (na *_StorageKeys__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_StorageKeys__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_StorageKeys__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.StorageKeys.Repr"}.BeginMap(0)
 }
-func (na *_StorageKeys__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_StorageKeys__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -14788,10 +14859,10 @@ func (_StorageKeys__ReprAssembler) AssignString(string) error {
 func (_StorageKeys__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.StorageKeys.Repr"}.AssignBytes(nil)
 }
-func (_StorageKeys__ReprAssembler) AssignLink(ipld.Link) error {
+func (_StorageKeys__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.StorageKeys.Repr"}.AssignLink(nil)
 }
-func (na *_StorageKeys__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_StorageKeys__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -14806,8 +14877,8 @@ func (na *_StorageKeys__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.StorageKeys.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.StorageKeys.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -14821,7 +14892,7 @@ func (na *_StorageKeys__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_StorageKeys__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_StorageKeys__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _StorageKeys__ReprPrototype{}
 }
 func (la *_StorageKeys__ReprAssembler) valueFinishTidy() bool {
@@ -14836,7 +14907,7 @@ func (la *_StorageKeys__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_StorageKeys__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_StorageKeys__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -14869,7 +14940,7 @@ func (la *_StorageKeys__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_StorageKeys__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_StorageKeys__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Hash__ReprPrototype{}
 }
 
@@ -14900,12 +14971,12 @@ func (m MaybeString) IsAbsent() bool {
 func (m MaybeString) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeString) AsNode() ipld.Node {
+func (m MaybeString) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -14919,28 +14990,28 @@ func (m MaybeString) Must() String {
 	return &m.v
 }
 
-var _ ipld.Node = (String)(&_String{})
+var _ datamodel.Node = (String)(&_String{})
 var _ schema.TypedNode = (String)(&_String{})
 
-func (String) Kind() ipld.Kind {
-	return ipld.Kind_String
+func (String) Kind() datamodel.Kind {
+	return datamodel.Kind_String
 }
-func (String) LookupByString(string) (ipld.Node, error) {
+func (String) LookupByString(string) (datamodel.Node, error) {
 	return mixins.String{TypeName: "dageth.String"}.LookupByString("")
 }
-func (String) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (String) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.String{TypeName: "dageth.String"}.LookupByNode(nil)
 }
-func (String) LookupByIndex(idx int64) (ipld.Node, error) {
+func (String) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.String{TypeName: "dageth.String"}.LookupByIndex(0)
 }
-func (String) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (String) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.String{TypeName: "dageth.String"}.LookupBySegment(seg)
 }
-func (String) MapIterator() ipld.MapIterator {
+func (String) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (String) ListIterator() ipld.ListIterator {
+func (String) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (String) Length() int64 {
@@ -14967,16 +15038,16 @@ func (n String) AsString() (string, error) {
 func (String) AsBytes() ([]byte, error) {
 	return mixins.String{TypeName: "dageth.String"}.AsBytes()
 }
-func (String) AsLink() (ipld.Link, error) {
+func (String) AsLink() (datamodel.Link, error) {
 	return mixins.String{TypeName: "dageth.String"}.AsLink()
 }
-func (String) Prototype() ipld.NodePrototype {
+func (String) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
 type _String__Prototype struct{}
 
-func (_String__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_String__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _String__Builder
 	nb.Reset()
 	return &nb
@@ -14986,7 +15057,7 @@ type _String__Builder struct {
 	_String__Assembler
 }
 
-func (nb *_String__Builder) Build() ipld.Node {
+func (nb *_String__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -15004,10 +15075,10 @@ type _String__Assembler struct {
 }
 
 func (na *_String__Assembler) reset() {}
-func (_String__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_String__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.String"}.BeginMap(0)
 }
-func (_String__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_String__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.String"}.BeginList(0)
 }
 func (na *_String__Assembler) AssignNull() error {
@@ -15043,10 +15114,10 @@ func (na *_String__Assembler) AssignString(v string) error {
 func (_String__Assembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.String"}.AssignBytes(nil)
 }
-func (_String__Assembler) AssignLink(ipld.Link) error {
+func (_String__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.String"}.AssignLink(nil)
 }
-func (na *_String__Assembler) AssignNode(v ipld.Node) error {
+func (na *_String__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -15065,19 +15136,19 @@ func (na *_String__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignString(v2)
 	}
 }
-func (_String__Assembler) Prototype() ipld.NodePrototype {
+func (_String__Assembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (String) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n String) Representation() ipld.Node {
+func (n String) Representation() datamodel.Node {
 	return (*_String__Repr)(n)
 }
 
 type _String__Repr = _String
 
-var _ ipld.Node = &_String__Repr{}
+var _ datamodel.Node = &_String__Repr{}
 
 type _String__ReprPrototype = _String__Prototype
 type _String__ReprAssembler = _String__Assembler
@@ -15105,12 +15176,12 @@ func (m MaybeTime) IsAbsent() bool {
 func (m MaybeTime) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTime) AsNode() ipld.Node {
+func (m MaybeTime) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -15124,28 +15195,28 @@ func (m MaybeTime) Must() Time {
 	return &m.v
 }
 
-var _ ipld.Node = (Time)(&_Time{})
+var _ datamodel.Node = (Time)(&_Time{})
 var _ schema.TypedNode = (Time)(&_Time{})
 
-func (Time) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (Time) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (Time) LookupByString(string) (ipld.Node, error) {
+func (Time) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Time"}.LookupByString("")
 }
-func (Time) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Time) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Time"}.LookupByNode(nil)
 }
-func (Time) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Time) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Time"}.LookupByIndex(0)
 }
-func (Time) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Time) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Time"}.LookupBySegment(seg)
 }
-func (Time) MapIterator() ipld.MapIterator {
+func (Time) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Time) ListIterator() ipld.ListIterator {
+func (Time) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Time) Length() int64 {
@@ -15172,16 +15243,16 @@ func (Time) AsString() (string, error) {
 func (n Time) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (Time) AsLink() (ipld.Link, error) {
+func (Time) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.Time"}.AsLink()
 }
-func (Time) Prototype() ipld.NodePrototype {
+func (Time) Prototype() datamodel.NodePrototype {
 	return _Time__Prototype{}
 }
 
 type _Time__Prototype struct{}
 
-func (_Time__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Time__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Time__Builder
 	nb.Reset()
 	return &nb
@@ -15191,7 +15262,7 @@ type _Time__Builder struct {
 	_Time__Assembler
 }
 
-func (nb *_Time__Builder) Build() ipld.Node {
+func (nb *_Time__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -15209,10 +15280,10 @@ type _Time__Assembler struct {
 }
 
 func (na *_Time__Assembler) reset() {}
-func (_Time__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Time__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Time"}.BeginMap(0)
 }
-func (_Time__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Time__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Time"}.BeginList(0)
 }
 func (na *_Time__Assembler) AssignNull() error {
@@ -15248,10 +15319,10 @@ func (na *_Time__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_Time__Assembler) AssignLink(ipld.Link) error {
+func (_Time__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.Time"}.AssignLink(nil)
 }
-func (na *_Time__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Time__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -15270,19 +15341,19 @@ func (na *_Time__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_Time__Assembler) Prototype() ipld.NodePrototype {
+func (_Time__Assembler) Prototype() datamodel.NodePrototype {
 	return _Time__Prototype{}
 }
 func (Time) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Time) Representation() ipld.Node {
+func (n Time) Representation() datamodel.Node {
 	return (*_Time__Repr)(n)
 }
 
 type _Time__Repr = _Time
 
-var _ ipld.Node = &_Time__Repr{}
+var _ datamodel.Node = &_Time__Repr{}
 
 type _Time__ReprPrototype = _Time__Prototype
 type _Time__ReprAssembler = _Time__Assembler
@@ -15344,12 +15415,12 @@ func (m MaybeTopics) IsAbsent() bool {
 func (m MaybeTopics) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTopics) AsNode() ipld.Node {
+func (m MaybeTopics) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -15363,40 +15434,40 @@ func (m MaybeTopics) Must() Topics {
 	return &m.v
 }
 
-var _ ipld.Node = (Topics)(&_Topics{})
+var _ datamodel.Node = (Topics)(&_Topics{})
 var _ schema.TypedNode = (Topics)(&_Topics{})
 
-func (Topics) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (Topics) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (Topics) LookupByString(string) (ipld.Node, error) {
+func (Topics) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Topics"}.LookupByString("")
 }
-func (n Topics) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n Topics) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n Topics) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n Topics) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n Topics) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Topics) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Topics", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Topics", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (Topics) MapIterator() ipld.MapIterator {
+func (Topics) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n Topics) ListIterator() ipld.ListIterator {
+func (n Topics) ListIterator() datamodel.ListIterator {
 	return &_Topics__ListItr{n, 0}
 }
 
@@ -15405,9 +15476,9 @@ type _Topics__ListItr struct {
 	idx int
 }
 
-func (itr *_Topics__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_Topics__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -15443,16 +15514,16 @@ func (Topics) AsString() (string, error) {
 func (Topics) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Topics"}.AsBytes()
 }
-func (Topics) AsLink() (ipld.Link, error) {
+func (Topics) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Topics"}.AsLink()
 }
-func (Topics) Prototype() ipld.NodePrototype {
+func (Topics) Prototype() datamodel.NodePrototype {
 	return _Topics__Prototype{}
 }
 
 type _Topics__Prototype struct{}
 
-func (_Topics__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Topics__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Topics__Builder
 	nb.Reset()
 	return &nb
@@ -15462,7 +15533,7 @@ type _Topics__Builder struct {
 	_Topics__Assembler
 }
 
-func (nb *_Topics__Builder) Build() ipld.Node {
+func (nb *_Topics__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -15487,10 +15558,10 @@ func (na *_Topics__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Topics__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Topics__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Topics"}.BeginMap(0)
 }
-func (na *_Topics__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Topics__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -15535,10 +15606,10 @@ func (_Topics__Assembler) AssignString(string) error {
 func (_Topics__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Topics"}.AssignBytes(nil)
 }
-func (_Topics__Assembler) AssignLink(ipld.Link) error {
+func (_Topics__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Topics"}.AssignLink(nil)
 }
-func (na *_Topics__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Topics__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -15553,8 +15624,8 @@ func (na *_Topics__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Topics", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Topics", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -15568,7 +15639,7 @@ func (na *_Topics__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Topics__Assembler) Prototype() ipld.NodePrototype {
+func (_Topics__Assembler) Prototype() datamodel.NodePrototype {
 	return _Topics__Prototype{}
 }
 func (la *_Topics__Assembler) valueFinishTidy() bool {
@@ -15583,7 +15654,7 @@ func (la *_Topics__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Topics__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Topics__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -15616,59 +15687,59 @@ func (la *_Topics__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Topics__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Topics__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Hash__Prototype{}
 }
 func (Topics) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Topics) Representation() ipld.Node {
+func (n Topics) Representation() datamodel.Node {
 	return (*_Topics__Repr)(n)
 }
 
 type _Topics__Repr _Topics
 
-var _ ipld.Node = &_Topics__Repr{}
+var _ datamodel.Node = &_Topics__Repr{}
 
-func (_Topics__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_Topics__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_Topics__Repr) LookupByString(string) (ipld.Node, error) {
+func (_Topics__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Topics.Repr"}.LookupByString("")
 }
-func (nr *_Topics__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_Topics__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (Topics)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Hash).Representation(), nil
 }
-func (nr *_Topics__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_Topics__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (Topics)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Hash).Representation(), nil
 }
-func (n _Topics__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Topics__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Topics.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Topics.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_Topics__Repr) MapIterator() ipld.MapIterator {
+func (_Topics__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_Topics__Repr) ListIterator() ipld.ListIterator {
+func (nr *_Topics__Repr) ListIterator() datamodel.ListIterator {
 	return &_Topics__ReprListItr{(Topics)(nr), 0}
 }
 
 type _Topics__ReprListItr _Topics__ListItr
 
-func (itr *_Topics__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_Topics__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_Topics__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(Hash).Representation(), nil
@@ -15701,16 +15772,16 @@ func (_Topics__Repr) AsString() (string, error) {
 func (_Topics__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Topics.Repr"}.AsBytes()
 }
-func (_Topics__Repr) AsLink() (ipld.Link, error) {
+func (_Topics__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Topics.Repr"}.AsLink()
 }
-func (_Topics__Repr) Prototype() ipld.NodePrototype {
+func (_Topics__Repr) Prototype() datamodel.NodePrototype {
 	return _Topics__ReprPrototype{}
 }
 
 type _Topics__ReprPrototype struct{}
 
-func (_Topics__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Topics__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Topics__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -15720,7 +15791,7 @@ type _Topics__ReprBuilder struct {
 	_Topics__ReprAssembler
 }
 
-func (nb *_Topics__ReprBuilder) Build() ipld.Node {
+func (nb *_Topics__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -15745,10 +15816,10 @@ func (na *_Topics__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Topics__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Topics__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Topics.Repr"}.BeginMap(0)
 }
-func (na *_Topics__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Topics__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -15793,10 +15864,10 @@ func (_Topics__ReprAssembler) AssignString(string) error {
 func (_Topics__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Topics.Repr"}.AssignBytes(nil)
 }
-func (_Topics__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Topics__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Topics.Repr"}.AssignLink(nil)
 }
-func (na *_Topics__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Topics__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -15811,8 +15882,8 @@ func (na *_Topics__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Topics.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Topics.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -15826,7 +15897,7 @@ func (na *_Topics__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Topics__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Topics__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Topics__ReprPrototype{}
 }
 func (la *_Topics__ReprAssembler) valueFinishTidy() bool {
@@ -15841,7 +15912,7 @@ func (la *_Topics__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Topics__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Topics__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -15874,7 +15945,7 @@ func (la *_Topics__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Topics__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Topics__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Hash__ReprPrototype{}
 }
 
@@ -15936,12 +16007,12 @@ func (m MaybeTransaction) IsAbsent() bool {
 func (m MaybeTransaction) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTransaction) AsNode() ipld.Node {
+func (m MaybeTransaction) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -15971,43 +16042,43 @@ var (
 	fieldName__Transaction_R            = _String{"R"}
 	fieldName__Transaction_S            = _String{"S"}
 )
-var _ ipld.Node = (Transaction)(&_Transaction{})
+var _ datamodel.Node = (Transaction)(&_Transaction{})
 var _ schema.TypedNode = (Transaction)(&_Transaction{})
 
-func (Transaction) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Transaction) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Transaction) LookupByString(key string) (ipld.Node, error) {
+func (n Transaction) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "TxType":
 		return &n.TxType, nil
 	case "ChainID":
 		if n.ChainID.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.ChainID.v, nil
 	case "AccountNonce":
 		return &n.AccountNonce, nil
 	case "GasPrice":
 		if n.GasPrice.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.GasPrice.v, nil
 	case "GasTipCap":
 		if n.GasTipCap.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.GasTipCap.v, nil
 	case "GasFeeCap":
 		if n.GasFeeCap.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.GasFeeCap.v, nil
 	case "GasLimit":
 		return &n.GasLimit, nil
 	case "Recipient":
 		if n.Recipient.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.Recipient.v, nil
 	case "Amount":
@@ -16016,7 +16087,7 @@ func (n Transaction) LookupByString(key string) (ipld.Node, error) {
 		return &n.Data, nil
 	case "AccessList":
 		if n.AccessList.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return &n.AccessList.v, nil
 	case "V":
@@ -16026,23 +16097,23 @@ func (n Transaction) LookupByString(key string) (ipld.Node, error) {
 	case "S":
 		return &n.S, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Transaction) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Transaction) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Transaction) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Transaction) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Transaction"}.LookupByIndex(0)
 }
-func (n Transaction) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Transaction) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Transaction) MapIterator() ipld.MapIterator {
+func (n Transaction) MapIterator() datamodel.MapIterator {
 	return &_Transaction__MapItr{n, 0}
 }
 
@@ -16051,9 +16122,9 @@ type _Transaction__MapItr struct {
 	idx int
 }
 
-func (itr *_Transaction__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Transaction__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 14 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -16062,7 +16133,7 @@ func (itr *_Transaction__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	case 1:
 		k = &fieldName__Transaction_ChainID
 		if itr.n.ChainID.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.ChainID.v
@@ -16072,21 +16143,21 @@ func (itr *_Transaction__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	case 3:
 		k = &fieldName__Transaction_GasPrice
 		if itr.n.GasPrice.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.GasPrice.v
 	case 4:
 		k = &fieldName__Transaction_GasTipCap
 		if itr.n.GasTipCap.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.GasTipCap.v
 	case 5:
 		k = &fieldName__Transaction_GasFeeCap
 		if itr.n.GasFeeCap.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.GasFeeCap.v
@@ -16096,7 +16167,7 @@ func (itr *_Transaction__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	case 7:
 		k = &fieldName__Transaction_Recipient
 		if itr.n.Recipient.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.Recipient.v
@@ -16109,7 +16180,7 @@ func (itr *_Transaction__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 	case 10:
 		k = &fieldName__Transaction_AccessList
 		if itr.n.AccessList.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = &itr.n.AccessList.v
@@ -16132,7 +16203,7 @@ func (itr *_Transaction__MapItr) Done() bool {
 	return itr.idx >= 14
 }
 
-func (Transaction) ListIterator() ipld.ListIterator {
+func (Transaction) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Transaction) Length() int64 {
@@ -16159,16 +16230,16 @@ func (Transaction) AsString() (string, error) {
 func (Transaction) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Transaction"}.AsBytes()
 }
-func (Transaction) AsLink() (ipld.Link, error) {
+func (Transaction) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Transaction"}.AsLink()
 }
-func (Transaction) Prototype() ipld.NodePrototype {
+func (Transaction) Prototype() datamodel.NodePrototype {
 	return _Transaction__Prototype{}
 }
 
 type _Transaction__Prototype struct{}
 
-func (_Transaction__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Transaction__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Transaction__Builder
 	nb.Reset()
 	return &nb
@@ -16178,7 +16249,7 @@ type _Transaction__Builder struct {
 	_Transaction__Assembler
 }
 
-func (nb *_Transaction__Builder) Build() ipld.Node {
+func (nb *_Transaction__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -16251,7 +16322,7 @@ var (
 	fieldBits__Transaction_sufficient  = 0 + 1<<0 + 1<<1 + 1<<2 + 1<<3 + 1<<4 + 1<<5 + 1<<6 + 1<<7 + 1<<8 + 1<<9 + 1<<10 + 1<<11 + 1<<12 + 1<<13
 )
 
-func (na *_Transaction__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Transaction__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -16264,7 +16335,7 @@ func (na *_Transaction__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Transaction__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Transaction__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Transaction"}.BeginList(0)
 }
 func (na *_Transaction__Assembler) AssignNull() error {
@@ -16296,10 +16367,10 @@ func (_Transaction__Assembler) AssignString(string) error {
 func (_Transaction__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Transaction"}.AssignBytes(nil)
 }
-func (_Transaction__Assembler) AssignLink(ipld.Link) error {
+func (_Transaction__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Transaction"}.AssignLink(nil)
 }
-func (na *_Transaction__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Transaction__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -16319,8 +16390,8 @@ func (na *_Transaction__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Transaction", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Transaction", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -16337,7 +16408,7 @@ func (na *_Transaction__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Transaction__Assembler) Prototype() ipld.NodePrototype {
+func (_Transaction__Assembler) Prototype() datamodel.NodePrototype {
 	return _Transaction__Prototype{}
 }
 func (ma *_Transaction__Assembler) valueFinishTidy() bool {
@@ -16492,7 +16563,7 @@ func (ma *_Transaction__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Transaction__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -16510,7 +16581,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 	switch k {
 	case "TxType":
 		if ma.s&fieldBit__Transaction_TxType != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_TxType}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_TxType}
 		}
 		ma.s += fieldBit__Transaction_TxType
 		ma.state = maState_midValue
@@ -16520,7 +16591,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_TxType, nil
 	case "ChainID":
 		if ma.s&fieldBit__Transaction_ChainID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_ChainID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_ChainID}
 		}
 		ma.s += fieldBit__Transaction_ChainID
 		ma.state = maState_midValue
@@ -16531,7 +16602,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_ChainID, nil
 	case "AccountNonce":
 		if ma.s&fieldBit__Transaction_AccountNonce != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccountNonce}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccountNonce}
 		}
 		ma.s += fieldBit__Transaction_AccountNonce
 		ma.state = maState_midValue
@@ -16541,7 +16612,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_AccountNonce, nil
 	case "GasPrice":
 		if ma.s&fieldBit__Transaction_GasPrice != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasPrice}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasPrice}
 		}
 		ma.s += fieldBit__Transaction_GasPrice
 		ma.state = maState_midValue
@@ -16552,7 +16623,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_GasPrice, nil
 	case "GasTipCap":
 		if ma.s&fieldBit__Transaction_GasTipCap != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasTipCap}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasTipCap}
 		}
 		ma.s += fieldBit__Transaction_GasTipCap
 		ma.state = maState_midValue
@@ -16563,7 +16634,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_GasTipCap, nil
 	case "GasFeeCap":
 		if ma.s&fieldBit__Transaction_GasFeeCap != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasFeeCap}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasFeeCap}
 		}
 		ma.s += fieldBit__Transaction_GasFeeCap
 		ma.state = maState_midValue
@@ -16574,7 +16645,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_GasFeeCap, nil
 	case "GasLimit":
 		if ma.s&fieldBit__Transaction_GasLimit != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasLimit}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasLimit}
 		}
 		ma.s += fieldBit__Transaction_GasLimit
 		ma.state = maState_midValue
@@ -16584,7 +16655,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_GasLimit, nil
 	case "Recipient":
 		if ma.s&fieldBit__Transaction_Recipient != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Recipient}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Recipient}
 		}
 		ma.s += fieldBit__Transaction_Recipient
 		ma.state = maState_midValue
@@ -16595,7 +16666,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Recipient, nil
 	case "Amount":
 		if ma.s&fieldBit__Transaction_Amount != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Amount}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Amount}
 		}
 		ma.s += fieldBit__Transaction_Amount
 		ma.state = maState_midValue
@@ -16605,7 +16676,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Amount, nil
 	case "Data":
 		if ma.s&fieldBit__Transaction_Data != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Data}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Data}
 		}
 		ma.s += fieldBit__Transaction_Data
 		ma.state = maState_midValue
@@ -16615,7 +16686,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Data, nil
 	case "AccessList":
 		if ma.s&fieldBit__Transaction_AccessList != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccessList}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccessList}
 		}
 		ma.s += fieldBit__Transaction_AccessList
 		ma.state = maState_midValue
@@ -16626,7 +16697,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_AccessList, nil
 	case "V":
 		if ma.s&fieldBit__Transaction_V != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_V}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_V}
 		}
 		ma.s += fieldBit__Transaction_V
 		ma.state = maState_midValue
@@ -16636,7 +16707,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_V, nil
 	case "R":
 		if ma.s&fieldBit__Transaction_R != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_R}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_R}
 		}
 		ma.s += fieldBit__Transaction_R
 		ma.state = maState_midValue
@@ -16646,7 +16717,7 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_R, nil
 	case "S":
 		if ma.s&fieldBit__Transaction_S != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_S}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_S}
 		}
 		ma.s += fieldBit__Transaction_S
 		ma.state = maState_midValue
@@ -16655,9 +16726,9 @@ func (ma *_Transaction__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		ma.ca_S.m = &ma.cm
 		return &ma.ca_S, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Transaction", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Transaction", Key: &_String{k}}
 }
-func (ma *_Transaction__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Transaction__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -16675,7 +16746,7 @@ func (ma *_Transaction__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Transaction__KeyAssembler)(ma)
 }
-func (ma *_Transaction__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Transaction__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -16772,7 +16843,7 @@ func (ma *_Transaction__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Transaction_sufficient != fieldBits__Transaction_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Transaction_TxType == 0 {
 			err.Missing = append(err.Missing, "TxType")
 		}
@@ -16803,19 +16874,19 @@ func (ma *_Transaction__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Transaction__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Transaction__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Transaction__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Transaction__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _Transaction__KeyAssembler _Transaction__Assembler
 
-func (_Transaction__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Transaction__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Transaction.KeyAssembler"}.BeginMap(0)
 }
-func (_Transaction__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Transaction__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Transaction.KeyAssembler"}.BeginList(0)
 }
 func (na *_Transaction__KeyAssembler) AssignNull() error {
@@ -16837,127 +16908,140 @@ func (ka *_Transaction__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "TxType":
 		if ka.s&fieldBit__Transaction_TxType != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_TxType}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_TxType}
 		}
 		ka.s += fieldBit__Transaction_TxType
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "ChainID":
 		if ka.s&fieldBit__Transaction_ChainID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_ChainID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_ChainID}
 		}
 		ka.s += fieldBit__Transaction_ChainID
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	case "AccountNonce":
 		if ka.s&fieldBit__Transaction_AccountNonce != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccountNonce}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccountNonce}
 		}
 		ka.s += fieldBit__Transaction_AccountNonce
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	case "GasPrice":
 		if ka.s&fieldBit__Transaction_GasPrice != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasPrice}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasPrice}
 		}
 		ka.s += fieldBit__Transaction_GasPrice
 		ka.state = maState_expectValue
 		ka.f = 3
+		return nil
 	case "GasTipCap":
 		if ka.s&fieldBit__Transaction_GasTipCap != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasTipCap}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasTipCap}
 		}
 		ka.s += fieldBit__Transaction_GasTipCap
 		ka.state = maState_expectValue
 		ka.f = 4
+		return nil
 	case "GasFeeCap":
 		if ka.s&fieldBit__Transaction_GasFeeCap != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasFeeCap}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasFeeCap}
 		}
 		ka.s += fieldBit__Transaction_GasFeeCap
 		ka.state = maState_expectValue
 		ka.f = 5
+		return nil
 	case "GasLimit":
 		if ka.s&fieldBit__Transaction_GasLimit != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasLimit}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasLimit}
 		}
 		ka.s += fieldBit__Transaction_GasLimit
 		ka.state = maState_expectValue
 		ka.f = 6
+		return nil
 	case "Recipient":
 		if ka.s&fieldBit__Transaction_Recipient != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Recipient}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Recipient}
 		}
 		ka.s += fieldBit__Transaction_Recipient
 		ka.state = maState_expectValue
 		ka.f = 7
+		return nil
 	case "Amount":
 		if ka.s&fieldBit__Transaction_Amount != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Amount}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Amount}
 		}
 		ka.s += fieldBit__Transaction_Amount
 		ka.state = maState_expectValue
 		ka.f = 8
+		return nil
 	case "Data":
 		if ka.s&fieldBit__Transaction_Data != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Data}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Data}
 		}
 		ka.s += fieldBit__Transaction_Data
 		ka.state = maState_expectValue
 		ka.f = 9
+		return nil
 	case "AccessList":
 		if ka.s&fieldBit__Transaction_AccessList != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccessList}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccessList}
 		}
 		ka.s += fieldBit__Transaction_AccessList
 		ka.state = maState_expectValue
 		ka.f = 10
+		return nil
 	case "V":
 		if ka.s&fieldBit__Transaction_V != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_V}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_V}
 		}
 		ka.s += fieldBit__Transaction_V
 		ka.state = maState_expectValue
 		ka.f = 11
+		return nil
 	case "R":
 		if ka.s&fieldBit__Transaction_R != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_R}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_R}
 		}
 		ka.s += fieldBit__Transaction_R
 		ka.state = maState_expectValue
 		ka.f = 12
+		return nil
 	case "S":
 		if ka.s&fieldBit__Transaction_S != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_S}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_S}
 		}
 		ka.s += fieldBit__Transaction_S
 		ka.state = maState_expectValue
 		ka.f = 13
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.Transaction", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.Transaction", Key: &_String{k}}
 	}
-	return nil
 }
 func (_Transaction__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Transaction.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Transaction__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Transaction__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Transaction.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Transaction__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Transaction__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Transaction__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Transaction__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Transaction) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Transaction) Representation() ipld.Node {
+func (n Transaction) Representation() datamodel.Node {
 	return (*_Transaction__Repr)(n)
 }
 
@@ -16979,42 +17063,42 @@ var (
 	fieldName__Transaction_R_serial            = _String{"R"}
 	fieldName__Transaction_S_serial            = _String{"S"}
 )
-var _ ipld.Node = &_Transaction__Repr{}
+var _ datamodel.Node = &_Transaction__Repr{}
 
-func (_Transaction__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_Transaction__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_Transaction__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_Transaction__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "TxType":
 		return n.TxType.Representation(), nil
 	case "ChainID":
 		if n.ChainID.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChainID.v.Representation(), nil
 	case "AccountNonce":
 		return n.AccountNonce.Representation(), nil
 	case "GasPrice":
 		if n.GasPrice.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.GasPrice.v.Representation(), nil
 	case "GasTipCap":
 		if n.GasTipCap.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.GasTipCap.v.Representation(), nil
 	case "GasFeeCap":
 		if n.GasFeeCap.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.GasFeeCap.v.Representation(), nil
 	case "GasLimit":
 		return n.GasLimit.Representation(), nil
 	case "Recipient":
 		if n.Recipient.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Recipient.v.Representation(), nil
 	case "Amount":
@@ -17023,7 +17107,7 @@ func (n *_Transaction__Repr) LookupByString(key string) (ipld.Node, error) {
 		return n.Data.Representation(), nil
 	case "AccessList":
 		if n.AccessList.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.AccessList.v.Representation(), nil
 	case "V":
@@ -17033,23 +17117,23 @@ func (n *_Transaction__Repr) LookupByString(key string) (ipld.Node, error) {
 	case "S":
 		return n.S.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_Transaction__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_Transaction__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_Transaction__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_Transaction__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Transaction.Repr"}.LookupByIndex(0)
 }
-func (n _Transaction__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Transaction__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_Transaction__Repr) MapIterator() ipld.MapIterator {
+func (n *_Transaction__Repr) MapIterator() datamodel.MapIterator {
 	return &_Transaction__ReprMapItr{n, 0}
 }
 
@@ -17058,9 +17142,9 @@ type _Transaction__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_Transaction__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Transaction__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 14 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -17069,7 +17153,7 @@ func (itr *_Transaction__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error)
 	case 1:
 		k = &fieldName__Transaction_ChainID_serial
 		if itr.n.ChainID.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChainID.v.Representation()
@@ -17079,21 +17163,21 @@ func (itr *_Transaction__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error)
 	case 3:
 		k = &fieldName__Transaction_GasPrice_serial
 		if itr.n.GasPrice.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.GasPrice.v.Representation()
 	case 4:
 		k = &fieldName__Transaction_GasTipCap_serial
 		if itr.n.GasTipCap.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.GasTipCap.v.Representation()
 	case 5:
 		k = &fieldName__Transaction_GasFeeCap_serial
 		if itr.n.GasFeeCap.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.GasFeeCap.v.Representation()
@@ -17103,7 +17187,7 @@ func (itr *_Transaction__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error)
 	case 7:
 		k = &fieldName__Transaction_Recipient_serial
 		if itr.n.Recipient.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Recipient.v.Representation()
@@ -17116,7 +17200,7 @@ func (itr *_Transaction__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error)
 	case 10:
 		k = &fieldName__Transaction_AccessList_serial
 		if itr.n.AccessList.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.AccessList.v.Representation()
@@ -17138,7 +17222,7 @@ func (itr *_Transaction__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error)
 func (itr *_Transaction__ReprMapItr) Done() bool {
 	return itr.idx >= 14
 }
-func (_Transaction__Repr) ListIterator() ipld.ListIterator {
+func (_Transaction__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_Transaction__Repr) Length() int64 {
@@ -17166,16 +17250,16 @@ func (_Transaction__Repr) AsString() (string, error) {
 func (_Transaction__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Transaction.Repr"}.AsBytes()
 }
-func (_Transaction__Repr) AsLink() (ipld.Link, error) {
+func (_Transaction__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Transaction.Repr"}.AsLink()
 }
-func (_Transaction__Repr) Prototype() ipld.NodePrototype {
+func (_Transaction__Repr) Prototype() datamodel.NodePrototype {
 	return _Transaction__ReprPrototype{}
 }
 
 type _Transaction__ReprPrototype struct{}
 
-func (_Transaction__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Transaction__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Transaction__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -17185,7 +17269,7 @@ type _Transaction__ReprBuilder struct {
 	_Transaction__ReprAssembler
 }
 
-func (nb *_Transaction__ReprBuilder) Build() ipld.Node {
+func (nb *_Transaction__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -17239,7 +17323,7 @@ func (na *_Transaction__ReprAssembler) reset() {
 	na.ca_R.reset()
 	na.ca_S.reset()
 }
-func (na *_Transaction__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Transaction__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -17252,7 +17336,7 @@ func (na *_Transaction__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error
 	}
 	return na, nil
 }
-func (_Transaction__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Transaction__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Transaction.Repr"}.BeginList(0)
 }
 func (na *_Transaction__ReprAssembler) AssignNull() error {
@@ -17284,10 +17368,10 @@ func (_Transaction__ReprAssembler) AssignString(string) error {
 func (_Transaction__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Transaction.Repr"}.AssignBytes(nil)
 }
-func (_Transaction__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Transaction__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Transaction.Repr"}.AssignLink(nil)
 }
-func (na *_Transaction__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Transaction__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -17307,8 +17391,8 @@ func (na *_Transaction__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Transaction.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Transaction.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -17325,7 +17409,7 @@ func (na *_Transaction__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Transaction__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Transaction__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Transaction__ReprPrototype{}
 }
 func (ma *_Transaction__ReprAssembler) valueFinishTidy() bool {
@@ -17472,7 +17556,7 @@ func (ma *_Transaction__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -17490,7 +17574,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 	switch k {
 	case "TxType":
 		if ma.s&fieldBit__Transaction_TxType != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_TxType_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_TxType_serial}
 		}
 		ma.s += fieldBit__Transaction_TxType
 		ma.state = maState_midValue
@@ -17500,7 +17584,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_TxType, nil
 	case "ChainID":
 		if ma.s&fieldBit__Transaction_ChainID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_ChainID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_ChainID_serial}
 		}
 		ma.s += fieldBit__Transaction_ChainID
 		ma.state = maState_midValue
@@ -17511,7 +17595,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_ChainID, nil
 	case "AccountNonce":
 		if ma.s&fieldBit__Transaction_AccountNonce != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccountNonce_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccountNonce_serial}
 		}
 		ma.s += fieldBit__Transaction_AccountNonce
 		ma.state = maState_midValue
@@ -17521,7 +17605,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_AccountNonce, nil
 	case "GasPrice":
 		if ma.s&fieldBit__Transaction_GasPrice != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasPrice_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasPrice_serial}
 		}
 		ma.s += fieldBit__Transaction_GasPrice
 		ma.state = maState_midValue
@@ -17532,7 +17616,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_GasPrice, nil
 	case "GasTipCap":
 		if ma.s&fieldBit__Transaction_GasTipCap != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasTipCap_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasTipCap_serial}
 		}
 		ma.s += fieldBit__Transaction_GasTipCap
 		ma.state = maState_midValue
@@ -17543,7 +17627,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_GasTipCap, nil
 	case "GasFeeCap":
 		if ma.s&fieldBit__Transaction_GasFeeCap != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasFeeCap_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasFeeCap_serial}
 		}
 		ma.s += fieldBit__Transaction_GasFeeCap
 		ma.state = maState_midValue
@@ -17554,7 +17638,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_GasFeeCap, nil
 	case "GasLimit":
 		if ma.s&fieldBit__Transaction_GasLimit != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasLimit_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasLimit_serial}
 		}
 		ma.s += fieldBit__Transaction_GasLimit
 		ma.state = maState_midValue
@@ -17564,7 +17648,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_GasLimit, nil
 	case "Recipient":
 		if ma.s&fieldBit__Transaction_Recipient != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Recipient_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Recipient_serial}
 		}
 		ma.s += fieldBit__Transaction_Recipient
 		ma.state = maState_midValue
@@ -17575,7 +17659,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_Recipient, nil
 	case "Amount":
 		if ma.s&fieldBit__Transaction_Amount != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Amount_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Amount_serial}
 		}
 		ma.s += fieldBit__Transaction_Amount
 		ma.state = maState_midValue
@@ -17585,7 +17669,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_Amount, nil
 	case "Data":
 		if ma.s&fieldBit__Transaction_Data != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Data_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Data_serial}
 		}
 		ma.s += fieldBit__Transaction_Data
 		ma.state = maState_midValue
@@ -17595,7 +17679,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_Data, nil
 	case "AccessList":
 		if ma.s&fieldBit__Transaction_AccessList != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccessList_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccessList_serial}
 		}
 		ma.s += fieldBit__Transaction_AccessList
 		ma.state = maState_midValue
@@ -17606,7 +17690,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_AccessList, nil
 	case "V":
 		if ma.s&fieldBit__Transaction_V != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_V_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_V_serial}
 		}
 		ma.s += fieldBit__Transaction_V
 		ma.state = maState_midValue
@@ -17616,7 +17700,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_V, nil
 	case "R":
 		if ma.s&fieldBit__Transaction_R != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_R_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_R_serial}
 		}
 		ma.s += fieldBit__Transaction_R
 		ma.state = maState_midValue
@@ -17626,7 +17710,7 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_R, nil
 	case "S":
 		if ma.s&fieldBit__Transaction_S != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_S_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_S_serial}
 		}
 		ma.s += fieldBit__Transaction_S
 		ma.state = maState_midValue
@@ -17636,9 +17720,9 @@ func (ma *_Transaction__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembl
 		return &ma.ca_S, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Transaction.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Transaction.Repr", Key: &_String{k}}
 }
-func (ma *_Transaction__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Transaction__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -17656,7 +17740,7 @@ func (ma *_Transaction__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Transaction__ReprKeyAssembler)(ma)
 }
-func (ma *_Transaction__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Transaction__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -17753,7 +17837,7 @@ func (ma *_Transaction__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__Transaction_sufficient != fieldBits__Transaction_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__Transaction_TxType == 0 {
 			err.Missing = append(err.Missing, "TxType")
 		}
@@ -17784,19 +17868,19 @@ func (ma *_Transaction__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Transaction__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Transaction__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Transaction__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Transaction__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _Transaction__ReprKeyAssembler _Transaction__ReprAssembler
 
-func (_Transaction__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Transaction__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Transaction.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_Transaction__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Transaction__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Transaction.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_Transaction__ReprKeyAssembler) AssignNull() error {
@@ -17818,7 +17902,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "TxType":
 		if ka.s&fieldBit__Transaction_TxType != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_TxType_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_TxType_serial}
 		}
 		ka.s += fieldBit__Transaction_TxType
 		ka.state = maState_expectValue
@@ -17826,7 +17910,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "ChainID":
 		if ka.s&fieldBit__Transaction_ChainID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_ChainID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_ChainID_serial}
 		}
 		ka.s += fieldBit__Transaction_ChainID
 		ka.state = maState_expectValue
@@ -17834,7 +17918,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "AccountNonce":
 		if ka.s&fieldBit__Transaction_AccountNonce != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccountNonce_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccountNonce_serial}
 		}
 		ka.s += fieldBit__Transaction_AccountNonce
 		ka.state = maState_expectValue
@@ -17842,7 +17926,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "GasPrice":
 		if ka.s&fieldBit__Transaction_GasPrice != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasPrice_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasPrice_serial}
 		}
 		ka.s += fieldBit__Transaction_GasPrice
 		ka.state = maState_expectValue
@@ -17850,7 +17934,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "GasTipCap":
 		if ka.s&fieldBit__Transaction_GasTipCap != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasTipCap_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasTipCap_serial}
 		}
 		ka.s += fieldBit__Transaction_GasTipCap
 		ka.state = maState_expectValue
@@ -17858,7 +17942,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "GasFeeCap":
 		if ka.s&fieldBit__Transaction_GasFeeCap != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasFeeCap_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasFeeCap_serial}
 		}
 		ka.s += fieldBit__Transaction_GasFeeCap
 		ka.state = maState_expectValue
@@ -17866,7 +17950,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "GasLimit":
 		if ka.s&fieldBit__Transaction_GasLimit != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasLimit_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_GasLimit_serial}
 		}
 		ka.s += fieldBit__Transaction_GasLimit
 		ka.state = maState_expectValue
@@ -17874,7 +17958,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Recipient":
 		if ka.s&fieldBit__Transaction_Recipient != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Recipient_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Recipient_serial}
 		}
 		ka.s += fieldBit__Transaction_Recipient
 		ka.state = maState_expectValue
@@ -17882,7 +17966,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Amount":
 		if ka.s&fieldBit__Transaction_Amount != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Amount_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Amount_serial}
 		}
 		ka.s += fieldBit__Transaction_Amount
 		ka.state = maState_expectValue
@@ -17890,7 +17974,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Data":
 		if ka.s&fieldBit__Transaction_Data != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_Data_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_Data_serial}
 		}
 		ka.s += fieldBit__Transaction_Data
 		ka.state = maState_expectValue
@@ -17898,7 +17982,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "AccessList":
 		if ka.s&fieldBit__Transaction_AccessList != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccessList_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_AccessList_serial}
 		}
 		ka.s += fieldBit__Transaction_AccessList
 		ka.state = maState_expectValue
@@ -17906,7 +17990,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "V":
 		if ka.s&fieldBit__Transaction_V != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_V_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_V_serial}
 		}
 		ka.s += fieldBit__Transaction_V
 		ka.state = maState_expectValue
@@ -17914,7 +17998,7 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "R":
 		if ka.s&fieldBit__Transaction_R != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_R_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_R_serial}
 		}
 		ka.s += fieldBit__Transaction_R
 		ka.state = maState_expectValue
@@ -17922,29 +18006,29 @@ func (ka *_Transaction__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "S":
 		if ka.s&fieldBit__Transaction_S != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__Transaction_S_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__Transaction_S_serial}
 		}
 		ka.s += fieldBit__Transaction_S
 		ka.state = maState_expectValue
 		ka.f = 13
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Transaction.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.Transaction.Repr", Key: &_String{k}}
 }
 func (_Transaction__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Transaction.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Transaction__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_Transaction__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Transaction.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Transaction__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Transaction__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Transaction__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_Transaction__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -18005,12 +18089,12 @@ func (m MaybeTransactions) IsAbsent() bool {
 func (m MaybeTransactions) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTransactions) AsNode() ipld.Node {
+func (m MaybeTransactions) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -18024,40 +18108,40 @@ func (m MaybeTransactions) Must() Transactions {
 	return &m.v
 }
 
-var _ ipld.Node = (Transactions)(&_Transactions{})
+var _ datamodel.Node = (Transactions)(&_Transactions{})
 var _ schema.TypedNode = (Transactions)(&_Transactions{})
 
-func (Transactions) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (Transactions) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (Transactions) LookupByString(string) (ipld.Node, error) {
+func (Transactions) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Transactions"}.LookupByString("")
 }
-func (n Transactions) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n Transactions) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n Transactions) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n Transactions) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n Transactions) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Transactions) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Transactions", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Transactions", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (Transactions) MapIterator() ipld.MapIterator {
+func (Transactions) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n Transactions) ListIterator() ipld.ListIterator {
+func (n Transactions) ListIterator() datamodel.ListIterator {
 	return &_Transactions__ListItr{n, 0}
 }
 
@@ -18066,9 +18150,9 @@ type _Transactions__ListItr struct {
 	idx int
 }
 
-func (itr *_Transactions__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_Transactions__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -18104,16 +18188,16 @@ func (Transactions) AsString() (string, error) {
 func (Transactions) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Transactions"}.AsBytes()
 }
-func (Transactions) AsLink() (ipld.Link, error) {
+func (Transactions) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Transactions"}.AsLink()
 }
-func (Transactions) Prototype() ipld.NodePrototype {
+func (Transactions) Prototype() datamodel.NodePrototype {
 	return _Transactions__Prototype{}
 }
 
 type _Transactions__Prototype struct{}
 
-func (_Transactions__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Transactions__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Transactions__Builder
 	nb.Reset()
 	return &nb
@@ -18123,7 +18207,7 @@ type _Transactions__Builder struct {
 	_Transactions__Assembler
 }
 
-func (nb *_Transactions__Builder) Build() ipld.Node {
+func (nb *_Transactions__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -18148,10 +18232,10 @@ func (na *_Transactions__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Transactions__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Transactions__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Transactions"}.BeginMap(0)
 }
-func (na *_Transactions__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Transactions__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -18196,10 +18280,10 @@ func (_Transactions__Assembler) AssignString(string) error {
 func (_Transactions__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Transactions"}.AssignBytes(nil)
 }
-func (_Transactions__Assembler) AssignLink(ipld.Link) error {
+func (_Transactions__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Transactions"}.AssignLink(nil)
 }
-func (na *_Transactions__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Transactions__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -18214,8 +18298,8 @@ func (na *_Transactions__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Transactions", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Transactions", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -18229,7 +18313,7 @@ func (na *_Transactions__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Transactions__Assembler) Prototype() ipld.NodePrototype {
+func (_Transactions__Assembler) Prototype() datamodel.NodePrototype {
 	return _Transactions__Prototype{}
 }
 func (la *_Transactions__Assembler) valueFinishTidy() bool {
@@ -18244,7 +18328,7 @@ func (la *_Transactions__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Transactions__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Transactions__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -18277,59 +18361,59 @@ func (la *_Transactions__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Transactions__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Transactions__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Transaction__Prototype{}
 }
 func (Transactions) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Transactions) Representation() ipld.Node {
+func (n Transactions) Representation() datamodel.Node {
 	return (*_Transactions__Repr)(n)
 }
 
 type _Transactions__Repr _Transactions
 
-var _ ipld.Node = &_Transactions__Repr{}
+var _ datamodel.Node = &_Transactions__Repr{}
 
-func (_Transactions__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_Transactions__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_Transactions__Repr) LookupByString(string) (ipld.Node, error) {
+func (_Transactions__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Transactions.Repr"}.LookupByString("")
 }
-func (nr *_Transactions__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_Transactions__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (Transactions)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Transaction).Representation(), nil
 }
-func (nr *_Transactions__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_Transactions__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (Transactions)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Transaction).Representation(), nil
 }
-func (n _Transactions__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Transactions__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Transactions.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Transactions.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_Transactions__Repr) MapIterator() ipld.MapIterator {
+func (_Transactions__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_Transactions__Repr) ListIterator() ipld.ListIterator {
+func (nr *_Transactions__Repr) ListIterator() datamodel.ListIterator {
 	return &_Transactions__ReprListItr{(Transactions)(nr), 0}
 }
 
 type _Transactions__ReprListItr _Transactions__ListItr
 
-func (itr *_Transactions__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_Transactions__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_Transactions__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(Transaction).Representation(), nil
@@ -18362,16 +18446,16 @@ func (_Transactions__Repr) AsString() (string, error) {
 func (_Transactions__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Transactions.Repr"}.AsBytes()
 }
-func (_Transactions__Repr) AsLink() (ipld.Link, error) {
+func (_Transactions__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Transactions.Repr"}.AsLink()
 }
-func (_Transactions__Repr) Prototype() ipld.NodePrototype {
+func (_Transactions__Repr) Prototype() datamodel.NodePrototype {
 	return _Transactions__ReprPrototype{}
 }
 
 type _Transactions__ReprPrototype struct{}
 
-func (_Transactions__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Transactions__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Transactions__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -18381,7 +18465,7 @@ type _Transactions__ReprBuilder struct {
 	_Transactions__ReprAssembler
 }
 
-func (nb *_Transactions__ReprBuilder) Build() ipld.Node {
+func (nb *_Transactions__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -18406,10 +18490,10 @@ func (na *_Transactions__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Transactions__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Transactions__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Transactions.Repr"}.BeginMap(0)
 }
-func (na *_Transactions__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Transactions__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -18454,10 +18538,10 @@ func (_Transactions__ReprAssembler) AssignString(string) error {
 func (_Transactions__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Transactions.Repr"}.AssignBytes(nil)
 }
-func (_Transactions__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Transactions__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Transactions.Repr"}.AssignLink(nil)
 }
-func (na *_Transactions__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Transactions__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -18472,8 +18556,8 @@ func (na *_Transactions__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Transactions.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Transactions.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -18487,7 +18571,7 @@ func (na *_Transactions__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Transactions__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Transactions__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Transactions__ReprPrototype{}
 }
 func (la *_Transactions__ReprAssembler) valueFinishTidy() bool {
@@ -18502,7 +18586,7 @@ func (la *_Transactions__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Transactions__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Transactions__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -18535,7 +18619,7 @@ func (la *_Transactions__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Transactions__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Transactions__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Transaction__ReprPrototype{}
 }
 
@@ -18606,12 +18690,12 @@ func (m MaybeTrieBranchNode) IsAbsent() bool {
 func (m MaybeTrieBranchNode) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTrieBranchNode) AsNode() ipld.Node {
+func (m MaybeTrieBranchNode) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -18644,117 +18728,117 @@ var (
 	fieldName__TrieBranchNode_ChildF = _String{"ChildF"}
 	fieldName__TrieBranchNode_Value  = _String{"Value"}
 )
-var _ ipld.Node = (TrieBranchNode)(&_TrieBranchNode{})
+var _ datamodel.Node = (TrieBranchNode)(&_TrieBranchNode{})
 var _ schema.TypedNode = (TrieBranchNode)(&_TrieBranchNode{})
 
-func (TrieBranchNode) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (TrieBranchNode) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n TrieBranchNode) LookupByString(key string) (ipld.Node, error) {
+func (n TrieBranchNode) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Child0":
 		if n.Child0.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child0.v, nil
 	case "Child1":
 		if n.Child1.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child1.v, nil
 	case "Child2":
 		if n.Child2.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child2.v, nil
 	case "Child3":
 		if n.Child3.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child3.v, nil
 	case "Child4":
 		if n.Child4.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child4.v, nil
 	case "Child5":
 		if n.Child5.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child5.v, nil
 	case "Child6":
 		if n.Child6.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child6.v, nil
 	case "Child7":
 		if n.Child7.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child7.v, nil
 	case "Child8":
 		if n.Child8.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child8.v, nil
 	case "Child9":
 		if n.Child9.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child9.v, nil
 	case "ChildA":
 		if n.ChildA.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildA.v, nil
 	case "ChildB":
 		if n.ChildB.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildB.v, nil
 	case "ChildC":
 		if n.ChildC.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildC.v, nil
 	case "ChildD":
 		if n.ChildD.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildD.v, nil
 	case "ChildE":
 		if n.ChildE.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildE.v, nil
 	case "ChildF":
 		if n.ChildF.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildF.v, nil
 	case "Value":
 		if n.Value.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Value.v, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n TrieBranchNode) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n TrieBranchNode) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (TrieBranchNode) LookupByIndex(idx int64) (ipld.Node, error) {
+func (TrieBranchNode) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TrieBranchNode"}.LookupByIndex(0)
 }
-func (n TrieBranchNode) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n TrieBranchNode) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n TrieBranchNode) MapIterator() ipld.MapIterator {
+func (n TrieBranchNode) MapIterator() datamodel.MapIterator {
 	return &_TrieBranchNode__MapItr{n, 0}
 }
 
@@ -18763,127 +18847,127 @@ type _TrieBranchNode__MapItr struct {
 	idx int
 }
 
-func (itr *_TrieBranchNode__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TrieBranchNode__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 17 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
 		k = &fieldName__TrieBranchNode_Child0
 		if itr.n.Child0.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child0.v
 	case 1:
 		k = &fieldName__TrieBranchNode_Child1
 		if itr.n.Child1.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child1.v
 	case 2:
 		k = &fieldName__TrieBranchNode_Child2
 		if itr.n.Child2.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child2.v
 	case 3:
 		k = &fieldName__TrieBranchNode_Child3
 		if itr.n.Child3.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child3.v
 	case 4:
 		k = &fieldName__TrieBranchNode_Child4
 		if itr.n.Child4.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child4.v
 	case 5:
 		k = &fieldName__TrieBranchNode_Child5
 		if itr.n.Child5.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child5.v
 	case 6:
 		k = &fieldName__TrieBranchNode_Child6
 		if itr.n.Child6.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child6.v
 	case 7:
 		k = &fieldName__TrieBranchNode_Child7
 		if itr.n.Child7.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child7.v
 	case 8:
 		k = &fieldName__TrieBranchNode_Child8
 		if itr.n.Child8.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child8.v
 	case 9:
 		k = &fieldName__TrieBranchNode_Child9
 		if itr.n.Child9.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child9.v
 	case 10:
 		k = &fieldName__TrieBranchNode_ChildA
 		if itr.n.ChildA.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildA.v
 	case 11:
 		k = &fieldName__TrieBranchNode_ChildB
 		if itr.n.ChildB.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildB.v
 	case 12:
 		k = &fieldName__TrieBranchNode_ChildC
 		if itr.n.ChildC.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildC.v
 	case 13:
 		k = &fieldName__TrieBranchNode_ChildD
 		if itr.n.ChildD.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildD.v
 	case 14:
 		k = &fieldName__TrieBranchNode_ChildE
 		if itr.n.ChildE.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildE.v
 	case 15:
 		k = &fieldName__TrieBranchNode_ChildF
 		if itr.n.ChildF.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildF.v
 	case 16:
 		k = &fieldName__TrieBranchNode_Value
 		if itr.n.Value.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Value.v
@@ -18897,7 +18981,7 @@ func (itr *_TrieBranchNode__MapItr) Done() bool {
 	return itr.idx >= 17
 }
 
-func (TrieBranchNode) ListIterator() ipld.ListIterator {
+func (TrieBranchNode) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (TrieBranchNode) Length() int64 {
@@ -18924,16 +19008,16 @@ func (TrieBranchNode) AsString() (string, error) {
 func (TrieBranchNode) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TrieBranchNode"}.AsBytes()
 }
-func (TrieBranchNode) AsLink() (ipld.Link, error) {
+func (TrieBranchNode) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TrieBranchNode"}.AsLink()
 }
-func (TrieBranchNode) Prototype() ipld.NodePrototype {
+func (TrieBranchNode) Prototype() datamodel.NodePrototype {
 	return _TrieBranchNode__Prototype{}
 }
 
 type _TrieBranchNode__Prototype struct{}
 
-func (_TrieBranchNode__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_TrieBranchNode__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TrieBranchNode__Builder
 	nb.Reset()
 	return &nb
@@ -18943,7 +19027,7 @@ type _TrieBranchNode__Builder struct {
 	_TrieBranchNode__Assembler
 }
 
-func (nb *_TrieBranchNode__Builder) Build() ipld.Node {
+func (nb *_TrieBranchNode__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -19025,7 +19109,7 @@ var (
 	fieldBits__TrieBranchNode_sufficient = 0 + 1<<0 + 1<<1 + 1<<2 + 1<<3 + 1<<4 + 1<<5 + 1<<6 + 1<<7 + 1<<8 + 1<<9 + 1<<10 + 1<<11 + 1<<12 + 1<<13 + 1<<14 + 1<<15 + 1<<16
 )
 
-func (na *_TrieBranchNode__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TrieBranchNode__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -19038,7 +19122,7 @@ func (na *_TrieBranchNode__Assembler) BeginMap(int64) (ipld.MapAssembler, error)
 	}
 	return na, nil
 }
-func (_TrieBranchNode__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieBranchNode__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TrieBranchNode"}.BeginList(0)
 }
 func (na *_TrieBranchNode__Assembler) AssignNull() error {
@@ -19070,10 +19154,10 @@ func (_TrieBranchNode__Assembler) AssignString(string) error {
 func (_TrieBranchNode__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieBranchNode"}.AssignBytes(nil)
 }
-func (_TrieBranchNode__Assembler) AssignLink(ipld.Link) error {
+func (_TrieBranchNode__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieBranchNode"}.AssignLink(nil)
 }
-func (na *_TrieBranchNode__Assembler) AssignNode(v ipld.Node) error {
+func (na *_TrieBranchNode__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -19093,8 +19177,8 @@ func (na *_TrieBranchNode__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TrieBranchNode", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TrieBranchNode", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -19111,7 +19195,7 @@ func (na *_TrieBranchNode__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TrieBranchNode__Assembler) Prototype() ipld.NodePrototype {
+func (_TrieBranchNode__Assembler) Prototype() datamodel.NodePrototype {
 	return _TrieBranchNode__Prototype{}
 }
 func (ma *_TrieBranchNode__Assembler) valueFinishTidy() bool {
@@ -19324,7 +19408,7 @@ func (ma *_TrieBranchNode__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -19342,7 +19426,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 	switch k {
 	case "Child0":
 		if ma.s&fieldBit__TrieBranchNode_Child0 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child0}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child0}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child0
 		ma.state = maState_midValue
@@ -19353,7 +19437,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child0, nil
 	case "Child1":
 		if ma.s&fieldBit__TrieBranchNode_Child1 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child1}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child1}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child1
 		ma.state = maState_midValue
@@ -19364,7 +19448,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child1, nil
 	case "Child2":
 		if ma.s&fieldBit__TrieBranchNode_Child2 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child2}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child2}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child2
 		ma.state = maState_midValue
@@ -19375,7 +19459,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child2, nil
 	case "Child3":
 		if ma.s&fieldBit__TrieBranchNode_Child3 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child3}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child3}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child3
 		ma.state = maState_midValue
@@ -19386,7 +19470,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child3, nil
 	case "Child4":
 		if ma.s&fieldBit__TrieBranchNode_Child4 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child4}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child4}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child4
 		ma.state = maState_midValue
@@ -19397,7 +19481,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child4, nil
 	case "Child5":
 		if ma.s&fieldBit__TrieBranchNode_Child5 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child5}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child5}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child5
 		ma.state = maState_midValue
@@ -19408,7 +19492,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child5, nil
 	case "Child6":
 		if ma.s&fieldBit__TrieBranchNode_Child6 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child6}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child6}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child6
 		ma.state = maState_midValue
@@ -19419,7 +19503,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child6, nil
 	case "Child7":
 		if ma.s&fieldBit__TrieBranchNode_Child7 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child7}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child7}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child7
 		ma.state = maState_midValue
@@ -19430,7 +19514,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child7, nil
 	case "Child8":
 		if ma.s&fieldBit__TrieBranchNode_Child8 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child8}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child8}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child8
 		ma.state = maState_midValue
@@ -19441,7 +19525,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child8, nil
 	case "Child9":
 		if ma.s&fieldBit__TrieBranchNode_Child9 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child9}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child9}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child9
 		ma.state = maState_midValue
@@ -19452,7 +19536,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_Child9, nil
 	case "ChildA":
 		if ma.s&fieldBit__TrieBranchNode_ChildA != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildA}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildA}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildA
 		ma.state = maState_midValue
@@ -19463,7 +19547,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_ChildA, nil
 	case "ChildB":
 		if ma.s&fieldBit__TrieBranchNode_ChildB != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildB}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildB}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildB
 		ma.state = maState_midValue
@@ -19474,7 +19558,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_ChildB, nil
 	case "ChildC":
 		if ma.s&fieldBit__TrieBranchNode_ChildC != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildC}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildC}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildC
 		ma.state = maState_midValue
@@ -19485,7 +19569,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_ChildC, nil
 	case "ChildD":
 		if ma.s&fieldBit__TrieBranchNode_ChildD != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildD}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildD}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildD
 		ma.state = maState_midValue
@@ -19496,7 +19580,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_ChildD, nil
 	case "ChildE":
 		if ma.s&fieldBit__TrieBranchNode_ChildE != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildE}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildE}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildE
 		ma.state = maState_midValue
@@ -19507,7 +19591,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_ChildE, nil
 	case "ChildF":
 		if ma.s&fieldBit__TrieBranchNode_ChildF != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildF}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildF}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildF
 		ma.state = maState_midValue
@@ -19518,7 +19602,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		return &ma.ca_ChildF, nil
 	case "Value":
 		if ma.s&fieldBit__TrieBranchNode_Value != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Value}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Value}
 		}
 		ma.s += fieldBit__TrieBranchNode_Value
 		ma.state = maState_midValue
@@ -19528,9 +19612,9 @@ func (ma *_TrieBranchNode__Assembler) AssembleEntry(k string) (ipld.NodeAssemble
 		ma.w.Value.m = allowNull
 		return &ma.ca_Value, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TrieBranchNode", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TrieBranchNode", Key: &_String{k}}
 }
-func (ma *_TrieBranchNode__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TrieBranchNode__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -19548,7 +19632,7 @@ func (ma *_TrieBranchNode__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TrieBranchNode__KeyAssembler)(ma)
 }
-func (ma *_TrieBranchNode__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TrieBranchNode__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -19668,26 +19752,26 @@ func (ma *_TrieBranchNode__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__TrieBranchNode_sufficient != fieldBits__TrieBranchNode_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		return err
 	}
 	ma.state = maState_finished
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TrieBranchNode__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TrieBranchNode__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TrieBranchNode__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TrieBranchNode__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _TrieBranchNode__KeyAssembler _TrieBranchNode__Assembler
 
-func (_TrieBranchNode__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TrieBranchNode__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieBranchNode.KeyAssembler"}.BeginMap(0)
 }
-func (_TrieBranchNode__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieBranchNode__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieBranchNode.KeyAssembler"}.BeginList(0)
 }
 func (na *_TrieBranchNode__KeyAssembler) AssignNull() error {
@@ -19709,148 +19793,164 @@ func (ka *_TrieBranchNode__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Child0":
 		if ka.s&fieldBit__TrieBranchNode_Child0 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child0}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child0}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child0
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "Child1":
 		if ka.s&fieldBit__TrieBranchNode_Child1 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child1}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child1}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child1
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	case "Child2":
 		if ka.s&fieldBit__TrieBranchNode_Child2 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child2}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child2}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child2
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	case "Child3":
 		if ka.s&fieldBit__TrieBranchNode_Child3 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child3}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child3}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child3
 		ka.state = maState_expectValue
 		ka.f = 3
+		return nil
 	case "Child4":
 		if ka.s&fieldBit__TrieBranchNode_Child4 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child4}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child4}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child4
 		ka.state = maState_expectValue
 		ka.f = 4
+		return nil
 	case "Child5":
 		if ka.s&fieldBit__TrieBranchNode_Child5 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child5}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child5}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child5
 		ka.state = maState_expectValue
 		ka.f = 5
+		return nil
 	case "Child6":
 		if ka.s&fieldBit__TrieBranchNode_Child6 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child6}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child6}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child6
 		ka.state = maState_expectValue
 		ka.f = 6
+		return nil
 	case "Child7":
 		if ka.s&fieldBit__TrieBranchNode_Child7 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child7}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child7}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child7
 		ka.state = maState_expectValue
 		ka.f = 7
+		return nil
 	case "Child8":
 		if ka.s&fieldBit__TrieBranchNode_Child8 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child8}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child8}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child8
 		ka.state = maState_expectValue
 		ka.f = 8
+		return nil
 	case "Child9":
 		if ka.s&fieldBit__TrieBranchNode_Child9 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child9}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child9}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child9
 		ka.state = maState_expectValue
 		ka.f = 9
+		return nil
 	case "ChildA":
 		if ka.s&fieldBit__TrieBranchNode_ChildA != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildA}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildA}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildA
 		ka.state = maState_expectValue
 		ka.f = 10
+		return nil
 	case "ChildB":
 		if ka.s&fieldBit__TrieBranchNode_ChildB != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildB}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildB}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildB
 		ka.state = maState_expectValue
 		ka.f = 11
+		return nil
 	case "ChildC":
 		if ka.s&fieldBit__TrieBranchNode_ChildC != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildC}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildC}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildC
 		ka.state = maState_expectValue
 		ka.f = 12
+		return nil
 	case "ChildD":
 		if ka.s&fieldBit__TrieBranchNode_ChildD != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildD}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildD}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildD
 		ka.state = maState_expectValue
 		ka.f = 13
+		return nil
 	case "ChildE":
 		if ka.s&fieldBit__TrieBranchNode_ChildE != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildE}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildE}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildE
 		ka.state = maState_expectValue
 		ka.f = 14
+		return nil
 	case "ChildF":
 		if ka.s&fieldBit__TrieBranchNode_ChildF != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildF}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildF}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildF
 		ka.state = maState_expectValue
 		ka.f = 15
+		return nil
 	case "Value":
 		if ka.s&fieldBit__TrieBranchNode_Value != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Value}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Value}
 		}
 		ka.s += fieldBit__TrieBranchNode_Value
 		ka.state = maState_expectValue
 		ka.f = 16
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.TrieBranchNode", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.TrieBranchNode", Key: &_String{k}}
 	}
-	return nil
 }
 func (_TrieBranchNode__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieBranchNode.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TrieBranchNode__KeyAssembler) AssignLink(ipld.Link) error {
+func (_TrieBranchNode__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieBranchNode.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TrieBranchNode__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TrieBranchNode__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TrieBranchNode__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_TrieBranchNode__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (TrieBranchNode) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n TrieBranchNode) Representation() ipld.Node {
+func (n TrieBranchNode) Representation() datamodel.Node {
 	return (*_TrieBranchNode__Repr)(n)
 }
 
@@ -19875,116 +19975,116 @@ var (
 	fieldName__TrieBranchNode_ChildF_serial = _String{"ChildF"}
 	fieldName__TrieBranchNode_Value_serial  = _String{"Value"}
 )
-var _ ipld.Node = &_TrieBranchNode__Repr{}
+var _ datamodel.Node = &_TrieBranchNode__Repr{}
 
-func (_TrieBranchNode__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_TrieBranchNode__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_TrieBranchNode__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_TrieBranchNode__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Child0":
 		if n.Child0.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child0.v.Representation(), nil
 	case "Child1":
 		if n.Child1.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child1.v.Representation(), nil
 	case "Child2":
 		if n.Child2.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child2.v.Representation(), nil
 	case "Child3":
 		if n.Child3.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child3.v.Representation(), nil
 	case "Child4":
 		if n.Child4.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child4.v.Representation(), nil
 	case "Child5":
 		if n.Child5.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child5.v.Representation(), nil
 	case "Child6":
 		if n.Child6.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child6.v.Representation(), nil
 	case "Child7":
 		if n.Child7.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child7.v.Representation(), nil
 	case "Child8":
 		if n.Child8.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child8.v.Representation(), nil
 	case "Child9":
 		if n.Child9.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Child9.v.Representation(), nil
 	case "ChildA":
 		if n.ChildA.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildA.v.Representation(), nil
 	case "ChildB":
 		if n.ChildB.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildB.v.Representation(), nil
 	case "ChildC":
 		if n.ChildC.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildC.v.Representation(), nil
 	case "ChildD":
 		if n.ChildD.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildD.v.Representation(), nil
 	case "ChildE":
 		if n.ChildE.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildE.v.Representation(), nil
 	case "ChildF":
 		if n.ChildF.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.ChildF.v.Representation(), nil
 	case "Value":
 		if n.Value.m == schema.Maybe_Null {
-			return ipld.Null, nil
+			return datamodel.Null, nil
 		}
 		return n.Value.v.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_TrieBranchNode__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_TrieBranchNode__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_TrieBranchNode__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_TrieBranchNode__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TrieBranchNode.Repr"}.LookupByIndex(0)
 }
-func (n _TrieBranchNode__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _TrieBranchNode__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_TrieBranchNode__Repr) MapIterator() ipld.MapIterator {
+func (n *_TrieBranchNode__Repr) MapIterator() datamodel.MapIterator {
 	return &_TrieBranchNode__ReprMapItr{n, 0}
 }
 
@@ -19993,127 +20093,127 @@ type _TrieBranchNode__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_TrieBranchNode__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TrieBranchNode__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 17 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
 		k = &fieldName__TrieBranchNode_Child0_serial
 		if itr.n.Child0.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child0.v.Representation()
 	case 1:
 		k = &fieldName__TrieBranchNode_Child1_serial
 		if itr.n.Child1.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child1.v.Representation()
 	case 2:
 		k = &fieldName__TrieBranchNode_Child2_serial
 		if itr.n.Child2.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child2.v.Representation()
 	case 3:
 		k = &fieldName__TrieBranchNode_Child3_serial
 		if itr.n.Child3.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child3.v.Representation()
 	case 4:
 		k = &fieldName__TrieBranchNode_Child4_serial
 		if itr.n.Child4.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child4.v.Representation()
 	case 5:
 		k = &fieldName__TrieBranchNode_Child5_serial
 		if itr.n.Child5.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child5.v.Representation()
 	case 6:
 		k = &fieldName__TrieBranchNode_Child6_serial
 		if itr.n.Child6.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child6.v.Representation()
 	case 7:
 		k = &fieldName__TrieBranchNode_Child7_serial
 		if itr.n.Child7.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child7.v.Representation()
 	case 8:
 		k = &fieldName__TrieBranchNode_Child8_serial
 		if itr.n.Child8.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child8.v.Representation()
 	case 9:
 		k = &fieldName__TrieBranchNode_Child9_serial
 		if itr.n.Child9.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Child9.v.Representation()
 	case 10:
 		k = &fieldName__TrieBranchNode_ChildA_serial
 		if itr.n.ChildA.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildA.v.Representation()
 	case 11:
 		k = &fieldName__TrieBranchNode_ChildB_serial
 		if itr.n.ChildB.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildB.v.Representation()
 	case 12:
 		k = &fieldName__TrieBranchNode_ChildC_serial
 		if itr.n.ChildC.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildC.v.Representation()
 	case 13:
 		k = &fieldName__TrieBranchNode_ChildD_serial
 		if itr.n.ChildD.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildD.v.Representation()
 	case 14:
 		k = &fieldName__TrieBranchNode_ChildE_serial
 		if itr.n.ChildE.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildE.v.Representation()
 	case 15:
 		k = &fieldName__TrieBranchNode_ChildF_serial
 		if itr.n.ChildF.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.ChildF.v.Representation()
 	case 16:
 		k = &fieldName__TrieBranchNode_Value_serial
 		if itr.n.Value.m == schema.Maybe_Null {
-			v = ipld.Null
+			v = datamodel.Null
 			break
 		}
 		v = itr.n.Value.v.Representation()
@@ -20126,7 +20226,7 @@ func (itr *_TrieBranchNode__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ erro
 func (itr *_TrieBranchNode__ReprMapItr) Done() bool {
 	return itr.idx >= 17
 }
-func (_TrieBranchNode__Repr) ListIterator() ipld.ListIterator {
+func (_TrieBranchNode__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_TrieBranchNode__Repr) Length() int64 {
@@ -20154,16 +20254,16 @@ func (_TrieBranchNode__Repr) AsString() (string, error) {
 func (_TrieBranchNode__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TrieBranchNode.Repr"}.AsBytes()
 }
-func (_TrieBranchNode__Repr) AsLink() (ipld.Link, error) {
+func (_TrieBranchNode__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TrieBranchNode.Repr"}.AsLink()
 }
-func (_TrieBranchNode__Repr) Prototype() ipld.NodePrototype {
+func (_TrieBranchNode__Repr) Prototype() datamodel.NodePrototype {
 	return _TrieBranchNode__ReprPrototype{}
 }
 
 type _TrieBranchNode__ReprPrototype struct{}
 
-func (_TrieBranchNode__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_TrieBranchNode__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TrieBranchNode__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -20173,7 +20273,7 @@ type _TrieBranchNode__ReprBuilder struct {
 	_TrieBranchNode__ReprAssembler
 }
 
-func (nb *_TrieBranchNode__ReprBuilder) Build() ipld.Node {
+func (nb *_TrieBranchNode__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -20233,7 +20333,7 @@ func (na *_TrieBranchNode__ReprAssembler) reset() {
 	na.ca_ChildF.reset()
 	na.ca_Value.reset()
 }
-func (na *_TrieBranchNode__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TrieBranchNode__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -20246,7 +20346,7 @@ func (na *_TrieBranchNode__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, er
 	}
 	return na, nil
 }
-func (_TrieBranchNode__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieBranchNode__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TrieBranchNode.Repr"}.BeginList(0)
 }
 func (na *_TrieBranchNode__ReprAssembler) AssignNull() error {
@@ -20278,10 +20378,10 @@ func (_TrieBranchNode__ReprAssembler) AssignString(string) error {
 func (_TrieBranchNode__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieBranchNode.Repr"}.AssignBytes(nil)
 }
-func (_TrieBranchNode__ReprAssembler) AssignLink(ipld.Link) error {
+func (_TrieBranchNode__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieBranchNode.Repr"}.AssignLink(nil)
 }
-func (na *_TrieBranchNode__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_TrieBranchNode__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -20301,8 +20401,8 @@ func (na *_TrieBranchNode__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TrieBranchNode.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TrieBranchNode.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -20319,7 +20419,7 @@ func (na *_TrieBranchNode__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TrieBranchNode__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_TrieBranchNode__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _TrieBranchNode__ReprPrototype{}
 }
 func (ma *_TrieBranchNode__ReprAssembler) valueFinishTidy() bool {
@@ -20532,7 +20632,7 @@ func (ma *_TrieBranchNode__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -20550,7 +20650,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 	switch k {
 	case "Child0":
 		if ma.s&fieldBit__TrieBranchNode_Child0 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child0_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child0_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child0
 		ma.state = maState_midValue
@@ -20561,7 +20661,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child0, nil
 	case "Child1":
 		if ma.s&fieldBit__TrieBranchNode_Child1 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child1_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child1_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child1
 		ma.state = maState_midValue
@@ -20572,7 +20672,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child1, nil
 	case "Child2":
 		if ma.s&fieldBit__TrieBranchNode_Child2 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child2_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child2_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child2
 		ma.state = maState_midValue
@@ -20583,7 +20683,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child2, nil
 	case "Child3":
 		if ma.s&fieldBit__TrieBranchNode_Child3 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child3_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child3_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child3
 		ma.state = maState_midValue
@@ -20594,7 +20694,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child3, nil
 	case "Child4":
 		if ma.s&fieldBit__TrieBranchNode_Child4 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child4_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child4_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child4
 		ma.state = maState_midValue
@@ -20605,7 +20705,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child4, nil
 	case "Child5":
 		if ma.s&fieldBit__TrieBranchNode_Child5 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child5_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child5_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child5
 		ma.state = maState_midValue
@@ -20616,7 +20716,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child5, nil
 	case "Child6":
 		if ma.s&fieldBit__TrieBranchNode_Child6 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child6_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child6_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child6
 		ma.state = maState_midValue
@@ -20627,7 +20727,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child6, nil
 	case "Child7":
 		if ma.s&fieldBit__TrieBranchNode_Child7 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child7_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child7_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child7
 		ma.state = maState_midValue
@@ -20638,7 +20738,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child7, nil
 	case "Child8":
 		if ma.s&fieldBit__TrieBranchNode_Child8 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child8_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child8_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child8
 		ma.state = maState_midValue
@@ -20649,7 +20749,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child8, nil
 	case "Child9":
 		if ma.s&fieldBit__TrieBranchNode_Child9 != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child9_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child9_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Child9
 		ma.state = maState_midValue
@@ -20660,7 +20760,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Child9, nil
 	case "ChildA":
 		if ma.s&fieldBit__TrieBranchNode_ChildA != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildA_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildA_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildA
 		ma.state = maState_midValue
@@ -20671,7 +20771,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_ChildA, nil
 	case "ChildB":
 		if ma.s&fieldBit__TrieBranchNode_ChildB != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildB_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildB_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildB
 		ma.state = maState_midValue
@@ -20682,7 +20782,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_ChildB, nil
 	case "ChildC":
 		if ma.s&fieldBit__TrieBranchNode_ChildC != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildC_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildC_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildC
 		ma.state = maState_midValue
@@ -20693,7 +20793,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_ChildC, nil
 	case "ChildD":
 		if ma.s&fieldBit__TrieBranchNode_ChildD != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildD_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildD_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildD
 		ma.state = maState_midValue
@@ -20704,7 +20804,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_ChildD, nil
 	case "ChildE":
 		if ma.s&fieldBit__TrieBranchNode_ChildE != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildE_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildE_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildE
 		ma.state = maState_midValue
@@ -20715,7 +20815,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_ChildE, nil
 	case "ChildF":
 		if ma.s&fieldBit__TrieBranchNode_ChildF != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildF_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildF_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_ChildF
 		ma.state = maState_midValue
@@ -20726,7 +20826,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_ChildF, nil
 	case "Value":
 		if ma.s&fieldBit__TrieBranchNode_Value != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Value_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Value_serial}
 		}
 		ma.s += fieldBit__TrieBranchNode_Value
 		ma.state = maState_midValue
@@ -20737,9 +20837,9 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAsse
 		return &ma.ca_Value, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TrieBranchNode.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TrieBranchNode.Repr", Key: &_String{k}}
 }
-func (ma *_TrieBranchNode__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TrieBranchNode__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -20757,7 +20857,7 @@ func (ma *_TrieBranchNode__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TrieBranchNode__ReprKeyAssembler)(ma)
 }
-func (ma *_TrieBranchNode__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TrieBranchNode__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -20877,26 +20977,26 @@ func (ma *_TrieBranchNode__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__TrieBranchNode_sufficient != fieldBits__TrieBranchNode_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		return err
 	}
 	ma.state = maState_finished
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TrieBranchNode__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TrieBranchNode__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TrieBranchNode__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TrieBranchNode__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _TrieBranchNode__ReprKeyAssembler _TrieBranchNode__ReprAssembler
 
-func (_TrieBranchNode__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TrieBranchNode__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieBranchNode.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_TrieBranchNode__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieBranchNode__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieBranchNode.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_TrieBranchNode__ReprKeyAssembler) AssignNull() error {
@@ -20918,7 +21018,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "Child0":
 		if ka.s&fieldBit__TrieBranchNode_Child0 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child0_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child0_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child0
 		ka.state = maState_expectValue
@@ -20926,7 +21026,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child1":
 		if ka.s&fieldBit__TrieBranchNode_Child1 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child1_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child1_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child1
 		ka.state = maState_expectValue
@@ -20934,7 +21034,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child2":
 		if ka.s&fieldBit__TrieBranchNode_Child2 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child2_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child2_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child2
 		ka.state = maState_expectValue
@@ -20942,7 +21042,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child3":
 		if ka.s&fieldBit__TrieBranchNode_Child3 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child3_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child3_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child3
 		ka.state = maState_expectValue
@@ -20950,7 +21050,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child4":
 		if ka.s&fieldBit__TrieBranchNode_Child4 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child4_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child4_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child4
 		ka.state = maState_expectValue
@@ -20958,7 +21058,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child5":
 		if ka.s&fieldBit__TrieBranchNode_Child5 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child5_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child5_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child5
 		ka.state = maState_expectValue
@@ -20966,7 +21066,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child6":
 		if ka.s&fieldBit__TrieBranchNode_Child6 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child6_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child6_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child6
 		ka.state = maState_expectValue
@@ -20974,7 +21074,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child7":
 		if ka.s&fieldBit__TrieBranchNode_Child7 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child7_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child7_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child7
 		ka.state = maState_expectValue
@@ -20982,7 +21082,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child8":
 		if ka.s&fieldBit__TrieBranchNode_Child8 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child8_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child8_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child8
 		ka.state = maState_expectValue
@@ -20990,7 +21090,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child9":
 		if ka.s&fieldBit__TrieBranchNode_Child9 != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child9_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Child9_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Child9
 		ka.state = maState_expectValue
@@ -20998,7 +21098,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "ChildA":
 		if ka.s&fieldBit__TrieBranchNode_ChildA != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildA_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildA_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildA
 		ka.state = maState_expectValue
@@ -21006,7 +21106,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "ChildB":
 		if ka.s&fieldBit__TrieBranchNode_ChildB != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildB_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildB_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildB
 		ka.state = maState_expectValue
@@ -21014,7 +21114,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "ChildC":
 		if ka.s&fieldBit__TrieBranchNode_ChildC != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildC_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildC_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildC
 		ka.state = maState_expectValue
@@ -21022,7 +21122,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "ChildD":
 		if ka.s&fieldBit__TrieBranchNode_ChildD != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildD_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildD_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildD
 		ka.state = maState_expectValue
@@ -21030,7 +21130,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "ChildE":
 		if ka.s&fieldBit__TrieBranchNode_ChildE != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildE_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildE_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildE
 		ka.state = maState_expectValue
@@ -21038,7 +21138,7 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "ChildF":
 		if ka.s&fieldBit__TrieBranchNode_ChildF != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildF_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_ChildF_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_ChildF
 		ka.state = maState_expectValue
@@ -21046,36 +21146,36 @@ func (ka *_TrieBranchNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Value":
 		if ka.s&fieldBit__TrieBranchNode_Value != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Value_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieBranchNode_Value_serial}
 		}
 		ka.s += fieldBit__TrieBranchNode_Value
 		ka.state = maState_expectValue
 		ka.f = 16
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.TrieBranchNode.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.TrieBranchNode.Repr", Key: &_String{k}}
 }
 func (_TrieBranchNode__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieBranchNode.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TrieBranchNode__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_TrieBranchNode__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieBranchNode.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TrieBranchNode__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TrieBranchNode__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TrieBranchNode__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_TrieBranchNode__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
 func (n _TrieExtensionNode) FieldPartialPath() Bytes {
 	return &n.PartialPath
 }
-func (n _TrieExtensionNode) FieldChild() Link {
+func (n _TrieExtensionNode) FieldChild() Child {
 	return &n.Child
 }
 
@@ -21094,12 +21194,12 @@ func (m MaybeTrieExtensionNode) IsAbsent() bool {
 func (m MaybeTrieExtensionNode) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTrieExtensionNode) AsNode() ipld.Node {
+func (m MaybeTrieExtensionNode) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -21117,36 +21217,36 @@ var (
 	fieldName__TrieExtensionNode_PartialPath = _String{"PartialPath"}
 	fieldName__TrieExtensionNode_Child       = _String{"Child"}
 )
-var _ ipld.Node = (TrieExtensionNode)(&_TrieExtensionNode{})
+var _ datamodel.Node = (TrieExtensionNode)(&_TrieExtensionNode{})
 var _ schema.TypedNode = (TrieExtensionNode)(&_TrieExtensionNode{})
 
-func (TrieExtensionNode) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (TrieExtensionNode) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n TrieExtensionNode) LookupByString(key string) (ipld.Node, error) {
+func (n TrieExtensionNode) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "PartialPath":
 		return &n.PartialPath, nil
 	case "Child":
 		return &n.Child, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n TrieExtensionNode) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n TrieExtensionNode) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (TrieExtensionNode) LookupByIndex(idx int64) (ipld.Node, error) {
+func (TrieExtensionNode) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TrieExtensionNode"}.LookupByIndex(0)
 }
-func (n TrieExtensionNode) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n TrieExtensionNode) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n TrieExtensionNode) MapIterator() ipld.MapIterator {
+func (n TrieExtensionNode) MapIterator() datamodel.MapIterator {
 	return &_TrieExtensionNode__MapItr{n, 0}
 }
 
@@ -21155,9 +21255,9 @@ type _TrieExtensionNode__MapItr struct {
 	idx int
 }
 
-func (itr *_TrieExtensionNode__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TrieExtensionNode__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 2 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -21176,7 +21276,7 @@ func (itr *_TrieExtensionNode__MapItr) Done() bool {
 	return itr.idx >= 2
 }
 
-func (TrieExtensionNode) ListIterator() ipld.ListIterator {
+func (TrieExtensionNode) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (TrieExtensionNode) Length() int64 {
@@ -21203,16 +21303,16 @@ func (TrieExtensionNode) AsString() (string, error) {
 func (TrieExtensionNode) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TrieExtensionNode"}.AsBytes()
 }
-func (TrieExtensionNode) AsLink() (ipld.Link, error) {
+func (TrieExtensionNode) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TrieExtensionNode"}.AsLink()
 }
-func (TrieExtensionNode) Prototype() ipld.NodePrototype {
+func (TrieExtensionNode) Prototype() datamodel.NodePrototype {
 	return _TrieExtensionNode__Prototype{}
 }
 
 type _TrieExtensionNode__Prototype struct{}
 
-func (_TrieExtensionNode__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_TrieExtensionNode__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TrieExtensionNode__Builder
 	nb.Reset()
 	return &nb
@@ -21222,7 +21322,7 @@ type _TrieExtensionNode__Builder struct {
 	_TrieExtensionNode__Assembler
 }
 
-func (nb *_TrieExtensionNode__Builder) Build() ipld.Node {
+func (nb *_TrieExtensionNode__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -21243,7 +21343,7 @@ type _TrieExtensionNode__Assembler struct {
 
 	cm             schema.Maybe
 	ca_PartialPath _Bytes__Assembler
-	ca_Child       _Link__Assembler
+	ca_Child       _Child__Assembler
 }
 
 func (na *_TrieExtensionNode__Assembler) reset() {
@@ -21259,7 +21359,7 @@ var (
 	fieldBits__TrieExtensionNode_sufficient = 0 + 1<<0 + 1<<1
 )
 
-func (na *_TrieExtensionNode__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TrieExtensionNode__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -21272,7 +21372,7 @@ func (na *_TrieExtensionNode__Assembler) BeginMap(int64) (ipld.MapAssembler, err
 	}
 	return na, nil
 }
-func (_TrieExtensionNode__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieExtensionNode__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TrieExtensionNode"}.BeginList(0)
 }
 func (na *_TrieExtensionNode__Assembler) AssignNull() error {
@@ -21304,10 +21404,10 @@ func (_TrieExtensionNode__Assembler) AssignString(string) error {
 func (_TrieExtensionNode__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieExtensionNode"}.AssignBytes(nil)
 }
-func (_TrieExtensionNode__Assembler) AssignLink(ipld.Link) error {
+func (_TrieExtensionNode__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieExtensionNode"}.AssignLink(nil)
 }
-func (na *_TrieExtensionNode__Assembler) AssignNode(v ipld.Node) error {
+func (na *_TrieExtensionNode__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -21327,8 +21427,8 @@ func (na *_TrieExtensionNode__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TrieExtensionNode", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TrieExtensionNode", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -21345,7 +21445,7 @@ func (na *_TrieExtensionNode__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TrieExtensionNode__Assembler) Prototype() ipld.NodePrototype {
+func (_TrieExtensionNode__Assembler) Prototype() datamodel.NodePrototype {
 	return _TrieExtensionNode__Prototype{}
 }
 func (ma *_TrieExtensionNode__Assembler) valueFinishTidy() bool {
@@ -21374,7 +21474,7 @@ func (ma *_TrieExtensionNode__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_TrieExtensionNode__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TrieExtensionNode__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -21392,7 +21492,7 @@ func (ma *_TrieExtensionNode__Assembler) AssembleEntry(k string) (ipld.NodeAssem
 	switch k {
 	case "PartialPath":
 		if ma.s&fieldBit__TrieExtensionNode_PartialPath != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_PartialPath}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_PartialPath}
 		}
 		ma.s += fieldBit__TrieExtensionNode_PartialPath
 		ma.state = maState_midValue
@@ -21402,7 +21502,7 @@ func (ma *_TrieExtensionNode__Assembler) AssembleEntry(k string) (ipld.NodeAssem
 		return &ma.ca_PartialPath, nil
 	case "Child":
 		if ma.s&fieldBit__TrieExtensionNode_Child != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_Child}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_Child}
 		}
 		ma.s += fieldBit__TrieExtensionNode_Child
 		ma.state = maState_midValue
@@ -21411,9 +21511,9 @@ func (ma *_TrieExtensionNode__Assembler) AssembleEntry(k string) (ipld.NodeAssem
 		ma.ca_Child.m = &ma.cm
 		return &ma.ca_Child, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TrieExtensionNode", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TrieExtensionNode", Key: &_String{k}}
 }
-func (ma *_TrieExtensionNode__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TrieExtensionNode__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -21431,7 +21531,7 @@ func (ma *_TrieExtensionNode__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TrieExtensionNode__KeyAssembler)(ma)
 }
-func (ma *_TrieExtensionNode__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TrieExtensionNode__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -21474,7 +21574,7 @@ func (ma *_TrieExtensionNode__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__TrieExtensionNode_sufficient != fieldBits__TrieExtensionNode_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__TrieExtensionNode_PartialPath == 0 {
 			err.Missing = append(err.Missing, "PartialPath")
 		}
@@ -21487,19 +21587,19 @@ func (ma *_TrieExtensionNode__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TrieExtensionNode__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TrieExtensionNode__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TrieExtensionNode__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TrieExtensionNode__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _TrieExtensionNode__KeyAssembler _TrieExtensionNode__Assembler
 
-func (_TrieExtensionNode__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TrieExtensionNode__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieExtensionNode.KeyAssembler"}.BeginMap(0)
 }
-func (_TrieExtensionNode__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieExtensionNode__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieExtensionNode.KeyAssembler"}.BeginList(0)
 }
 func (na *_TrieExtensionNode__KeyAssembler) AssignNull() error {
@@ -21521,43 +21621,44 @@ func (ka *_TrieExtensionNode__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "PartialPath":
 		if ka.s&fieldBit__TrieExtensionNode_PartialPath != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_PartialPath}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_PartialPath}
 		}
 		ka.s += fieldBit__TrieExtensionNode_PartialPath
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "Child":
 		if ka.s&fieldBit__TrieExtensionNode_Child != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_Child}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_Child}
 		}
 		ka.s += fieldBit__TrieExtensionNode_Child
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.TrieExtensionNode", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.TrieExtensionNode", Key: &_String{k}}
 	}
-	return nil
 }
 func (_TrieExtensionNode__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieExtensionNode.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TrieExtensionNode__KeyAssembler) AssignLink(ipld.Link) error {
+func (_TrieExtensionNode__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieExtensionNode.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TrieExtensionNode__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TrieExtensionNode__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TrieExtensionNode__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_TrieExtensionNode__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (TrieExtensionNode) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n TrieExtensionNode) Representation() ipld.Node {
+func (n TrieExtensionNode) Representation() datamodel.Node {
 	return (*_TrieExtensionNode__Repr)(n)
 }
 
@@ -21567,35 +21668,35 @@ var (
 	fieldName__TrieExtensionNode_PartialPath_serial = _String{"PartialPath"}
 	fieldName__TrieExtensionNode_Child_serial       = _String{"Child"}
 )
-var _ ipld.Node = &_TrieExtensionNode__Repr{}
+var _ datamodel.Node = &_TrieExtensionNode__Repr{}
 
-func (_TrieExtensionNode__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_TrieExtensionNode__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_TrieExtensionNode__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_TrieExtensionNode__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "PartialPath":
 		return n.PartialPath.Representation(), nil
 	case "Child":
 		return n.Child.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_TrieExtensionNode__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_TrieExtensionNode__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_TrieExtensionNode__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_TrieExtensionNode__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TrieExtensionNode.Repr"}.LookupByIndex(0)
 }
-func (n _TrieExtensionNode__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _TrieExtensionNode__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_TrieExtensionNode__Repr) MapIterator() ipld.MapIterator {
+func (n *_TrieExtensionNode__Repr) MapIterator() datamodel.MapIterator {
 	return &_TrieExtensionNode__ReprMapItr{n, 0}
 }
 
@@ -21604,9 +21705,9 @@ type _TrieExtensionNode__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_TrieExtensionNode__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TrieExtensionNode__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 2 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -21624,7 +21725,7 @@ func (itr *_TrieExtensionNode__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ e
 func (itr *_TrieExtensionNode__ReprMapItr) Done() bool {
 	return itr.idx >= 2
 }
-func (_TrieExtensionNode__Repr) ListIterator() ipld.ListIterator {
+func (_TrieExtensionNode__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_TrieExtensionNode__Repr) Length() int64 {
@@ -21652,16 +21753,16 @@ func (_TrieExtensionNode__Repr) AsString() (string, error) {
 func (_TrieExtensionNode__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TrieExtensionNode.Repr"}.AsBytes()
 }
-func (_TrieExtensionNode__Repr) AsLink() (ipld.Link, error) {
+func (_TrieExtensionNode__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TrieExtensionNode.Repr"}.AsLink()
 }
-func (_TrieExtensionNode__Repr) Prototype() ipld.NodePrototype {
+func (_TrieExtensionNode__Repr) Prototype() datamodel.NodePrototype {
 	return _TrieExtensionNode__ReprPrototype{}
 }
 
 type _TrieExtensionNode__ReprPrototype struct{}
 
-func (_TrieExtensionNode__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_TrieExtensionNode__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TrieExtensionNode__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -21671,7 +21772,7 @@ type _TrieExtensionNode__ReprBuilder struct {
 	_TrieExtensionNode__ReprAssembler
 }
 
-func (nb *_TrieExtensionNode__ReprBuilder) Build() ipld.Node {
+func (nb *_TrieExtensionNode__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -21692,7 +21793,7 @@ type _TrieExtensionNode__ReprAssembler struct {
 
 	cm             schema.Maybe
 	ca_PartialPath _Bytes__ReprAssembler
-	ca_Child       _Link__ReprAssembler
+	ca_Child       _Child__ReprAssembler
 }
 
 func (na *_TrieExtensionNode__ReprAssembler) reset() {
@@ -21701,7 +21802,7 @@ func (na *_TrieExtensionNode__ReprAssembler) reset() {
 	na.ca_PartialPath.reset()
 	na.ca_Child.reset()
 }
-func (na *_TrieExtensionNode__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TrieExtensionNode__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -21714,7 +21815,7 @@ func (na *_TrieExtensionNode__ReprAssembler) BeginMap(int64) (ipld.MapAssembler,
 	}
 	return na, nil
 }
-func (_TrieExtensionNode__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieExtensionNode__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TrieExtensionNode.Repr"}.BeginList(0)
 }
 func (na *_TrieExtensionNode__ReprAssembler) AssignNull() error {
@@ -21746,10 +21847,10 @@ func (_TrieExtensionNode__ReprAssembler) AssignString(string) error {
 func (_TrieExtensionNode__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieExtensionNode.Repr"}.AssignBytes(nil)
 }
-func (_TrieExtensionNode__ReprAssembler) AssignLink(ipld.Link) error {
+func (_TrieExtensionNode__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieExtensionNode.Repr"}.AssignLink(nil)
 }
-func (na *_TrieExtensionNode__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_TrieExtensionNode__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -21769,8 +21870,8 @@ func (na *_TrieExtensionNode__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TrieExtensionNode.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TrieExtensionNode.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -21787,7 +21888,7 @@ func (na *_TrieExtensionNode__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TrieExtensionNode__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_TrieExtensionNode__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _TrieExtensionNode__ReprPrototype{}
 }
 func (ma *_TrieExtensionNode__ReprAssembler) valueFinishTidy() bool {
@@ -21814,7 +21915,7 @@ func (ma *_TrieExtensionNode__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_TrieExtensionNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TrieExtensionNode__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -21832,7 +21933,7 @@ func (ma *_TrieExtensionNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeA
 	switch k {
 	case "PartialPath":
 		if ma.s&fieldBit__TrieExtensionNode_PartialPath != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_PartialPath_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_PartialPath_serial}
 		}
 		ma.s += fieldBit__TrieExtensionNode_PartialPath
 		ma.state = maState_midValue
@@ -21842,7 +21943,7 @@ func (ma *_TrieExtensionNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeA
 		return &ma.ca_PartialPath, nil
 	case "Child":
 		if ma.s&fieldBit__TrieExtensionNode_Child != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_Child_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_Child_serial}
 		}
 		ma.s += fieldBit__TrieExtensionNode_Child
 		ma.state = maState_midValue
@@ -21852,9 +21953,9 @@ func (ma *_TrieExtensionNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeA
 		return &ma.ca_Child, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TrieExtensionNode.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TrieExtensionNode.Repr", Key: &_String{k}}
 }
-func (ma *_TrieExtensionNode__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TrieExtensionNode__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -21872,7 +21973,7 @@ func (ma *_TrieExtensionNode__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TrieExtensionNode__ReprKeyAssembler)(ma)
 }
-func (ma *_TrieExtensionNode__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TrieExtensionNode__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -21915,7 +22016,7 @@ func (ma *_TrieExtensionNode__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__TrieExtensionNode_sufficient != fieldBits__TrieExtensionNode_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__TrieExtensionNode_PartialPath == 0 {
 			err.Missing = append(err.Missing, "PartialPath")
 		}
@@ -21928,19 +22029,19 @@ func (ma *_TrieExtensionNode__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TrieExtensionNode__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TrieExtensionNode__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TrieExtensionNode__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TrieExtensionNode__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _TrieExtensionNode__ReprKeyAssembler _TrieExtensionNode__ReprAssembler
 
-func (_TrieExtensionNode__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TrieExtensionNode__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieExtensionNode.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_TrieExtensionNode__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieExtensionNode__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieExtensionNode.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_TrieExtensionNode__ReprKeyAssembler) AssignNull() error {
@@ -21962,7 +22063,7 @@ func (ka *_TrieExtensionNode__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "PartialPath":
 		if ka.s&fieldBit__TrieExtensionNode_PartialPath != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_PartialPath_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_PartialPath_serial}
 		}
 		ka.s += fieldBit__TrieExtensionNode_PartialPath
 		ka.state = maState_expectValue
@@ -21970,29 +22071,29 @@ func (ka *_TrieExtensionNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Child":
 		if ka.s&fieldBit__TrieExtensionNode_Child != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_Child_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieExtensionNode_Child_serial}
 		}
 		ka.s += fieldBit__TrieExtensionNode_Child
 		ka.state = maState_expectValue
 		ka.f = 1
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.TrieExtensionNode.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.TrieExtensionNode.Repr", Key: &_String{k}}
 }
 func (_TrieExtensionNode__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieExtensionNode.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TrieExtensionNode__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_TrieExtensionNode__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieExtensionNode.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TrieExtensionNode__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TrieExtensionNode__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TrieExtensionNode__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_TrieExtensionNode__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -22018,12 +22119,12 @@ func (m MaybeTrieLeafNode) IsAbsent() bool {
 func (m MaybeTrieLeafNode) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTrieLeafNode) AsNode() ipld.Node {
+func (m MaybeTrieLeafNode) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -22041,36 +22142,36 @@ var (
 	fieldName__TrieLeafNode_PartialPath = _String{"PartialPath"}
 	fieldName__TrieLeafNode_Value       = _String{"Value"}
 )
-var _ ipld.Node = (TrieLeafNode)(&_TrieLeafNode{})
+var _ datamodel.Node = (TrieLeafNode)(&_TrieLeafNode{})
 var _ schema.TypedNode = (TrieLeafNode)(&_TrieLeafNode{})
 
-func (TrieLeafNode) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (TrieLeafNode) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n TrieLeafNode) LookupByString(key string) (ipld.Node, error) {
+func (n TrieLeafNode) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "PartialPath":
 		return &n.PartialPath, nil
 	case "Value":
 		return &n.Value, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n TrieLeafNode) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n TrieLeafNode) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (TrieLeafNode) LookupByIndex(idx int64) (ipld.Node, error) {
+func (TrieLeafNode) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TrieLeafNode"}.LookupByIndex(0)
 }
-func (n TrieLeafNode) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n TrieLeafNode) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n TrieLeafNode) MapIterator() ipld.MapIterator {
+func (n TrieLeafNode) MapIterator() datamodel.MapIterator {
 	return &_TrieLeafNode__MapItr{n, 0}
 }
 
@@ -22079,9 +22180,9 @@ type _TrieLeafNode__MapItr struct {
 	idx int
 }
 
-func (itr *_TrieLeafNode__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TrieLeafNode__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 2 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -22100,7 +22201,7 @@ func (itr *_TrieLeafNode__MapItr) Done() bool {
 	return itr.idx >= 2
 }
 
-func (TrieLeafNode) ListIterator() ipld.ListIterator {
+func (TrieLeafNode) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (TrieLeafNode) Length() int64 {
@@ -22127,16 +22228,16 @@ func (TrieLeafNode) AsString() (string, error) {
 func (TrieLeafNode) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TrieLeafNode"}.AsBytes()
 }
-func (TrieLeafNode) AsLink() (ipld.Link, error) {
+func (TrieLeafNode) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TrieLeafNode"}.AsLink()
 }
-func (TrieLeafNode) Prototype() ipld.NodePrototype {
+func (TrieLeafNode) Prototype() datamodel.NodePrototype {
 	return _TrieLeafNode__Prototype{}
 }
 
 type _TrieLeafNode__Prototype struct{}
 
-func (_TrieLeafNode__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_TrieLeafNode__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TrieLeafNode__Builder
 	nb.Reset()
 	return &nb
@@ -22146,7 +22247,7 @@ type _TrieLeafNode__Builder struct {
 	_TrieLeafNode__Assembler
 }
 
-func (nb *_TrieLeafNode__Builder) Build() ipld.Node {
+func (nb *_TrieLeafNode__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -22183,7 +22284,7 @@ var (
 	fieldBits__TrieLeafNode_sufficient = 0 + 1<<0 + 1<<1
 )
 
-func (na *_TrieLeafNode__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TrieLeafNode__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -22196,7 +22297,7 @@ func (na *_TrieLeafNode__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_TrieLeafNode__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieLeafNode__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TrieLeafNode"}.BeginList(0)
 }
 func (na *_TrieLeafNode__Assembler) AssignNull() error {
@@ -22228,10 +22329,10 @@ func (_TrieLeafNode__Assembler) AssignString(string) error {
 func (_TrieLeafNode__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieLeafNode"}.AssignBytes(nil)
 }
-func (_TrieLeafNode__Assembler) AssignLink(ipld.Link) error {
+func (_TrieLeafNode__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieLeafNode"}.AssignLink(nil)
 }
-func (na *_TrieLeafNode__Assembler) AssignNode(v ipld.Node) error {
+func (na *_TrieLeafNode__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -22251,8 +22352,8 @@ func (na *_TrieLeafNode__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TrieLeafNode", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TrieLeafNode", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -22269,7 +22370,7 @@ func (na *_TrieLeafNode__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TrieLeafNode__Assembler) Prototype() ipld.NodePrototype {
+func (_TrieLeafNode__Assembler) Prototype() datamodel.NodePrototype {
 	return _TrieLeafNode__Prototype{}
 }
 func (ma *_TrieLeafNode__Assembler) valueFinishTidy() bool {
@@ -22298,7 +22399,7 @@ func (ma *_TrieLeafNode__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_TrieLeafNode__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TrieLeafNode__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -22316,7 +22417,7 @@ func (ma *_TrieLeafNode__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 	switch k {
 	case "PartialPath":
 		if ma.s&fieldBit__TrieLeafNode_PartialPath != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_PartialPath}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_PartialPath}
 		}
 		ma.s += fieldBit__TrieLeafNode_PartialPath
 		ma.state = maState_midValue
@@ -22326,7 +22427,7 @@ func (ma *_TrieLeafNode__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_PartialPath, nil
 	case "Value":
 		if ma.s&fieldBit__TrieLeafNode_Value != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_Value}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_Value}
 		}
 		ma.s += fieldBit__TrieLeafNode_Value
 		ma.state = maState_midValue
@@ -22335,9 +22436,9 @@ func (ma *_TrieLeafNode__Assembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		ma.ca_Value.m = &ma.cm
 		return &ma.ca_Value, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TrieLeafNode", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TrieLeafNode", Key: &_String{k}}
 }
-func (ma *_TrieLeafNode__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TrieLeafNode__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -22355,7 +22456,7 @@ func (ma *_TrieLeafNode__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TrieLeafNode__KeyAssembler)(ma)
 }
-func (ma *_TrieLeafNode__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TrieLeafNode__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -22398,7 +22499,7 @@ func (ma *_TrieLeafNode__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__TrieLeafNode_sufficient != fieldBits__TrieLeafNode_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__TrieLeafNode_PartialPath == 0 {
 			err.Missing = append(err.Missing, "PartialPath")
 		}
@@ -22411,19 +22512,19 @@ func (ma *_TrieLeafNode__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TrieLeafNode__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TrieLeafNode__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TrieLeafNode__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TrieLeafNode__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _TrieLeafNode__KeyAssembler _TrieLeafNode__Assembler
 
-func (_TrieLeafNode__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TrieLeafNode__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieLeafNode.KeyAssembler"}.BeginMap(0)
 }
-func (_TrieLeafNode__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieLeafNode__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieLeafNode.KeyAssembler"}.BeginList(0)
 }
 func (na *_TrieLeafNode__KeyAssembler) AssignNull() error {
@@ -22445,43 +22546,44 @@ func (ka *_TrieLeafNode__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "PartialPath":
 		if ka.s&fieldBit__TrieLeafNode_PartialPath != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_PartialPath}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_PartialPath}
 		}
 		ka.s += fieldBit__TrieLeafNode_PartialPath
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "Value":
 		if ka.s&fieldBit__TrieLeafNode_Value != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_Value}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_Value}
 		}
 		ka.s += fieldBit__TrieLeafNode_Value
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.TrieLeafNode", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.TrieLeafNode", Key: &_String{k}}
 	}
-	return nil
 }
 func (_TrieLeafNode__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieLeafNode.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TrieLeafNode__KeyAssembler) AssignLink(ipld.Link) error {
+func (_TrieLeafNode__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieLeafNode.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TrieLeafNode__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TrieLeafNode__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TrieLeafNode__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_TrieLeafNode__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (TrieLeafNode) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n TrieLeafNode) Representation() ipld.Node {
+func (n TrieLeafNode) Representation() datamodel.Node {
 	return (*_TrieLeafNode__Repr)(n)
 }
 
@@ -22491,35 +22593,35 @@ var (
 	fieldName__TrieLeafNode_PartialPath_serial = _String{"PartialPath"}
 	fieldName__TrieLeafNode_Value_serial       = _String{"Value"}
 )
-var _ ipld.Node = &_TrieLeafNode__Repr{}
+var _ datamodel.Node = &_TrieLeafNode__Repr{}
 
-func (_TrieLeafNode__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_TrieLeafNode__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_TrieLeafNode__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_TrieLeafNode__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "PartialPath":
 		return n.PartialPath.Representation(), nil
 	case "Value":
 		return n.Value.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_TrieLeafNode__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_TrieLeafNode__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_TrieLeafNode__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_TrieLeafNode__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TrieLeafNode.Repr"}.LookupByIndex(0)
 }
-func (n _TrieLeafNode__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _TrieLeafNode__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_TrieLeafNode__Repr) MapIterator() ipld.MapIterator {
+func (n *_TrieLeafNode__Repr) MapIterator() datamodel.MapIterator {
 	return &_TrieLeafNode__ReprMapItr{n, 0}
 }
 
@@ -22528,9 +22630,9 @@ type _TrieLeafNode__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_TrieLeafNode__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TrieLeafNode__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 2 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -22548,7 +22650,7 @@ func (itr *_TrieLeafNode__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error)
 func (itr *_TrieLeafNode__ReprMapItr) Done() bool {
 	return itr.idx >= 2
 }
-func (_TrieLeafNode__Repr) ListIterator() ipld.ListIterator {
+func (_TrieLeafNode__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_TrieLeafNode__Repr) Length() int64 {
@@ -22576,16 +22678,16 @@ func (_TrieLeafNode__Repr) AsString() (string, error) {
 func (_TrieLeafNode__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TrieLeafNode.Repr"}.AsBytes()
 }
-func (_TrieLeafNode__Repr) AsLink() (ipld.Link, error) {
+func (_TrieLeafNode__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TrieLeafNode.Repr"}.AsLink()
 }
-func (_TrieLeafNode__Repr) Prototype() ipld.NodePrototype {
+func (_TrieLeafNode__Repr) Prototype() datamodel.NodePrototype {
 	return _TrieLeafNode__ReprPrototype{}
 }
 
 type _TrieLeafNode__ReprPrototype struct{}
 
-func (_TrieLeafNode__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_TrieLeafNode__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TrieLeafNode__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -22595,7 +22697,7 @@ type _TrieLeafNode__ReprBuilder struct {
 	_TrieLeafNode__ReprAssembler
 }
 
-func (nb *_TrieLeafNode__ReprBuilder) Build() ipld.Node {
+func (nb *_TrieLeafNode__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -22625,7 +22727,7 @@ func (na *_TrieLeafNode__ReprAssembler) reset() {
 	na.ca_PartialPath.reset()
 	na.ca_Value.reset()
 }
-func (na *_TrieLeafNode__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TrieLeafNode__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -22638,7 +22740,7 @@ func (na *_TrieLeafNode__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, erro
 	}
 	return na, nil
 }
-func (_TrieLeafNode__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieLeafNode__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TrieLeafNode.Repr"}.BeginList(0)
 }
 func (na *_TrieLeafNode__ReprAssembler) AssignNull() error {
@@ -22670,10 +22772,10 @@ func (_TrieLeafNode__ReprAssembler) AssignString(string) error {
 func (_TrieLeafNode__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieLeafNode.Repr"}.AssignBytes(nil)
 }
-func (_TrieLeafNode__ReprAssembler) AssignLink(ipld.Link) error {
+func (_TrieLeafNode__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieLeafNode.Repr"}.AssignLink(nil)
 }
-func (na *_TrieLeafNode__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_TrieLeafNode__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -22693,8 +22795,8 @@ func (na *_TrieLeafNode__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TrieLeafNode.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TrieLeafNode.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -22711,7 +22813,7 @@ func (na *_TrieLeafNode__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TrieLeafNode__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_TrieLeafNode__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _TrieLeafNode__ReprPrototype{}
 }
 func (ma *_TrieLeafNode__ReprAssembler) valueFinishTidy() bool {
@@ -22738,7 +22840,7 @@ func (ma *_TrieLeafNode__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_TrieLeafNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TrieLeafNode__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -22756,7 +22858,7 @@ func (ma *_TrieLeafNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssemb
 	switch k {
 	case "PartialPath":
 		if ma.s&fieldBit__TrieLeafNode_PartialPath != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_PartialPath_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_PartialPath_serial}
 		}
 		ma.s += fieldBit__TrieLeafNode_PartialPath
 		ma.state = maState_midValue
@@ -22766,7 +22868,7 @@ func (ma *_TrieLeafNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssemb
 		return &ma.ca_PartialPath, nil
 	case "Value":
 		if ma.s&fieldBit__TrieLeafNode_Value != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_Value_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_Value_serial}
 		}
 		ma.s += fieldBit__TrieLeafNode_Value
 		ma.state = maState_midValue
@@ -22776,9 +22878,9 @@ func (ma *_TrieLeafNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssemb
 		return &ma.ca_Value, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TrieLeafNode.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TrieLeafNode.Repr", Key: &_String{k}}
 }
-func (ma *_TrieLeafNode__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TrieLeafNode__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -22796,7 +22898,7 @@ func (ma *_TrieLeafNode__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TrieLeafNode__ReprKeyAssembler)(ma)
 }
-func (ma *_TrieLeafNode__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TrieLeafNode__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -22839,7 +22941,7 @@ func (ma *_TrieLeafNode__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__TrieLeafNode_sufficient != fieldBits__TrieLeafNode_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__TrieLeafNode_PartialPath == 0 {
 			err.Missing = append(err.Missing, "PartialPath")
 		}
@@ -22852,19 +22954,19 @@ func (ma *_TrieLeafNode__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TrieLeafNode__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TrieLeafNode__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TrieLeafNode__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TrieLeafNode__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _TrieLeafNode__ReprKeyAssembler _TrieLeafNode__ReprAssembler
 
-func (_TrieLeafNode__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TrieLeafNode__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieLeafNode.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_TrieLeafNode__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieLeafNode__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieLeafNode.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_TrieLeafNode__ReprKeyAssembler) AssignNull() error {
@@ -22886,7 +22988,7 @@ func (ka *_TrieLeafNode__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "PartialPath":
 		if ka.s&fieldBit__TrieLeafNode_PartialPath != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_PartialPath_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_PartialPath_serial}
 		}
 		ka.s += fieldBit__TrieLeafNode_PartialPath
 		ka.state = maState_expectValue
@@ -22894,29 +22996,29 @@ func (ka *_TrieLeafNode__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Value":
 		if ka.s&fieldBit__TrieLeafNode_Value != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_Value_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TrieLeafNode_Value_serial}
 		}
 		ka.s += fieldBit__TrieLeafNode_Value
 		ka.state = maState_expectValue
 		ka.f = 1
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.TrieLeafNode.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.TrieLeafNode.Repr", Key: &_String{k}}
 }
 func (_TrieLeafNode__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieLeafNode.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TrieLeafNode__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_TrieLeafNode__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieLeafNode.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TrieLeafNode__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TrieLeafNode__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TrieLeafNode__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_TrieLeafNode__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -22948,12 +23050,12 @@ func (m MaybeTrieNode) IsAbsent() bool {
 func (m MaybeTrieNode) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTrieNode) AsNode() ipld.Node {
+func (m MaybeTrieNode) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -22972,47 +23074,47 @@ var (
 	memberName__TrieNode_TrieExtensionNode = _String{"TrieExtensionNode"}
 	memberName__TrieNode_TrieLeafNode      = _String{"TrieLeafNode"}
 )
-var _ ipld.Node = (TrieNode)(&_TrieNode{})
+var _ datamodel.Node = (TrieNode)(&_TrieNode{})
 var _ schema.TypedNode = (TrieNode)(&_TrieNode{})
 
-func (TrieNode) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (TrieNode) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n TrieNode) LookupByString(key string) (ipld.Node, error) {
+func (n TrieNode) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "TrieBranchNode":
 		if n.tag != 1 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return &n.x1, nil
 	case "TrieExtensionNode":
 		if n.tag != 2 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return &n.x2, nil
 	case "TrieLeafNode":
 		if n.tag != 3 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return &n.x3, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n TrieNode) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n TrieNode) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (TrieNode) LookupByIndex(idx int64) (ipld.Node, error) {
+func (TrieNode) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TrieNode"}.LookupByIndex(0)
 }
-func (n TrieNode) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n TrieNode) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n TrieNode) MapIterator() ipld.MapIterator {
+func (n TrieNode) MapIterator() datamodel.MapIterator {
 	return &_TrieNode__MapItr{n, false}
 }
 
@@ -23021,9 +23123,9 @@ type _TrieNode__MapItr struct {
 	done bool
 }
 
-func (itr *_TrieNode__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TrieNode__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.done {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.n.tag {
 	case 1:
@@ -23042,7 +23144,7 @@ func (itr *_TrieNode__MapItr) Done() bool {
 	return itr.done
 }
 
-func (TrieNode) ListIterator() ipld.ListIterator {
+func (TrieNode) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (TrieNode) Length() int64 {
@@ -23069,16 +23171,16 @@ func (TrieNode) AsString() (string, error) {
 func (TrieNode) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TrieNode"}.AsBytes()
 }
-func (TrieNode) AsLink() (ipld.Link, error) {
+func (TrieNode) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TrieNode"}.AsLink()
 }
-func (TrieNode) Prototype() ipld.NodePrototype {
+func (TrieNode) Prototype() datamodel.NodePrototype {
 	return _TrieNode__Prototype{}
 }
 
 type _TrieNode__Prototype struct{}
 
-func (_TrieNode__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_TrieNode__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TrieNode__Builder
 	nb.Reset()
 	return &nb
@@ -23088,7 +23190,7 @@ type _TrieNode__Builder struct {
 	_TrieNode__Assembler
 }
 
-func (nb *_TrieNode__Builder) Build() ipld.Node {
+func (nb *_TrieNode__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -23133,7 +23235,7 @@ func (na *_TrieNode__Assembler) reset() {
 	na.ca = 0
 	na.cm = schema.Maybe_Absent
 }
-func (na *_TrieNode__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TrieNode__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -23146,7 +23248,7 @@ func (na *_TrieNode__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_TrieNode__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieNode__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TrieNode"}.BeginList(0)
 }
 func (na *_TrieNode__Assembler) AssignNull() error {
@@ -23178,10 +23280,10 @@ func (_TrieNode__Assembler) AssignString(string) error {
 func (_TrieNode__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieNode"}.AssignBytes(nil)
 }
-func (_TrieNode__Assembler) AssignLink(ipld.Link) error {
+func (_TrieNode__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieNode"}.AssignLink(nil)
 }
-func (na *_TrieNode__Assembler) AssignNode(v ipld.Node) error {
+func (na *_TrieNode__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -23201,8 +23303,8 @@ func (na *_TrieNode__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TrieNode", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TrieNode", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -23219,7 +23321,7 @@ func (na *_TrieNode__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TrieNode__Assembler) Prototype() ipld.NodePrototype {
+func (_TrieNode__Assembler) Prototype() datamodel.NodePrototype {
 	return _TrieNode__Prototype{}
 }
 func (ma *_TrieNode__Assembler) valueFinishTidy() bool {
@@ -23231,7 +23333,7 @@ func (ma *_TrieNode__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (ma *_TrieNode__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TrieNode__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -23272,9 +23374,9 @@ func (ma *_TrieNode__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, err
 		ma.ca3.m = &ma.cm
 		return &ma.ca3, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TrieNode", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TrieNode", Key: &_String{k}}
 }
-func (ma *_TrieNode__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TrieNode__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -23292,7 +23394,7 @@ func (ma *_TrieNode__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TrieNode__KeyAssembler)(ma)
 }
-func (ma *_TrieNode__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TrieNode__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -23345,10 +23447,10 @@ func (ma *_TrieNode__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TrieNode__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TrieNode__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TrieNode__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TrieNode__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	switch k {
 	case "TrieBranchNode":
 		return _TrieBranchNode__Prototype{}
@@ -23363,10 +23465,10 @@ func (ma *_TrieNode__Assembler) ValuePrototype(k string) ipld.NodePrototype {
 
 type _TrieNode__KeyAssembler _TrieNode__Assembler
 
-func (_TrieNode__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TrieNode__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieNode.KeyAssembler"}.BeginMap(0)
 }
-func (_TrieNode__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieNode__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieNode.KeyAssembler"}.BeginList(0)
 }
 func (na *_TrieNode__KeyAssembler) AssignNull() error {
@@ -23405,28 +23507,28 @@ func (ka *_TrieNode__KeyAssembler) AssignString(k string) error {
 		ka.state = maState_expectValue
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.TrieNode", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	return schema.ErrInvalidKey{TypeName: "dageth.TrieNode", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
 }
 func (_TrieNode__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieNode.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TrieNode__KeyAssembler) AssignLink(ipld.Link) error {
+func (_TrieNode__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieNode.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TrieNode__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TrieNode__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TrieNode__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_TrieNode__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (TrieNode) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n TrieNode) Representation() ipld.Node {
+func (n TrieNode) Representation() datamodel.Node {
 	return (*_TrieNode__Repr)(n)
 }
 
@@ -23437,46 +23539,46 @@ var (
 	memberName__TrieNode_TrieExtensionNode_serial = _String{"extension"}
 	memberName__TrieNode_TrieLeafNode_serial      = _String{"leaf"}
 )
-var _ ipld.Node = &_TrieNode__Repr{}
+var _ datamodel.Node = &_TrieNode__Repr{}
 
-func (_TrieNode__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_TrieNode__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_TrieNode__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_TrieNode__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "branch":
 		if n.tag != 1 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return n.x1.Representation(), nil
 	case "extension":
 		if n.tag != 2 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return n.x2.Representation(), nil
 	case "leaf":
 		if n.tag != 3 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return n.x3.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_TrieNode__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_TrieNode__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_TrieNode__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_TrieNode__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TrieNode.Repr"}.LookupByIndex(0)
 }
-func (n _TrieNode__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _TrieNode__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_TrieNode__Repr) MapIterator() ipld.MapIterator {
+func (n *_TrieNode__Repr) MapIterator() datamodel.MapIterator {
 	return &_TrieNode__ReprMapItr{n, false}
 }
 
@@ -23485,9 +23587,9 @@ type _TrieNode__ReprMapItr struct {
 	done bool
 }
 
-func (itr *_TrieNode__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TrieNode__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.done {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.n.tag {
 	case 1:
@@ -23506,7 +23608,7 @@ func (itr *_TrieNode__ReprMapItr) Done() bool {
 	return itr.done
 }
 
-func (_TrieNode__Repr) ListIterator() ipld.ListIterator {
+func (_TrieNode__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (_TrieNode__Repr) Length() int64 {
@@ -23533,16 +23635,16 @@ func (_TrieNode__Repr) AsString() (string, error) {
 func (_TrieNode__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TrieNode.Repr"}.AsBytes()
 }
-func (_TrieNode__Repr) AsLink() (ipld.Link, error) {
+func (_TrieNode__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TrieNode.Repr"}.AsLink()
 }
-func (_TrieNode__Repr) Prototype() ipld.NodePrototype {
+func (_TrieNode__Repr) Prototype() datamodel.NodePrototype {
 	return _TrieNode__ReprPrototype{}
 }
 
 type _TrieNode__ReprPrototype struct{}
 
-func (_TrieNode__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_TrieNode__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TrieNode__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -23552,7 +23654,7 @@ type _TrieNode__ReprBuilder struct {
 	_TrieNode__ReprAssembler
 }
 
-func (nb *_TrieNode__ReprBuilder) Build() ipld.Node {
+func (nb *_TrieNode__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -23597,7 +23699,7 @@ func (na *_TrieNode__ReprAssembler) reset() {
 	na.ca = 0
 	na.cm = schema.Maybe_Absent
 }
-func (na *_TrieNode__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TrieNode__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -23610,7 +23712,7 @@ func (na *_TrieNode__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_TrieNode__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieNode__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TrieNode.Repr"}.BeginList(0)
 }
 func (na *_TrieNode__ReprAssembler) AssignNull() error {
@@ -23642,10 +23744,10 @@ func (_TrieNode__ReprAssembler) AssignString(string) error {
 func (_TrieNode__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieNode.Repr"}.AssignBytes(nil)
 }
-func (_TrieNode__ReprAssembler) AssignLink(ipld.Link) error {
+func (_TrieNode__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TrieNode.Repr"}.AssignLink(nil)
 }
-func (na *_TrieNode__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_TrieNode__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -23665,8 +23767,8 @@ func (na *_TrieNode__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TrieNode.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TrieNode.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -23683,7 +23785,7 @@ func (na *_TrieNode__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TrieNode__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_TrieNode__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _TrieNode__ReprPrototype{}
 }
 func (ma *_TrieNode__ReprAssembler) valueFinishTidy() bool {
@@ -23695,7 +23797,7 @@ func (ma *_TrieNode__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (ma *_TrieNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TrieNode__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -23736,9 +23838,9 @@ func (ma *_TrieNode__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		ma.ca3.m = &ma.cm
 		return &ma.ca3, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TrieNode.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TrieNode.Repr", Key: &_String{k}}
 }
-func (ma *_TrieNode__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TrieNode__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -23756,7 +23858,7 @@ func (ma *_TrieNode__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TrieNode__ReprKeyAssembler)(ma)
 }
-func (ma *_TrieNode__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TrieNode__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -23771,15 +23873,15 @@ func (ma *_TrieNode__ReprAssembler) AssembleValue() ipld.NodeAssembler {
 	}
 	ma.state = maState_midValue
 	switch ma.ca {
-	case 0:
+	case 1:
 		ma.ca1.w = &ma.w.x1
 		ma.ca1.m = &ma.cm
 		return &ma.ca1
-	case 1:
+	case 2:
 		ma.ca2.w = &ma.w.x2
 		ma.ca2.m = &ma.cm
 		return &ma.ca2
-	case 2:
+	case 3:
 		ma.ca3.w = &ma.w.x3
 		ma.ca3.m = &ma.cm
 		return &ma.ca3
@@ -23809,10 +23911,10 @@ func (ma *_TrieNode__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TrieNode__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TrieNode__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TrieNode__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TrieNode__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	switch k {
 	case "TrieBranchNode":
 		return _TrieBranchNode__ReprPrototype{}
@@ -23827,10 +23929,10 @@ func (ma *_TrieNode__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype
 
 type _TrieNode__ReprKeyAssembler _TrieNode__ReprAssembler
 
-func (_TrieNode__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TrieNode__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieNode.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_TrieNode__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TrieNode__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TrieNode.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_TrieNode__ReprKeyAssembler) AssignNull() error {
@@ -23869,22 +23971,22 @@ func (ka *_TrieNode__ReprKeyAssembler) AssignString(k string) error {
 		ka.state = maState_expectValue
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.TrieNode.Repr", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	return schema.ErrInvalidKey{TypeName: "dageth.TrieNode.Repr", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
 }
 func (_TrieNode__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieNode.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TrieNode__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_TrieNode__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TrieNode.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TrieNode__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TrieNode__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TrieNode__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_TrieNode__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -23945,12 +24047,12 @@ func (m MaybeTxCIDList) IsAbsent() bool {
 func (m MaybeTxCIDList) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTxCIDList) AsNode() ipld.Node {
+func (m MaybeTxCIDList) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -23964,40 +24066,40 @@ func (m MaybeTxCIDList) Must() TxCIDList {
 	return &m.v
 }
 
-var _ ipld.Node = (TxCIDList)(&_TxCIDList{})
+var _ datamodel.Node = (TxCIDList)(&_TxCIDList{})
 var _ schema.TypedNode = (TxCIDList)(&_TxCIDList{})
 
-func (TxCIDList) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (TxCIDList) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (TxCIDList) LookupByString(string) (ipld.Node, error) {
+func (TxCIDList) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.TxCIDList"}.LookupByString("")
 }
-func (n TxCIDList) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n TxCIDList) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n TxCIDList) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n TxCIDList) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n TxCIDList) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n TxCIDList) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.TxCIDList", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.TxCIDList", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (TxCIDList) MapIterator() ipld.MapIterator {
+func (TxCIDList) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n TxCIDList) ListIterator() ipld.ListIterator {
+func (n TxCIDList) ListIterator() datamodel.ListIterator {
 	return &_TxCIDList__ListItr{n, 0}
 }
 
@@ -24006,9 +24108,9 @@ type _TxCIDList__ListItr struct {
 	idx int
 }
 
-func (itr *_TxCIDList__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_TxCIDList__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -24044,16 +24146,16 @@ func (TxCIDList) AsString() (string, error) {
 func (TxCIDList) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.TxCIDList"}.AsBytes()
 }
-func (TxCIDList) AsLink() (ipld.Link, error) {
+func (TxCIDList) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.TxCIDList"}.AsLink()
 }
-func (TxCIDList) Prototype() ipld.NodePrototype {
+func (TxCIDList) Prototype() datamodel.NodePrototype {
 	return _TxCIDList__Prototype{}
 }
 
 type _TxCIDList__Prototype struct{}
 
-func (_TxCIDList__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_TxCIDList__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TxCIDList__Builder
 	nb.Reset()
 	return &nb
@@ -24063,7 +24165,7 @@ type _TxCIDList__Builder struct {
 	_TxCIDList__Assembler
 }
 
-func (nb *_TxCIDList__Builder) Build() ipld.Node {
+func (nb *_TxCIDList__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -24088,10 +24190,10 @@ func (na *_TxCIDList__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_TxCIDList__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TxCIDList__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.TxCIDList"}.BeginMap(0)
 }
-func (na *_TxCIDList__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_TxCIDList__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -24136,10 +24238,10 @@ func (_TxCIDList__Assembler) AssignString(string) error {
 func (_TxCIDList__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.TxCIDList"}.AssignBytes(nil)
 }
-func (_TxCIDList__Assembler) AssignLink(ipld.Link) error {
+func (_TxCIDList__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.TxCIDList"}.AssignLink(nil)
 }
-func (na *_TxCIDList__Assembler) AssignNode(v ipld.Node) error {
+func (na *_TxCIDList__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -24154,8 +24256,8 @@ func (na *_TxCIDList__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.TxCIDList", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TxCIDList", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -24169,7 +24271,7 @@ func (na *_TxCIDList__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TxCIDList__Assembler) Prototype() ipld.NodePrototype {
+func (_TxCIDList__Assembler) Prototype() datamodel.NodePrototype {
 	return _TxCIDList__Prototype{}
 }
 func (la *_TxCIDList__Assembler) valueFinishTidy() bool {
@@ -24184,7 +24286,7 @@ func (la *_TxCIDList__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_TxCIDList__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_TxCIDList__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -24217,59 +24319,59 @@ func (la *_TxCIDList__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_TxCIDList__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_TxCIDList__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Link__Prototype{}
 }
 func (TxCIDList) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n TxCIDList) Representation() ipld.Node {
+func (n TxCIDList) Representation() datamodel.Node {
 	return (*_TxCIDList__Repr)(n)
 }
 
 type _TxCIDList__Repr _TxCIDList
 
-var _ ipld.Node = &_TxCIDList__Repr{}
+var _ datamodel.Node = &_TxCIDList__Repr{}
 
-func (_TxCIDList__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_TxCIDList__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_TxCIDList__Repr) LookupByString(string) (ipld.Node, error) {
+func (_TxCIDList__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.TxCIDList.Repr"}.LookupByString("")
 }
-func (nr *_TxCIDList__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_TxCIDList__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (TxCIDList)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Link).Representation(), nil
 }
-func (nr *_TxCIDList__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_TxCIDList__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (TxCIDList)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Link).Representation(), nil
 }
-func (n _TxCIDList__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _TxCIDList__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.TxCIDList.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.TxCIDList.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_TxCIDList__Repr) MapIterator() ipld.MapIterator {
+func (_TxCIDList__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_TxCIDList__Repr) ListIterator() ipld.ListIterator {
+func (nr *_TxCIDList__Repr) ListIterator() datamodel.ListIterator {
 	return &_TxCIDList__ReprListItr{(TxCIDList)(nr), 0}
 }
 
 type _TxCIDList__ReprListItr _TxCIDList__ListItr
 
-func (itr *_TxCIDList__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_TxCIDList__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_TxCIDList__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(Link).Representation(), nil
@@ -24302,16 +24404,16 @@ func (_TxCIDList__Repr) AsString() (string, error) {
 func (_TxCIDList__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.TxCIDList.Repr"}.AsBytes()
 }
-func (_TxCIDList__Repr) AsLink() (ipld.Link, error) {
+func (_TxCIDList__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.TxCIDList.Repr"}.AsLink()
 }
-func (_TxCIDList__Repr) Prototype() ipld.NodePrototype {
+func (_TxCIDList__Repr) Prototype() datamodel.NodePrototype {
 	return _TxCIDList__ReprPrototype{}
 }
 
 type _TxCIDList__ReprPrototype struct{}
 
-func (_TxCIDList__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_TxCIDList__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TxCIDList__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -24321,7 +24423,7 @@ type _TxCIDList__ReprBuilder struct {
 	_TxCIDList__ReprAssembler
 }
 
-func (nb *_TxCIDList__ReprBuilder) Build() ipld.Node {
+func (nb *_TxCIDList__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -24346,10 +24448,10 @@ func (na *_TxCIDList__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_TxCIDList__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TxCIDList__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.TxCIDList.Repr"}.BeginMap(0)
 }
-func (na *_TxCIDList__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_TxCIDList__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -24394,10 +24496,10 @@ func (_TxCIDList__ReprAssembler) AssignString(string) error {
 func (_TxCIDList__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.TxCIDList.Repr"}.AssignBytes(nil)
 }
-func (_TxCIDList__ReprAssembler) AssignLink(ipld.Link) error {
+func (_TxCIDList__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.TxCIDList.Repr"}.AssignLink(nil)
 }
-func (na *_TxCIDList__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_TxCIDList__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -24412,8 +24514,8 @@ func (na *_TxCIDList__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.TxCIDList.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TxCIDList.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -24427,7 +24529,7 @@ func (na *_TxCIDList__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TxCIDList__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_TxCIDList__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _TxCIDList__ReprPrototype{}
 }
 func (la *_TxCIDList__ReprAssembler) valueFinishTidy() bool {
@@ -24442,7 +24544,7 @@ func (la *_TxCIDList__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_TxCIDList__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_TxCIDList__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -24475,7 +24577,7 @@ func (la *_TxCIDList__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_TxCIDList__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_TxCIDList__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Link__ReprPrototype{}
 }
 
@@ -24513,12 +24615,12 @@ func (m MaybeTxTrace) IsAbsent() bool {
 func (m MaybeTxTrace) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTxTrace) AsNode() ipld.Node {
+func (m MaybeTxTrace) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -24540,13 +24642,13 @@ var (
 	fieldName__TxTrace_Gas          = _String{"Gas"}
 	fieldName__TxTrace_Failed       = _String{"Failed"}
 )
-var _ ipld.Node = (TxTrace)(&_TxTrace{})
+var _ datamodel.Node = (TxTrace)(&_TxTrace{})
 var _ schema.TypedNode = (TxTrace)(&_TxTrace{})
 
-func (TxTrace) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (TxTrace) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n TxTrace) LookupByString(key string) (ipld.Node, error) {
+func (n TxTrace) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "TxCIDs":
 		return &n.TxCIDs, nil
@@ -24561,23 +24663,23 @@ func (n TxTrace) LookupByString(key string) (ipld.Node, error) {
 	case "Failed":
 		return &n.Failed, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n TxTrace) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n TxTrace) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (TxTrace) LookupByIndex(idx int64) (ipld.Node, error) {
+func (TxTrace) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TxTrace"}.LookupByIndex(0)
 }
-func (n TxTrace) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n TxTrace) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n TxTrace) MapIterator() ipld.MapIterator {
+func (n TxTrace) MapIterator() datamodel.MapIterator {
 	return &_TxTrace__MapItr{n, 0}
 }
 
@@ -24586,9 +24688,9 @@ type _TxTrace__MapItr struct {
 	idx int
 }
 
-func (itr *_TxTrace__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TxTrace__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 6 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -24619,7 +24721,7 @@ func (itr *_TxTrace__MapItr) Done() bool {
 	return itr.idx >= 6
 }
 
-func (TxTrace) ListIterator() ipld.ListIterator {
+func (TxTrace) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (TxTrace) Length() int64 {
@@ -24646,16 +24748,16 @@ func (TxTrace) AsString() (string, error) {
 func (TxTrace) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TxTrace"}.AsBytes()
 }
-func (TxTrace) AsLink() (ipld.Link, error) {
+func (TxTrace) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TxTrace"}.AsLink()
 }
-func (TxTrace) Prototype() ipld.NodePrototype {
+func (TxTrace) Prototype() datamodel.NodePrototype {
 	return _TxTrace__Prototype{}
 }
 
 type _TxTrace__Prototype struct{}
 
-func (_TxTrace__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_TxTrace__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TxTrace__Builder
 	nb.Reset()
 	return &nb
@@ -24665,7 +24767,7 @@ type _TxTrace__Builder struct {
 	_TxTrace__Assembler
 }
 
-func (nb *_TxTrace__Builder) Build() ipld.Node {
+func (nb *_TxTrace__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -24714,7 +24816,7 @@ var (
 	fieldBits__TxTrace_sufficient  = 0 + 1<<0 + 1<<1 + 1<<2 + 1<<3 + 1<<4 + 1<<5
 )
 
-func (na *_TxTrace__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TxTrace__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -24727,7 +24829,7 @@ func (na *_TxTrace__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_TxTrace__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TxTrace__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TxTrace"}.BeginList(0)
 }
 func (na *_TxTrace__Assembler) AssignNull() error {
@@ -24759,10 +24861,10 @@ func (_TxTrace__Assembler) AssignString(string) error {
 func (_TxTrace__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TxTrace"}.AssignBytes(nil)
 }
-func (_TxTrace__Assembler) AssignLink(ipld.Link) error {
+func (_TxTrace__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TxTrace"}.AssignLink(nil)
 }
-func (na *_TxTrace__Assembler) AssignNode(v ipld.Node) error {
+func (na *_TxTrace__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -24782,8 +24884,8 @@ func (na *_TxTrace__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TxTrace", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TxTrace", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -24800,7 +24902,7 @@ func (na *_TxTrace__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TxTrace__Assembler) Prototype() ipld.NodePrototype {
+func (_TxTrace__Assembler) Prototype() datamodel.NodePrototype {
 	return _TxTrace__Prototype{}
 }
 func (ma *_TxTrace__Assembler) valueFinishTidy() bool {
@@ -24869,7 +24971,7 @@ func (ma *_TxTrace__Assembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_TxTrace__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TxTrace__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -24887,7 +24989,7 @@ func (ma *_TxTrace__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 	switch k {
 	case "TxCIDs":
 		if ma.s&fieldBit__TxTrace_TxCIDs != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_TxCIDs}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_TxCIDs}
 		}
 		ma.s += fieldBit__TxTrace_TxCIDs
 		ma.state = maState_midValue
@@ -24897,7 +24999,7 @@ func (ma *_TxTrace__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_TxCIDs, nil
 	case "StateRootCID":
 		if ma.s&fieldBit__TxTrace_StateRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_StateRootCID}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_StateRootCID}
 		}
 		ma.s += fieldBit__TxTrace_StateRootCID
 		ma.state = maState_midValue
@@ -24907,7 +25009,7 @@ func (ma *_TxTrace__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_StateRootCID, nil
 	case "Result":
 		if ma.s&fieldBit__TxTrace_Result != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Result}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Result}
 		}
 		ma.s += fieldBit__TxTrace_Result
 		ma.state = maState_midValue
@@ -24917,7 +25019,7 @@ func (ma *_TxTrace__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Result, nil
 	case "Frames":
 		if ma.s&fieldBit__TxTrace_Frames != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Frames}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Frames}
 		}
 		ma.s += fieldBit__TxTrace_Frames
 		ma.state = maState_midValue
@@ -24927,7 +25029,7 @@ func (ma *_TxTrace__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Frames, nil
 	case "Gas":
 		if ma.s&fieldBit__TxTrace_Gas != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Gas}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Gas}
 		}
 		ma.s += fieldBit__TxTrace_Gas
 		ma.state = maState_midValue
@@ -24937,7 +25039,7 @@ func (ma *_TxTrace__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		return &ma.ca_Gas, nil
 	case "Failed":
 		if ma.s&fieldBit__TxTrace_Failed != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Failed}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Failed}
 		}
 		ma.s += fieldBit__TxTrace_Failed
 		ma.state = maState_midValue
@@ -24946,9 +25048,9 @@ func (ma *_TxTrace__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, erro
 		ma.ca_Failed.m = &ma.cm
 		return &ma.ca_Failed, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TxTrace", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TxTrace", Key: &_String{k}}
 }
-func (ma *_TxTrace__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TxTrace__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -24966,7 +25068,7 @@ func (ma *_TxTrace__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TxTrace__KeyAssembler)(ma)
 }
-func (ma *_TxTrace__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TxTrace__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -25025,7 +25127,7 @@ func (ma *_TxTrace__Assembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__TxTrace_sufficient != fieldBits__TxTrace_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__TxTrace_TxCIDs == 0 {
 			err.Missing = append(err.Missing, "TxCIDs")
 		}
@@ -25050,19 +25152,19 @@ func (ma *_TxTrace__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TxTrace__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TxTrace__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TxTrace__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TxTrace__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler valueprototype")
 }
 
 type _TxTrace__KeyAssembler _TxTrace__Assembler
 
-func (_TxTrace__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TxTrace__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TxTrace.KeyAssembler"}.BeginMap(0)
 }
-func (_TxTrace__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TxTrace__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TxTrace.KeyAssembler"}.BeginList(0)
 }
 func (na *_TxTrace__KeyAssembler) AssignNull() error {
@@ -25084,71 +25186,76 @@ func (ka *_TxTrace__KeyAssembler) AssignString(k string) error {
 	switch k {
 	case "TxCIDs":
 		if ka.s&fieldBit__TxTrace_TxCIDs != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_TxCIDs}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_TxCIDs}
 		}
 		ka.s += fieldBit__TxTrace_TxCIDs
 		ka.state = maState_expectValue
 		ka.f = 0
+		return nil
 	case "StateRootCID":
 		if ka.s&fieldBit__TxTrace_StateRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_StateRootCID}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_StateRootCID}
 		}
 		ka.s += fieldBit__TxTrace_StateRootCID
 		ka.state = maState_expectValue
 		ka.f = 1
+		return nil
 	case "Result":
 		if ka.s&fieldBit__TxTrace_Result != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Result}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Result}
 		}
 		ka.s += fieldBit__TxTrace_Result
 		ka.state = maState_expectValue
 		ka.f = 2
+		return nil
 	case "Frames":
 		if ka.s&fieldBit__TxTrace_Frames != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Frames}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Frames}
 		}
 		ka.s += fieldBit__TxTrace_Frames
 		ka.state = maState_expectValue
 		ka.f = 3
+		return nil
 	case "Gas":
 		if ka.s&fieldBit__TxTrace_Gas != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Gas}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Gas}
 		}
 		ka.s += fieldBit__TxTrace_Gas
 		ka.state = maState_expectValue
 		ka.f = 4
+		return nil
 	case "Failed":
 		if ka.s&fieldBit__TxTrace_Failed != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Failed}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Failed}
 		}
 		ka.s += fieldBit__TxTrace_Failed
 		ka.state = maState_expectValue
 		ka.f = 5
+		return nil
 	default:
-		return ipld.ErrInvalidKey{TypeName: "dageth.TxTrace", Key: &_String{k}}
+		return schema.ErrInvalidKey{TypeName: "dageth.TxTrace", Key: &_String{k}}
 	}
-	return nil
 }
 func (_TxTrace__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TxTrace.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TxTrace__KeyAssembler) AssignLink(ipld.Link) error {
+func (_TxTrace__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TxTrace.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TxTrace__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TxTrace__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TxTrace__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_TxTrace__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (TxTrace) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n TxTrace) Representation() ipld.Node {
+func (n TxTrace) Representation() datamodel.Node {
 	return (*_TxTrace__Repr)(n)
 }
 
@@ -25162,12 +25269,12 @@ var (
 	fieldName__TxTrace_Gas_serial          = _String{"Gas"}
 	fieldName__TxTrace_Failed_serial       = _String{"Failed"}
 )
-var _ ipld.Node = &_TxTrace__Repr{}
+var _ datamodel.Node = &_TxTrace__Repr{}
 
-func (_TxTrace__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_TxTrace__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_TxTrace__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_TxTrace__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "TxCIDs":
 		return n.TxCIDs.Representation(), nil
@@ -25182,23 +25289,23 @@ func (n *_TxTrace__Repr) LookupByString(key string) (ipld.Node, error) {
 	case "Failed":
 		return n.Failed.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_TxTrace__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_TxTrace__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_TxTrace__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_TxTrace__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.TxTrace.Repr"}.LookupByIndex(0)
 }
-func (n _TxTrace__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _TxTrace__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_TxTrace__Repr) MapIterator() ipld.MapIterator {
+func (n *_TxTrace__Repr) MapIterator() datamodel.MapIterator {
 	return &_TxTrace__ReprMapItr{n, 0}
 }
 
@@ -25207,9 +25314,9 @@ type _TxTrace__ReprMapItr struct {
 	idx int
 }
 
-func (itr *_TxTrace__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_TxTrace__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.idx >= 6 {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.idx {
 	case 0:
@@ -25239,7 +25346,7 @@ func (itr *_TxTrace__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
 func (itr *_TxTrace__ReprMapItr) Done() bool {
 	return itr.idx >= 6
 }
-func (_TxTrace__Repr) ListIterator() ipld.ListIterator {
+func (_TxTrace__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (rn *_TxTrace__Repr) Length() int64 {
@@ -25267,16 +25374,16 @@ func (_TxTrace__Repr) AsString() (string, error) {
 func (_TxTrace__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.TxTrace.Repr"}.AsBytes()
 }
-func (_TxTrace__Repr) AsLink() (ipld.Link, error) {
+func (_TxTrace__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.TxTrace.Repr"}.AsLink()
 }
-func (_TxTrace__Repr) Prototype() ipld.NodePrototype {
+func (_TxTrace__Repr) Prototype() datamodel.NodePrototype {
 	return _TxTrace__ReprPrototype{}
 }
 
 type _TxTrace__ReprPrototype struct{}
 
-func (_TxTrace__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_TxTrace__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TxTrace__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -25286,7 +25393,7 @@ type _TxTrace__ReprBuilder struct {
 	_TxTrace__ReprAssembler
 }
 
-func (nb *_TxTrace__ReprBuilder) Build() ipld.Node {
+func (nb *_TxTrace__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -25324,7 +25431,7 @@ func (na *_TxTrace__ReprAssembler) reset() {
 	na.ca_Gas.reset()
 	na.ca_Failed.reset()
 }
-func (na *_TxTrace__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_TxTrace__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -25337,7 +25444,7 @@ func (na *_TxTrace__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_TxTrace__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TxTrace__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.TxTrace.Repr"}.BeginList(0)
 }
 func (na *_TxTrace__ReprAssembler) AssignNull() error {
@@ -25369,10 +25476,10 @@ func (_TxTrace__ReprAssembler) AssignString(string) error {
 func (_TxTrace__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.TxTrace.Repr"}.AssignBytes(nil)
 }
-func (_TxTrace__ReprAssembler) AssignLink(ipld.Link) error {
+func (_TxTrace__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.TxTrace.Repr"}.AssignLink(nil)
 }
-func (na *_TxTrace__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_TxTrace__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -25392,8 +25499,8 @@ func (na *_TxTrace__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.TxTrace.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.TxTrace.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -25410,7 +25517,7 @@ func (na *_TxTrace__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_TxTrace__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_TxTrace__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _TxTrace__ReprPrototype{}
 }
 func (ma *_TxTrace__ReprAssembler) valueFinishTidy() bool {
@@ -25473,7 +25580,7 @@ func (ma *_TxTrace__ReprAssembler) valueFinishTidy() bool {
 		panic("unreachable")
 	}
 }
-func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -25491,7 +25598,7 @@ func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 	switch k {
 	case "TxCIDs":
 		if ma.s&fieldBit__TxTrace_TxCIDs != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_TxCIDs_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_TxCIDs_serial}
 		}
 		ma.s += fieldBit__TxTrace_TxCIDs
 		ma.state = maState_midValue
@@ -25501,7 +25608,7 @@ func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_TxCIDs, nil
 	case "StateRootCID":
 		if ma.s&fieldBit__TxTrace_StateRootCID != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_StateRootCID_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_StateRootCID_serial}
 		}
 		ma.s += fieldBit__TxTrace_StateRootCID
 		ma.state = maState_midValue
@@ -25511,7 +25618,7 @@ func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_StateRootCID, nil
 	case "Result":
 		if ma.s&fieldBit__TxTrace_Result != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Result_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Result_serial}
 		}
 		ma.s += fieldBit__TxTrace_Result
 		ma.state = maState_midValue
@@ -25521,7 +25628,7 @@ func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Result, nil
 	case "Frames":
 		if ma.s&fieldBit__TxTrace_Frames != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Frames_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Frames_serial}
 		}
 		ma.s += fieldBit__TxTrace_Frames
 		ma.state = maState_midValue
@@ -25531,7 +25638,7 @@ func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Frames, nil
 	case "Gas":
 		if ma.s&fieldBit__TxTrace_Gas != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Gas_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Gas_serial}
 		}
 		ma.s += fieldBit__TxTrace_Gas
 		ma.state = maState_midValue
@@ -25541,7 +25648,7 @@ func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Gas, nil
 	case "Failed":
 		if ma.s&fieldBit__TxTrace_Failed != 0 {
-			return nil, ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Failed_serial}
+			return nil, datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Failed_serial}
 		}
 		ma.s += fieldBit__TxTrace_Failed
 		ma.state = maState_midValue
@@ -25551,9 +25658,9 @@ func (ma *_TxTrace__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler,
 		return &ma.ca_Failed, nil
 	default:
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.TxTrace.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.TxTrace.Repr", Key: &_String{k}}
 }
-func (ma *_TxTrace__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_TxTrace__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -25571,7 +25678,7 @@ func (ma *_TxTrace__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_TxTrace__ReprKeyAssembler)(ma)
 }
-func (ma *_TxTrace__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_TxTrace__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -25630,7 +25737,7 @@ func (ma *_TxTrace__ReprAssembler) Finish() error {
 		panic("invalid state: Finish cannot be called on an assembler that's already finished")
 	}
 	if ma.s&fieldBits__TxTrace_sufficient != fieldBits__TxTrace_sufficient {
-		err := ipld.ErrMissingRequiredField{Missing: make([]string, 0)}
+		err := schema.ErrMissingRequiredField{Missing: make([]string, 0)}
 		if ma.s&fieldBit__TxTrace_TxCIDs == 0 {
 			err.Missing = append(err.Missing, "TxCIDs")
 		}
@@ -25655,19 +25762,19 @@ func (ma *_TxTrace__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_TxTrace__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_TxTrace__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_TxTrace__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_TxTrace__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	panic("todo structbuilder mapassembler repr valueprototype")
 }
 
 type _TxTrace__ReprKeyAssembler _TxTrace__ReprAssembler
 
-func (_TxTrace__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TxTrace__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TxTrace.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_TxTrace__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TxTrace__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.TxTrace.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_TxTrace__ReprKeyAssembler) AssignNull() error {
@@ -25689,7 +25796,7 @@ func (ka *_TxTrace__ReprKeyAssembler) AssignString(k string) error {
 	switch k {
 	case "TxCIDs":
 		if ka.s&fieldBit__TxTrace_TxCIDs != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_TxCIDs_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_TxCIDs_serial}
 		}
 		ka.s += fieldBit__TxTrace_TxCIDs
 		ka.state = maState_expectValue
@@ -25697,7 +25804,7 @@ func (ka *_TxTrace__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "StateRootCID":
 		if ka.s&fieldBit__TxTrace_StateRootCID != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_StateRootCID_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_StateRootCID_serial}
 		}
 		ka.s += fieldBit__TxTrace_StateRootCID
 		ka.state = maState_expectValue
@@ -25705,7 +25812,7 @@ func (ka *_TxTrace__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Result":
 		if ka.s&fieldBit__TxTrace_Result != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Result_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Result_serial}
 		}
 		ka.s += fieldBit__TxTrace_Result
 		ka.state = maState_expectValue
@@ -25713,7 +25820,7 @@ func (ka *_TxTrace__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Frames":
 		if ka.s&fieldBit__TxTrace_Frames != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Frames_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Frames_serial}
 		}
 		ka.s += fieldBit__TxTrace_Frames
 		ka.state = maState_expectValue
@@ -25721,7 +25828,7 @@ func (ka *_TxTrace__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Gas":
 		if ka.s&fieldBit__TxTrace_Gas != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Gas_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Gas_serial}
 		}
 		ka.s += fieldBit__TxTrace_Gas
 		ka.state = maState_expectValue
@@ -25729,29 +25836,29 @@ func (ka *_TxTrace__ReprKeyAssembler) AssignString(k string) error {
 		return nil
 	case "Failed":
 		if ka.s&fieldBit__TxTrace_Failed != 0 {
-			return ipld.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Failed_serial}
+			return datamodel.ErrRepeatedMapKey{Key: &fieldName__TxTrace_Failed_serial}
 		}
 		ka.s += fieldBit__TxTrace_Failed
 		ka.state = maState_expectValue
 		ka.f = 5
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.TxTrace.Repr", Key: &_String{k}}
+	return schema.ErrInvalidKey{TypeName: "dageth.TxTrace.Repr", Key: &_String{k}}
 }
 func (_TxTrace__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.TxTrace.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_TxTrace__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_TxTrace__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.TxTrace.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_TxTrace__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_TxTrace__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_TxTrace__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_TxTrace__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 
@@ -25778,12 +25885,12 @@ func (m MaybeTxType) IsAbsent() bool {
 func (m MaybeTxType) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeTxType) AsNode() ipld.Node {
+func (m MaybeTxType) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -25797,28 +25904,28 @@ func (m MaybeTxType) Must() TxType {
 	return &m.v
 }
 
-var _ ipld.Node = (TxType)(&_TxType{})
+var _ datamodel.Node = (TxType)(&_TxType{})
 var _ schema.TypedNode = (TxType)(&_TxType{})
 
-func (TxType) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (TxType) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (TxType) LookupByString(string) (ipld.Node, error) {
+func (TxType) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.TxType"}.LookupByString("")
 }
-func (TxType) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (TxType) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.TxType"}.LookupByNode(nil)
 }
-func (TxType) LookupByIndex(idx int64) (ipld.Node, error) {
+func (TxType) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.TxType"}.LookupByIndex(0)
 }
-func (TxType) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (TxType) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.TxType"}.LookupBySegment(seg)
 }
-func (TxType) MapIterator() ipld.MapIterator {
+func (TxType) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (TxType) ListIterator() ipld.ListIterator {
+func (TxType) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (TxType) Length() int64 {
@@ -25845,16 +25952,16 @@ func (TxType) AsString() (string, error) {
 func (n TxType) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (TxType) AsLink() (ipld.Link, error) {
+func (TxType) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.TxType"}.AsLink()
 }
-func (TxType) Prototype() ipld.NodePrototype {
+func (TxType) Prototype() datamodel.NodePrototype {
 	return _TxType__Prototype{}
 }
 
 type _TxType__Prototype struct{}
 
-func (_TxType__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_TxType__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _TxType__Builder
 	nb.Reset()
 	return &nb
@@ -25864,7 +25971,7 @@ type _TxType__Builder struct {
 	_TxType__Assembler
 }
 
-func (nb *_TxType__Builder) Build() ipld.Node {
+func (nb *_TxType__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -25882,10 +25989,10 @@ type _TxType__Assembler struct {
 }
 
 func (na *_TxType__Assembler) reset() {}
-func (_TxType__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_TxType__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.TxType"}.BeginMap(0)
 }
-func (_TxType__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_TxType__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.TxType"}.BeginList(0)
 }
 func (na *_TxType__Assembler) AssignNull() error {
@@ -25921,10 +26028,10 @@ func (na *_TxType__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_TxType__Assembler) AssignLink(ipld.Link) error {
+func (_TxType__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.TxType"}.AssignLink(nil)
 }
-func (na *_TxType__Assembler) AssignNode(v ipld.Node) error {
+func (na *_TxType__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -25943,19 +26050,19 @@ func (na *_TxType__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_TxType__Assembler) Prototype() ipld.NodePrototype {
+func (_TxType__Assembler) Prototype() datamodel.NodePrototype {
 	return _TxType__Prototype{}
 }
 func (TxType) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n TxType) Representation() ipld.Node {
+func (n TxType) Representation() datamodel.Node {
 	return (*_TxType__Repr)(n)
 }
 
 type _TxType__Repr = _TxType
 
-var _ ipld.Node = &_TxType__Repr{}
+var _ datamodel.Node = &_TxType__Repr{}
 
 type _TxType__ReprPrototype = _TxType__Prototype
 type _TxType__ReprAssembler = _TxType__Assembler
@@ -25983,12 +26090,12 @@ func (m MaybeUint) IsAbsent() bool {
 func (m MaybeUint) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeUint) AsNode() ipld.Node {
+func (m MaybeUint) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -26002,28 +26109,28 @@ func (m MaybeUint) Must() Uint {
 	return &m.v
 }
 
-var _ ipld.Node = (Uint)(&_Uint{})
+var _ datamodel.Node = (Uint)(&_Uint{})
 var _ schema.TypedNode = (Uint)(&_Uint{})
 
-func (Uint) Kind() ipld.Kind {
-	return ipld.Kind_Bytes
+func (Uint) Kind() datamodel.Kind {
+	return datamodel.Kind_Bytes
 }
-func (Uint) LookupByString(string) (ipld.Node, error) {
+func (Uint) LookupByString(string) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Uint"}.LookupByString("")
 }
-func (Uint) LookupByNode(ipld.Node) (ipld.Node, error) {
+func (Uint) LookupByNode(datamodel.Node) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Uint"}.LookupByNode(nil)
 }
-func (Uint) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Uint) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Uint"}.LookupByIndex(0)
 }
-func (Uint) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (Uint) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return mixins.Bytes{TypeName: "dageth.Uint"}.LookupBySegment(seg)
 }
-func (Uint) MapIterator() ipld.MapIterator {
+func (Uint) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (Uint) ListIterator() ipld.ListIterator {
+func (Uint) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Uint) Length() int64 {
@@ -26050,16 +26157,16 @@ func (Uint) AsString() (string, error) {
 func (n Uint) AsBytes() ([]byte, error) {
 	return n.x, nil
 }
-func (Uint) AsLink() (ipld.Link, error) {
+func (Uint) AsLink() (datamodel.Link, error) {
 	return mixins.Bytes{TypeName: "dageth.Uint"}.AsLink()
 }
-func (Uint) Prototype() ipld.NodePrototype {
+func (Uint) Prototype() datamodel.NodePrototype {
 	return _Uint__Prototype{}
 }
 
 type _Uint__Prototype struct{}
 
-func (_Uint__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Uint__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Uint__Builder
 	nb.Reset()
 	return &nb
@@ -26069,7 +26176,7 @@ type _Uint__Builder struct {
 	_Uint__Assembler
 }
 
-func (nb *_Uint__Builder) Build() ipld.Node {
+func (nb *_Uint__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -26087,10 +26194,10 @@ type _Uint__Assembler struct {
 }
 
 func (na *_Uint__Assembler) reset() {}
-func (_Uint__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Uint__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Uint"}.BeginMap(0)
 }
-func (_Uint__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Uint__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.BytesAssembler{TypeName: "dageth.Uint"}.BeginList(0)
 }
 func (na *_Uint__Assembler) AssignNull() error {
@@ -26126,10 +26233,10 @@ func (na *_Uint__Assembler) AssignBytes(v []byte) error {
 	*na.m = schema.Maybe_Value
 	return nil
 }
-func (_Uint__Assembler) AssignLink(ipld.Link) error {
+func (_Uint__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.BytesAssembler{TypeName: "dageth.Uint"}.AssignLink(nil)
 }
-func (na *_Uint__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Uint__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -26148,19 +26255,19 @@ func (na *_Uint__Assembler) AssignNode(v ipld.Node) error {
 		return na.AssignBytes(v2)
 	}
 }
-func (_Uint__Assembler) Prototype() ipld.NodePrototype {
+func (_Uint__Assembler) Prototype() datamodel.NodePrototype {
 	return _Uint__Prototype{}
 }
 func (Uint) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Uint) Representation() ipld.Node {
+func (n Uint) Representation() datamodel.Node {
 	return (*_Uint__Repr)(n)
 }
 
 type _Uint__Repr = _Uint
 
-var _ ipld.Node = &_Uint__Repr{}
+var _ datamodel.Node = &_Uint__Repr{}
 
 type _Uint__ReprPrototype = _Uint__Prototype
 type _Uint__ReprAssembler = _Uint__Assembler
@@ -26222,12 +26329,12 @@ func (m MaybeUncles) IsAbsent() bool {
 func (m MaybeUncles) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeUncles) AsNode() ipld.Node {
+func (m MaybeUncles) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return &m.v
 	default:
@@ -26241,40 +26348,40 @@ func (m MaybeUncles) Must() Uncles {
 	return &m.v
 }
 
-var _ ipld.Node = (Uncles)(&_Uncles{})
+var _ datamodel.Node = (Uncles)(&_Uncles{})
 var _ schema.TypedNode = (Uncles)(&_Uncles{})
 
-func (Uncles) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (Uncles) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (Uncles) LookupByString(string) (ipld.Node, error) {
+func (Uncles) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Uncles"}.LookupByString("")
 }
-func (n Uncles) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (n Uncles) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	idx, err := k.AsInt()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByIndex(idx)
 }
-func (n Uncles) LookupByIndex(idx int64) (ipld.Node, error) {
+func (n Uncles) LookupByIndex(idx int64) (datamodel.Node, error) {
 	if n.Length() <= idx {
-		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+		return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfInt(idx)}
 	}
 	v := &n.x[idx]
 	return v, nil
 }
-func (n Uncles) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Uncles) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Uncles", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Uncles", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (Uncles) MapIterator() ipld.MapIterator {
+func (Uncles) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (n Uncles) ListIterator() ipld.ListIterator {
+func (n Uncles) ListIterator() datamodel.ListIterator {
 	return &_Uncles__ListItr{n, 0}
 }
 
@@ -26283,9 +26390,9 @@ type _Uncles__ListItr struct {
 	idx int
 }
 
-func (itr *_Uncles__ListItr) Next() (idx int64, v ipld.Node, _ error) {
+func (itr *_Uncles__ListItr) Next() (idx int64, v datamodel.Node, _ error) {
 	if itr.idx >= len(itr.n.x) {
-		return -1, nil, ipld.ErrIteratorOverread{}
+		return -1, nil, datamodel.ErrIteratorOverread{}
 	}
 	idx = int64(itr.idx)
 	x := &itr.n.x[itr.idx]
@@ -26321,16 +26428,16 @@ func (Uncles) AsString() (string, error) {
 func (Uncles) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Uncles"}.AsBytes()
 }
-func (Uncles) AsLink() (ipld.Link, error) {
+func (Uncles) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Uncles"}.AsLink()
 }
-func (Uncles) Prototype() ipld.NodePrototype {
+func (Uncles) Prototype() datamodel.NodePrototype {
 	return _Uncles__Prototype{}
 }
 
 type _Uncles__Prototype struct{}
 
-func (_Uncles__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Uncles__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Uncles__Builder
 	nb.Reset()
 	return &nb
@@ -26340,7 +26447,7 @@ type _Uncles__Builder struct {
 	_Uncles__Assembler
 }
 
-func (nb *_Uncles__Builder) Build() ipld.Node {
+func (nb *_Uncles__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -26365,10 +26472,10 @@ func (na *_Uncles__Assembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Uncles__Assembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Uncles__Assembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Uncles"}.BeginMap(0)
 }
-func (na *_Uncles__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Uncles__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -26413,10 +26520,10 @@ func (_Uncles__Assembler) AssignString(string) error {
 func (_Uncles__Assembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Uncles"}.AssignBytes(nil)
 }
-func (_Uncles__Assembler) AssignLink(ipld.Link) error {
+func (_Uncles__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Uncles"}.AssignLink(nil)
 }
-func (na *_Uncles__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Uncles__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -26431,8 +26538,8 @@ func (na *_Uncles__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Uncles", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Uncles", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -26446,7 +26553,7 @@ func (na *_Uncles__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Uncles__Assembler) Prototype() ipld.NodePrototype {
+func (_Uncles__Assembler) Prototype() datamodel.NodePrototype {
 	return _Uncles__Prototype{}
 }
 func (la *_Uncles__Assembler) valueFinishTidy() bool {
@@ -26461,7 +26568,7 @@ func (la *_Uncles__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Uncles__Assembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Uncles__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -26494,59 +26601,59 @@ func (la *_Uncles__Assembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Uncles__Assembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Uncles__Assembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Header__Prototype{}
 }
 func (Uncles) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Uncles) Representation() ipld.Node {
+func (n Uncles) Representation() datamodel.Node {
 	return (*_Uncles__Repr)(n)
 }
 
 type _Uncles__Repr _Uncles
 
-var _ ipld.Node = &_Uncles__Repr{}
+var _ datamodel.Node = &_Uncles__Repr{}
 
-func (_Uncles__Repr) Kind() ipld.Kind {
-	return ipld.Kind_List
+func (_Uncles__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_List
 }
-func (_Uncles__Repr) LookupByString(string) (ipld.Node, error) {
+func (_Uncles__Repr) LookupByString(string) (datamodel.Node, error) {
 	return mixins.List{TypeName: "dageth.Uncles.Repr"}.LookupByString("")
 }
-func (nr *_Uncles__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+func (nr *_Uncles__Repr) LookupByNode(k datamodel.Node) (datamodel.Node, error) {
 	v, err := (Uncles)(nr).LookupByNode(k)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Header).Representation(), nil
 }
-func (nr *_Uncles__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (nr *_Uncles__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	v, err := (Uncles)(nr).LookupByIndex(idx)
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return v, err
 	}
 	return v.(Header).Representation(), nil
 }
-func (n _Uncles__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Uncles__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	i, err := seg.Index()
 	if err != nil {
-		return nil, ipld.ErrInvalidSegmentForList{TypeName: "dageth.Uncles.Repr", TroubleSegment: seg, Reason: err}
+		return nil, datamodel.ErrInvalidSegmentForList{TypeName: "dageth.Uncles.Repr", TroubleSegment: seg, Reason: err}
 	}
 	return n.LookupByIndex(i)
 }
-func (_Uncles__Repr) MapIterator() ipld.MapIterator {
+func (_Uncles__Repr) MapIterator() datamodel.MapIterator {
 	return nil
 }
-func (nr *_Uncles__Repr) ListIterator() ipld.ListIterator {
+func (nr *_Uncles__Repr) ListIterator() datamodel.ListIterator {
 	return &_Uncles__ReprListItr{(Uncles)(nr), 0}
 }
 
 type _Uncles__ReprListItr _Uncles__ListItr
 
-func (itr *_Uncles__ReprListItr) Next() (idx int64, v ipld.Node, err error) {
+func (itr *_Uncles__ReprListItr) Next() (idx int64, v datamodel.Node, err error) {
 	idx, v, err = (*_Uncles__ListItr)(itr).Next()
-	if err != nil || v == ipld.Null {
+	if err != nil || v == datamodel.Null {
 		return
 	}
 	return idx, v.(Header).Representation(), nil
@@ -26579,16 +26686,16 @@ func (_Uncles__Repr) AsString() (string, error) {
 func (_Uncles__Repr) AsBytes() ([]byte, error) {
 	return mixins.List{TypeName: "dageth.Uncles.Repr"}.AsBytes()
 }
-func (_Uncles__Repr) AsLink() (ipld.Link, error) {
+func (_Uncles__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.List{TypeName: "dageth.Uncles.Repr"}.AsLink()
 }
-func (_Uncles__Repr) Prototype() ipld.NodePrototype {
+func (_Uncles__Repr) Prototype() datamodel.NodePrototype {
 	return _Uncles__ReprPrototype{}
 }
 
 type _Uncles__ReprPrototype struct{}
 
-func (_Uncles__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Uncles__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Uncles__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -26598,7 +26705,7 @@ type _Uncles__ReprBuilder struct {
 	_Uncles__ReprAssembler
 }
 
-func (nb *_Uncles__ReprBuilder) Build() ipld.Node {
+func (nb *_Uncles__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -26623,10 +26730,10 @@ func (na *_Uncles__ReprAssembler) reset() {
 	na.state = laState_initial
 	na.va.reset()
 }
-func (_Uncles__ReprAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Uncles__ReprAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.ListAssembler{TypeName: "dageth.Uncles.Repr"}.BeginMap(0)
 }
-func (na *_Uncles__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (na *_Uncles__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -26671,10 +26778,10 @@ func (_Uncles__ReprAssembler) AssignString(string) error {
 func (_Uncles__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.ListAssembler{TypeName: "dageth.Uncles.Repr"}.AssignBytes(nil)
 }
-func (_Uncles__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Uncles__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.ListAssembler{TypeName: "dageth.Uncles.Repr"}.AssignLink(nil)
 }
-func (na *_Uncles__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Uncles__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -26689,8 +26796,8 @@ func (na *_Uncles__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_List {
-		return ipld.ErrWrongKind{TypeName: "dageth.Uncles.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustList, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_List {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Uncles.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustList, ActualKind: v.Kind()}
 	}
 	itr := v.ListIterator()
 	for !itr.Done() {
@@ -26704,7 +26811,7 @@ func (na *_Uncles__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Uncles__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Uncles__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Uncles__ReprPrototype{}
 }
 func (la *_Uncles__ReprAssembler) valueFinishTidy() bool {
@@ -26719,7 +26826,7 @@ func (la *_Uncles__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (la *_Uncles__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (la *_Uncles__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch la.state {
 	case laState_initial:
 		// carry on
@@ -26752,7 +26859,7 @@ func (la *_Uncles__ReprAssembler) Finish() error {
 	*la.m = schema.Maybe_Value
 	return nil
 }
-func (la *_Uncles__ReprAssembler) ValuePrototype(_ int64) ipld.NodePrototype {
+func (la *_Uncles__ReprAssembler) ValuePrototype(_ int64) datamodel.NodePrototype {
 	return _Header__ReprPrototype{}
 }
 
@@ -26788,12 +26895,12 @@ func (m MaybeValue) IsAbsent() bool {
 func (m MaybeValue) Exists() bool {
 	return m.m == schema.Maybe_Value
 }
-func (m MaybeValue) AsNode() ipld.Node {
+func (m MaybeValue) AsNode() datamodel.Node {
 	switch m.m {
 	case schema.Maybe_Absent:
-		return ipld.Absent
+		return datamodel.Absent
 	case schema.Maybe_Null:
-		return ipld.Null
+		return datamodel.Null
 	case schema.Maybe_Value:
 		return m.v
 	default:
@@ -26814,57 +26921,57 @@ var (
 	memberName__Value_Bytes       = _String{"Bytes"}
 	memberName__Value_Log         = _String{"Log"}
 )
-var _ ipld.Node = (Value)(&_Value{})
+var _ datamodel.Node = (Value)(&_Value{})
 var _ schema.TypedNode = (Value)(&_Value{})
 
-func (Value) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (Value) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n Value) LookupByString(key string) (ipld.Node, error) {
+func (n Value) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "Transaction":
 		if n.tag != 1 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return &n.x1, nil
 	case "Receipt":
 		if n.tag != 2 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return &n.x2, nil
 	case "Account":
 		if n.tag != 3 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return &n.x3, nil
 	case "Bytes":
 		if n.tag != 4 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return &n.x4, nil
 	case "Log":
 		if n.tag != 5 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return &n.x5, nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n Value) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n Value) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (Value) LookupByIndex(idx int64) (ipld.Node, error) {
+func (Value) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Value"}.LookupByIndex(0)
 }
-func (n Value) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n Value) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n Value) MapIterator() ipld.MapIterator {
+func (n Value) MapIterator() datamodel.MapIterator {
 	return &_Value__MapItr{n, false}
 }
 
@@ -26873,9 +26980,9 @@ type _Value__MapItr struct {
 	done bool
 }
 
-func (itr *_Value__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Value__MapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.done {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.n.tag {
 	case 1:
@@ -26898,7 +27005,7 @@ func (itr *_Value__MapItr) Done() bool {
 	return itr.done
 }
 
-func (Value) ListIterator() ipld.ListIterator {
+func (Value) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (Value) Length() int64 {
@@ -26925,16 +27032,16 @@ func (Value) AsString() (string, error) {
 func (Value) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Value"}.AsBytes()
 }
-func (Value) AsLink() (ipld.Link, error) {
+func (Value) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Value"}.AsLink()
 }
-func (Value) Prototype() ipld.NodePrototype {
+func (Value) Prototype() datamodel.NodePrototype {
 	return _Value__Prototype{}
 }
 
 type _Value__Prototype struct{}
 
-func (_Value__Prototype) NewBuilder() ipld.NodeBuilder {
+func (_Value__Prototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Value__Builder
 	nb.Reset()
 	return &nb
@@ -26944,7 +27051,7 @@ type _Value__Builder struct {
 	_Value__Assembler
 }
 
-func (nb *_Value__Builder) Build() ipld.Node {
+func (nb *_Value__Builder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -26999,7 +27106,7 @@ func (na *_Value__Assembler) reset() {
 	na.ca = 0
 	na.cm = schema.Maybe_Absent
 }
-func (na *_Value__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Value__Assembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -27012,7 +27119,7 @@ func (na *_Value__Assembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Value__Assembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Value__Assembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Value"}.BeginList(0)
 }
 func (na *_Value__Assembler) AssignNull() error {
@@ -27044,10 +27151,10 @@ func (_Value__Assembler) AssignString(string) error {
 func (_Value__Assembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Value"}.AssignBytes(nil)
 }
-func (_Value__Assembler) AssignLink(ipld.Link) error {
+func (_Value__Assembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Value"}.AssignLink(nil)
 }
-func (na *_Value__Assembler) AssignNode(v ipld.Node) error {
+func (na *_Value__Assembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -27067,8 +27174,8 @@ func (na *_Value__Assembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Value", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Value", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -27085,7 +27192,7 @@ func (na *_Value__Assembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Value__Assembler) Prototype() ipld.NodePrototype {
+func (_Value__Assembler) Prototype() datamodel.NodePrototype {
 	return _Value__Prototype{}
 }
 func (ma *_Value__Assembler) valueFinishTidy() bool {
@@ -27097,7 +27204,7 @@ func (ma *_Value__Assembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (ma *_Value__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Value__Assembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -27152,9 +27259,9 @@ func (ma *_Value__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error)
 		ma.ca5.m = &ma.cm
 		return &ma.ca5, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Value", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Value", Key: &_String{k}}
 }
-func (ma *_Value__Assembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Value__Assembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -27172,7 +27279,7 @@ func (ma *_Value__Assembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Value__KeyAssembler)(ma)
 }
-func (ma *_Value__Assembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Value__Assembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -27233,10 +27340,10 @@ func (ma *_Value__Assembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Value__Assembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Value__Assembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Value__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Value__Assembler) ValuePrototype(k string) datamodel.NodePrototype {
 	switch k {
 	case "Transaction":
 		return _Transaction__Prototype{}
@@ -27255,10 +27362,10 @@ func (ma *_Value__Assembler) ValuePrototype(k string) ipld.NodePrototype {
 
 type _Value__KeyAssembler _Value__Assembler
 
-func (_Value__KeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Value__KeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Value.KeyAssembler"}.BeginMap(0)
 }
-func (_Value__KeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Value__KeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Value.KeyAssembler"}.BeginList(0)
 }
 func (na *_Value__KeyAssembler) AssignNull() error {
@@ -27307,28 +27414,28 @@ func (ka *_Value__KeyAssembler) AssignString(k string) error {
 		ka.state = maState_expectValue
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Value", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	return schema.ErrInvalidKey{TypeName: "dageth.Value", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
 }
 func (_Value__KeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Value.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Value__KeyAssembler) AssignLink(ipld.Link) error {
+func (_Value__KeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Value.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Value__KeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Value__KeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Value__KeyAssembler) Prototype() ipld.NodePrototype {
+func (_Value__KeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
 func (Value) Type() schema.Type {
 	return nil /*TODO:typelit*/
 }
-func (n Value) Representation() ipld.Node {
+func (n Value) Representation() datamodel.Node {
 	return (*_Value__Repr)(n)
 }
 
@@ -27341,56 +27448,56 @@ var (
 	memberName__Value_Bytes_serial       = _String{"storage"}
 	memberName__Value_Log_serial         = _String{"log"}
 )
-var _ ipld.Node = &_Value__Repr{}
+var _ datamodel.Node = &_Value__Repr{}
 
-func (_Value__Repr) Kind() ipld.Kind {
-	return ipld.Kind_Map
+func (_Value__Repr) Kind() datamodel.Kind {
+	return datamodel.Kind_Map
 }
-func (n *_Value__Repr) LookupByString(key string) (ipld.Node, error) {
+func (n *_Value__Repr) LookupByString(key string) (datamodel.Node, error) {
 	switch key {
 	case "tx":
 		if n.tag != 1 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return n.x1.Representation(), nil
 	case "rct":
 		if n.tag != 2 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return n.x2.Representation(), nil
 	case "state":
 		if n.tag != 3 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return n.x3.Representation(), nil
 	case "storage":
 		if n.tag != 4 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return n.x4.Representation(), nil
 	case "log":
 		if n.tag != 5 {
-			return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			return nil, datamodel.ErrNotExists{Segment: datamodel.PathSegmentOfString(key)}
 		}
 		return n.x5.Representation(), nil
 	default:
-		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: datamodel.PathSegmentOfString(key)}
 	}
 }
-func (n *_Value__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+func (n *_Value__Repr) LookupByNode(key datamodel.Node) (datamodel.Node, error) {
 	ks, err := key.AsString()
 	if err != nil {
 		return nil, err
 	}
 	return n.LookupByString(ks)
 }
-func (_Value__Repr) LookupByIndex(idx int64) (ipld.Node, error) {
+func (_Value__Repr) LookupByIndex(idx int64) (datamodel.Node, error) {
 	return mixins.Map{TypeName: "dageth.Value.Repr"}.LookupByIndex(0)
 }
-func (n _Value__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+func (n _Value__Repr) LookupBySegment(seg datamodel.PathSegment) (datamodel.Node, error) {
 	return n.LookupByString(seg.String())
 }
-func (n *_Value__Repr) MapIterator() ipld.MapIterator {
+func (n *_Value__Repr) MapIterator() datamodel.MapIterator {
 	return &_Value__ReprMapItr{n, false}
 }
 
@@ -27399,9 +27506,9 @@ type _Value__ReprMapItr struct {
 	done bool
 }
 
-func (itr *_Value__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+func (itr *_Value__ReprMapItr) Next() (k datamodel.Node, v datamodel.Node, _ error) {
 	if itr.done {
-		return nil, nil, ipld.ErrIteratorOverread{}
+		return nil, nil, datamodel.ErrIteratorOverread{}
 	}
 	switch itr.n.tag {
 	case 1:
@@ -27424,7 +27531,7 @@ func (itr *_Value__ReprMapItr) Done() bool {
 	return itr.done
 }
 
-func (_Value__Repr) ListIterator() ipld.ListIterator {
+func (_Value__Repr) ListIterator() datamodel.ListIterator {
 	return nil
 }
 func (_Value__Repr) Length() int64 {
@@ -27451,16 +27558,16 @@ func (_Value__Repr) AsString() (string, error) {
 func (_Value__Repr) AsBytes() ([]byte, error) {
 	return mixins.Map{TypeName: "dageth.Value.Repr"}.AsBytes()
 }
-func (_Value__Repr) AsLink() (ipld.Link, error) {
+func (_Value__Repr) AsLink() (datamodel.Link, error) {
 	return mixins.Map{TypeName: "dageth.Value.Repr"}.AsLink()
 }
-func (_Value__Repr) Prototype() ipld.NodePrototype {
+func (_Value__Repr) Prototype() datamodel.NodePrototype {
 	return _Value__ReprPrototype{}
 }
 
 type _Value__ReprPrototype struct{}
 
-func (_Value__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+func (_Value__ReprPrototype) NewBuilder() datamodel.NodeBuilder {
 	var nb _Value__ReprBuilder
 	nb.Reset()
 	return &nb
@@ -27470,7 +27577,7 @@ type _Value__ReprBuilder struct {
 	_Value__ReprAssembler
 }
 
-func (nb *_Value__ReprBuilder) Build() ipld.Node {
+func (nb *_Value__ReprBuilder) Build() datamodel.Node {
 	if *nb.m != schema.Maybe_Value {
 		panic("invalid state: cannot call Build on an assembler that's not finished")
 	}
@@ -27525,7 +27632,7 @@ func (na *_Value__ReprAssembler) reset() {
 	na.ca = 0
 	na.cm = schema.Maybe_Absent
 }
-func (na *_Value__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
+func (na *_Value__ReprAssembler) BeginMap(int64) (datamodel.MapAssembler, error) {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -27538,7 +27645,7 @@ func (na *_Value__ReprAssembler) BeginMap(int64) (ipld.MapAssembler, error) {
 	}
 	return na, nil
 }
-func (_Value__ReprAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Value__ReprAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.MapAssembler{TypeName: "dageth.Value.Repr"}.BeginList(0)
 }
 func (na *_Value__ReprAssembler) AssignNull() error {
@@ -27570,10 +27677,10 @@ func (_Value__ReprAssembler) AssignString(string) error {
 func (_Value__ReprAssembler) AssignBytes([]byte) error {
 	return mixins.MapAssembler{TypeName: "dageth.Value.Repr"}.AssignBytes(nil)
 }
-func (_Value__ReprAssembler) AssignLink(ipld.Link) error {
+func (_Value__ReprAssembler) AssignLink(datamodel.Link) error {
 	return mixins.MapAssembler{TypeName: "dageth.Value.Repr"}.AssignLink(nil)
 }
-func (na *_Value__ReprAssembler) AssignNode(v ipld.Node) error {
+func (na *_Value__ReprAssembler) AssignNode(v datamodel.Node) error {
 	if v.IsNull() {
 		return na.AssignNull()
 	}
@@ -27593,8 +27700,8 @@ func (na *_Value__ReprAssembler) AssignNode(v ipld.Node) error {
 		*na.m = schema.Maybe_Value
 		return nil
 	}
-	if v.Kind() != ipld.Kind_Map {
-		return ipld.ErrWrongKind{TypeName: "dageth.Value.Repr", MethodName: "AssignNode", AppropriateKind: ipld.KindSet_JustMap, ActualKind: v.Kind()}
+	if v.Kind() != datamodel.Kind_Map {
+		return datamodel.ErrWrongKind{TypeName: "dageth.Value.Repr", MethodName: "AssignNode", AppropriateKind: datamodel.KindSet_JustMap, ActualKind: v.Kind()}
 	}
 	itr := v.MapIterator()
 	for !itr.Done() {
@@ -27611,7 +27718,7 @@ func (na *_Value__ReprAssembler) AssignNode(v ipld.Node) error {
 	}
 	return na.Finish()
 }
-func (_Value__ReprAssembler) Prototype() ipld.NodePrototype {
+func (_Value__ReprAssembler) Prototype() datamodel.NodePrototype {
 	return _Value__ReprPrototype{}
 }
 func (ma *_Value__ReprAssembler) valueFinishTidy() bool {
@@ -27623,7 +27730,7 @@ func (ma *_Value__ReprAssembler) valueFinishTidy() bool {
 		return false
 	}
 }
-func (ma *_Value__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+func (ma *_Value__ReprAssembler) AssembleEntry(k string) (datamodel.NodeAssembler, error) {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -27678,9 +27785,9 @@ func (ma *_Value__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, er
 		ma.ca5.m = &ma.cm
 		return &ma.ca5, nil
 	}
-	return nil, ipld.ErrInvalidKey{TypeName: "dageth.Value.Repr", Key: &_String{k}}
+	return nil, schema.ErrInvalidKey{TypeName: "dageth.Value.Repr", Key: &_String{k}}
 }
-func (ma *_Value__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+func (ma *_Value__ReprAssembler) AssembleKey() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		// carry on
@@ -27698,7 +27805,7 @@ func (ma *_Value__ReprAssembler) AssembleKey() ipld.NodeAssembler {
 	ma.state = maState_midKey
 	return (*_Value__ReprKeyAssembler)(ma)
 }
-func (ma *_Value__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+func (ma *_Value__ReprAssembler) AssembleValue() datamodel.NodeAssembler {
 	switch ma.state {
 	case maState_initial:
 		panic("invalid state: AssembleValue cannot be called when no key is primed")
@@ -27713,23 +27820,23 @@ func (ma *_Value__ReprAssembler) AssembleValue() ipld.NodeAssembler {
 	}
 	ma.state = maState_midValue
 	switch ma.ca {
-	case 0:
+	case 1:
 		ma.ca1.w = &ma.w.x1
 		ma.ca1.m = &ma.cm
 		return &ma.ca1
-	case 1:
+	case 2:
 		ma.ca2.w = &ma.w.x2
 		ma.ca2.m = &ma.cm
 		return &ma.ca2
-	case 2:
+	case 3:
 		ma.ca3.w = &ma.w.x3
 		ma.ca3.m = &ma.cm
 		return &ma.ca3
-	case 3:
+	case 4:
 		ma.ca4.w = &ma.w.x4
 		ma.ca4.m = &ma.cm
 		return &ma.ca4
-	case 4:
+	case 5:
 		ma.ca5.w = &ma.w.x5
 		ma.ca5.m = &ma.cm
 		return &ma.ca5
@@ -27759,10 +27866,10 @@ func (ma *_Value__ReprAssembler) Finish() error {
 	*ma.m = schema.Maybe_Value
 	return nil
 }
-func (ma *_Value__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+func (ma *_Value__ReprAssembler) KeyPrototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
-func (ma *_Value__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+func (ma *_Value__ReprAssembler) ValuePrototype(k string) datamodel.NodePrototype {
 	switch k {
 	case "Transaction":
 		return _Transaction__ReprPrototype{}
@@ -27781,10 +27888,10 @@ func (ma *_Value__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
 
 type _Value__ReprKeyAssembler _Value__ReprAssembler
 
-func (_Value__ReprKeyAssembler) BeginMap(sizeHint int64) (ipld.MapAssembler, error) {
+func (_Value__ReprKeyAssembler) BeginMap(sizeHint int64) (datamodel.MapAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Value.Repr.KeyAssembler"}.BeginMap(0)
 }
-func (_Value__ReprKeyAssembler) BeginList(sizeHint int64) (ipld.ListAssembler, error) {
+func (_Value__ReprKeyAssembler) BeginList(sizeHint int64) (datamodel.ListAssembler, error) {
 	return mixins.StringAssembler{TypeName: "dageth.Value.Repr.KeyAssembler"}.BeginList(0)
 }
 func (na *_Value__ReprKeyAssembler) AssignNull() error {
@@ -27833,21 +27940,21 @@ func (ka *_Value__ReprKeyAssembler) AssignString(k string) error {
 		ka.state = maState_expectValue
 		return nil
 	}
-	return ipld.ErrInvalidKey{TypeName: "dageth.Value.Repr", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	return schema.ErrInvalidKey{TypeName: "dageth.Value.Repr", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
 }
 func (_Value__ReprKeyAssembler) AssignBytes([]byte) error {
 	return mixins.StringAssembler{TypeName: "dageth.Value.Repr.KeyAssembler"}.AssignBytes(nil)
 }
-func (_Value__ReprKeyAssembler) AssignLink(ipld.Link) error {
+func (_Value__ReprKeyAssembler) AssignLink(datamodel.Link) error {
 	return mixins.StringAssembler{TypeName: "dageth.Value.Repr.KeyAssembler"}.AssignLink(nil)
 }
-func (ka *_Value__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+func (ka *_Value__ReprKeyAssembler) AssignNode(v datamodel.Node) error {
 	if v2, err := v.AsString(); err != nil {
 		return err
 	} else {
 		return ka.AssignString(v2)
 	}
 }
-func (_Value__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+func (_Value__ReprKeyAssembler) Prototype() datamodel.NodePrototype {
 	return _String__Prototype{}
 }
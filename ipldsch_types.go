@@ -3,10 +3,10 @@ package dageth
 // Code generated by go-ipld-prime gengo.  DO NOT EDIT.
 
 import (
-	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/datamodel"
 )
 
-var _ ipld.Node = nil // suppress errors when this dependency is not referenced
+var _ datamodel.Node = nil // suppress errors when this dependency is not referenced
 // Type is a struct embeding a NodePrototype/Type for every Node implementation in this package.
 // One of its major uses is to start the construction of a value.
 // You can use it like this:
@@ -99,7 +99,7 @@ type typeSlab struct {
 
 // --- type definitions follow ---
 
-// AccessElement matches the IPLD Schema type "AccessElement".  It has Struct type-kind, and may be interrogated like map kind.
+// AccessElement matches the IPLD Schema type "AccessElement".  It has struct type-kind, and may be interrogated like map kind.
 type AccessElement = *_AccessElement
 type _AccessElement struct {
 	Address     _Address
@@ -112,7 +112,7 @@ type _AccessList struct {
 	x []_AccessElement
 }
 
-// Account matches the IPLD Schema type "Account".  It has Struct type-kind, and may be interrogated like map kind.
+// Account matches the IPLD Schema type "Account".  It has struct type-kind, and may be interrogated like map kind.
 type Account = *_Account
 type _Account struct {
 	Nonce          _Uint
@@ -133,12 +133,12 @@ type _Balance struct{ x []byte }
 type BigInt = *_BigInt
 type _BigInt struct{ x []byte }
 
-// Block matches the IPLD Schema type "Block".  It has Struct type-kind, and may be interrogated like map kind.
+// Block matches the IPLD Schema type "Block".  It has struct type-kind, and may be interrogated like map kind.
 type Block = *_Block
 type _Block struct {
-	Header       _Link
-	Transactions _Link
-	Receipts     _Link
+	HeaderCID       _Link
+	TransactionsCID _Link
+	ReceiptsCID     _Link
 }
 
 // Bloom matches the IPLD Schema type "Bloom".  It has bytes kind.
@@ -158,12 +158,10 @@ type Bytes = *_Bytes
 type _Bytes struct{ x []byte }
 
 // Child matches the IPLD Schema type "Child".
-// Child has Union typekind, which means its data model behaviors are that of a map kind.
+// Child has union typekind, which means its data model behaviors are that of a map kind.
 type Child = *_Child
 type _Child struct {
-	tag uint
-	x1  _Link
-	x2  _TrieNode
+	x _Child__iface
 }
 type _Child__iface interface {
 	_Child__member()
@@ -172,7 +170,7 @@ type _Child__iface interface {
 func (_Link) _Child__member()     {}
 func (_TrieNode) _Child__member() {}
 
-// Frame matches the IPLD Schema type "Frame".  It has Struct type-kind, and may be interrogated like map kind.
+// Frame matches the IPLD Schema type "Frame".  It has struct type-kind, and may be interrogated like map kind.
 type Frame = *_Frame
 type _Frame struct {
 	Op     _OpCode
@@ -195,7 +193,7 @@ type _FrameList struct {
 type Hash = *_Hash
 type _Hash struct{ x []byte }
 
-// Header matches the IPLD Schema type "Header".  It has Struct type-kind, and may be interrogated like map kind.
+// Header matches the IPLD Schema type "Header".  It has struct type-kind, and may be interrogated like map kind.
 type Header = *_Header
 type _Header struct {
 	ParentCID    _Link
@@ -218,9 +216,9 @@ type _Header struct {
 
 // Link matches the IPLD Schema type "Link".  It has link kind.
 type Link = *_Link
-type _Link struct{ x ipld.Link }
+type _Link struct{ x datamodel.Link }
 
-// Log matches the IPLD Schema type "Log".  It has Struct type-kind, and may be interrogated like map kind.
+// Log matches the IPLD Schema type "Log".  It has struct type-kind, and may be interrogated like map kind.
 type Log = *_Log
 type _Log struct {
 	Address _Address
@@ -238,7 +236,7 @@ type _Logs struct {
 type OpCode = *_OpCode
 type _OpCode struct{ x []byte }
 
-// Receipt matches the IPLD Schema type "Receipt".  It has Struct type-kind, and may be interrogated like map kind.
+// Receipt matches the IPLD Schema type "Receipt".  It has struct type-kind, and may be interrogated like map kind.
 type Receipt = *_Receipt
 type _Receipt struct {
 	TxType            _TxType
@@ -247,7 +245,7 @@ type _Receipt struct {
 	CumulativeGasUsed _Uint
 	Bloom             _Bloom
 	Logs              _Logs
-	LogRootCID        _Link
+	LogRootCID        _Link__Maybe
 }
 
 // Receipts matches the IPLD Schema type "Receipts".  It has list kind.
@@ -276,7 +274,7 @@ type _Topics struct {
 	x []_Hash
 }
 
-// Transaction matches the IPLD Schema type "Transaction".  It has Struct type-kind, and may be interrogated like map kind.
+// Transaction matches the IPLD Schema type "Transaction".  It has struct type-kind, and may be interrogated like map kind.
 type Transaction = *_Transaction
 type _Transaction struct {
 	TxType       _TxType
@@ -301,7 +299,7 @@ type _Transactions struct {
 	x []_Transaction
 }
 
-// TrieBranchNode matches the IPLD Schema type "TrieBranchNode".  It has Struct type-kind, and may be interrogated like map kind.
+// TrieBranchNode matches the IPLD Schema type "TrieBranchNode".  It has struct type-kind, and may be interrogated like map kind.
 type TrieBranchNode = *_TrieBranchNode
 type _TrieBranchNode struct {
 	Child0 _Child__Maybe
@@ -323,14 +321,14 @@ type _TrieBranchNode struct {
 	Value  _Value__Maybe
 }
 
-// TrieExtensionNode matches the IPLD Schema type "TrieExtensionNode".  It has Struct type-kind, and may be interrogated like map kind.
+// TrieExtensionNode matches the IPLD Schema type "TrieExtensionNode".  It has struct type-kind, and may be interrogated like map kind.
 type TrieExtensionNode = *_TrieExtensionNode
 type _TrieExtensionNode struct {
 	PartialPath _Bytes
-	Child       _Link
+	Child       _Child
 }
 
-// TrieLeafNode matches the IPLD Schema type "TrieLeafNode".  It has Struct type-kind, and may be interrogated like map kind.
+// TrieLeafNode matches the IPLD Schema type "TrieLeafNode".  It has struct type-kind, and may be interrogated like map kind.
 type TrieLeafNode = *_TrieLeafNode
 type _TrieLeafNode struct {
 	PartialPath _Bytes
@@ -338,7 +336,7 @@ type _TrieLeafNode struct {
 }
 
 // TrieNode matches the IPLD Schema type "TrieNode".
-// TrieNode has Union typekind, which means its data model behaviors are that of a map kind.
+// TrieNode has union typekind, which means its data model behaviors are that of a map kind.
 type TrieNode = *_TrieNode
 type _TrieNode struct {
 	tag uint
@@ -360,7 +358,7 @@ type _TxCIDList struct {
 	x []_Link
 }
 
-// TxTrace matches the IPLD Schema type "TxTrace".  It has Struct type-kind, and may be interrogated like map kind.
+// TxTrace matches the IPLD Schema type "TxTrace".  It has struct type-kind, and may be interrogated like map kind.
 type TxTrace = *_TxTrace
 type _TxTrace struct {
 	TxCIDs       _TxCIDList
@@ -386,7 +384,7 @@ type _Uncles struct {
 }
 
 // Value matches the IPLD Schema type "Value".
-// Value has Union typekind, which means its data model behaviors are that of a map kind.
+// Value has union typekind, which means its data model behaviors are that of a map kind.
 type Value = *_Value
 type _Value struct {
 	tag uint
@@ -0,0 +1,118 @@
+package dageth
+
+import (
+	"fmt"
+	"hash"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/raw"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/multicodec"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/storage"
+	"github.com/multiformats/go-multihash"
+)
+
+// NewLinkSystem returns an ipld.LinkSystem preconfigured for DAG-ETH, reading from and writing to the storage.
+// It encodes and decodes with every codec of the dageth.Codecs table, and the raw codec for contract code, and refuses
+// to hash with anything but KECCAK_256. Like RegisterAll, it errors if any codec package has not been imported.
+// The Store helpers of this package use the CID prefix of the DAG-ETH type they store, so storing a node with them
+// always produces the CID of its canonical Ethereum hash.
+// An ipld.LinkSystem has no node prototype chooser, so its Load method needs the prototype of the link target:
+// LoadNode loads link targets as their dageth.Type with NodePrototypeChooser, which traversals of the graph should
+// set as the LinkTargetNodePrototypeChooser of their traversal.Config.
+func NewLinkSystem(store interface {
+	storage.ReadableStorage
+	storage.WritableStorage
+}) (ipld.LinkSystem, error) {
+	var reg multicodec.Registry
+	if err := RegisterAll(&reg); err != nil {
+		return ipld.LinkSystem{}, err
+	}
+	reg.RegisterEncoder(cid.Raw, raw.Encode)
+	reg.RegisterDecoder(cid.Raw, raw.Decode)
+	lsys := cidlink.LinkSystemUsingMulticodecRegistry(reg)
+	chooseHasher := lsys.HasherChooser
+	lsys.HasherChooser = func(lp ipld.LinkPrototype) (hash.Hash, error) {
+		if clp, ok := lp.(cidlink.LinkPrototype); !ok || clp.MhType != multihash.KECCAK_256 {
+			return nil, fmt.Errorf("DAG-ETH links need to be CIDs with a KECCAK_256 multihash; got %v", lp)
+		}
+		return chooseHasher(lp)
+	}
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	return lsys, nil
+}
+
+// LinkPrototype returns the link prototype of the multicodec type: a CIDv1 with a KECCAK_256 multihash
+func LinkPrototype(codec uint64) ipld.LinkPrototype {
+	return cidlink.LinkPrototype{Prefix: cid.Prefix{
+		Version:  1,
+		Codec:    codec,
+		MhType:   multihash.KECCAK_256,
+		MhLength: -1,
+	}}
+}
+
+// StoreNode encodes the node with the multicodec type, writes it to storage and returns its CID
+func StoreNode(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, codec uint64, node ipld.Node) (cid.Cid, error) {
+	lnk, err := lsys.Store(lnkCtx, LinkPrototype(codec), node)
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	return lnk.(cidlink.Link).Cid, nil
+}
+
+// StoreHeader stores a Header node and returns its eth-block CID
+func StoreHeader(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, node ipld.Node) (cid.Cid, error) {
+	return StoreNode(lnkCtx, lsys, cid.EthBlock, node)
+}
+
+// StoreUncles stores an Uncles node and returns its eth-block-list CID
+func StoreUncles(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, node ipld.Node) (cid.Cid, error) {
+	return StoreNode(lnkCtx, lsys, cid.EthBlockList, node)
+}
+
+// StoreTransaction stores a Transaction node and returns its eth-tx CID
+func StoreTransaction(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, node ipld.Node) (cid.Cid, error) {
+	return StoreNode(lnkCtx, lsys, cid.EthTx, node)
+}
+
+// StoreReceipt stores a Receipt node and returns its eth-tx-receipt CID
+func StoreReceipt(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, node ipld.Node) (cid.Cid, error) {
+	return StoreNode(lnkCtx, lsys, cid.EthTxReceipt, node)
+}
+
+// StoreAccount stores an Account node and returns its eth-account-snapshot CID
+func StoreAccount(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, node ipld.Node) (cid.Cid, error) {
+	return StoreNode(lnkCtx, lsys, cid.EthAccountSnapshot, node)
+}
+
+// StoreLog stores a Log node and returns its eth-receipt-log CID
+func StoreLog(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, node ipld.Node) (cid.Cid, error) {
+	return StoreNode(lnkCtx, lsys, EthLog, node)
+}
+
+// StoreTrieNode stores a TrieNode node as a node of the trie of the multicodec type (e.g. cid.EthStateTrie)
+// and returns its CID
+func StoreTrieNode(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, codec uint64, node ipld.Node) (cid.Cid, error) {
+	if c, ok := LookupCodec(codec); !ok || c.TypeName != "TrieNode" {
+		return cid.Cid{}, fmt.Errorf("%s is not a trie multicodec type", codecName(codec))
+	}
+	return StoreNode(lnkCtx, lsys, codec, node)
+}
+
+// StoreCode stores contract code and returns its raw CID, which is referenced by Account.CodeCID
+func StoreCode(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, code []byte) (cid.Cid, error) {
+	return StoreNode(lnkCtx, lsys, cid.Raw, basicnode.NewBytes(code))
+}
+
+// LoadNode loads the target of the link as the dageth.Type prototype chosen by NodePrototypeChooser
+func LoadNode(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, lnk ipld.Link) (ipld.Node, error) {
+	proto, err := NodePrototypeChooser(lnk, lnkCtx)
+	if err != nil {
+		return nil, err
+	}
+	return lsys.Load(lnkCtx, lnk, proto)
+}
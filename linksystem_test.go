@@ -0,0 +1,88 @@
+package dageth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/header"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestLinkSystem(t *testing.T) {
+	lsys, err := dageth.NewLinkSystem(&memstore.Store{})
+	if err != nil {
+		t.Fatalf("unable to create link system: %v", err)
+	}
+
+	h := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(1), BaseFee: big.NewInt(7)}
+	headerNode := dageth.Type.Header.NewBuilder()
+	if err := header.DecodeHeader(headerNode, *h); err != nil {
+		t.Fatalf("unable to decode header: %v", err)
+	}
+	headerCID, err := dageth.StoreHeader(ipld.LinkContext{}, lsys, headerNode.Build())
+	if err != nil {
+		t.Fatalf("unable to store header: %v", err)
+	}
+	if expected := shared.Keccak256ToCid(cid.EthBlock, h.Hash().Bytes()); headerCID != expected {
+		t.Errorf("header CID %s does not match its hash CID %s", headerCID, expected)
+	}
+	loaded, err := dageth.LoadNode(ipld.LinkContext{}, lsys, cidlink.Link{Cid: headerCID})
+	if err != nil {
+		t.Fatalf("unable to load header: %v", err)
+	}
+	if _, ok := loaded.(dageth.Header); !ok {
+		t.Errorf("loaded header is a %T; expected a dageth.Header", loaded)
+	}
+	if !ipld.DeepEqual(loaded, headerNode.Build()) {
+		t.Errorf("loaded header does not match stored header")
+	}
+
+	branch := make([]interface{}, 17)
+	for i := range branch {
+		branch[i] = []byte{}
+	}
+	branch[3] = crypto.Keccak256([]byte{3})
+	branchRLP, err := rlp.EncodeToBytes(branch)
+	if err != nil {
+		t.Fatalf("unable to RLP encode branch: %v", err)
+	}
+	branchNode := dageth.Type.TrieNode.NewBuilder()
+	if err := trie.DecodeTrieNodeBytes(branchNode, branchRLP, cid.EthStateTrie); err != nil {
+		t.Fatalf("unable to decode branch: %v", err)
+	}
+	branchCID, err := dageth.StoreTrieNode(ipld.LinkContext{}, lsys, cid.EthStateTrie, branchNode.Build())
+	if err != nil {
+		t.Fatalf("unable to store branch: %v", err)
+	}
+	if expected := shared.Keccak256ToCid(cid.EthStateTrie, crypto.Keccak256(branchRLP)); branchCID != expected {
+		t.Errorf("branch CID %s does not match its hash CID %s", branchCID, expected)
+	}
+	if _, err := dageth.StoreTrieNode(ipld.LinkContext{}, lsys, cid.EthBlock, branchNode.Build()); err == nil {
+		t.Errorf("expected an error storing a trie node as a header")
+	}
+
+	code := []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+	codeCID, err := dageth.StoreCode(ipld.LinkContext{}, lsys, code)
+	if err != nil {
+		t.Fatalf("unable to store code: %v", err)
+	}
+	if expected, _ := shared.RawToCid(cid.Raw, code); codeCID != expected {
+		t.Errorf("code CID %s does not match its hash CID %s", codeCID, expected)
+	}
+
+	sha2 := cidlink.LinkPrototype{Prefix: cid.Prefix{Version: 1, Codec: cid.EthBlock, MhType: multihash.SHA2_256, MhLength: -1}}
+	if _, err := lsys.Store(ipld.LinkContext{}, sha2, headerNode.Build()); err == nil {
+		t.Errorf("expected an error storing a header with a sha2-256 CID")
+	}
+}
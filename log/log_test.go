@@ -11,6 +11,7 @@ import (
 
 	dageth "github.com/vulcanize/go-codec-dageth"
 	"github.com/vulcanize/go-codec-dageth/log"
+	"github.com/vulcanize/go-codec-dageth/shared/fuzztest"
 )
 
 var (
@@ -109,3 +110,14 @@ func testLogEncoding(t *testing.T) {
 		t.Errorf("log encoding (%x) does not match the expected consensus encoding (%x)", logBytes, logEncoding)
 	}
 }
+
+func FuzzDecodeBytes(f *testing.F) {
+	f.Add(logEncoding)
+	codec, ok := dageth.LookupCodec(log.MultiCodecType)
+	if !ok {
+		f.Fatal("codec is not in the dageth.Codecs table")
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzztest.FuzzRoundTrip(t, codec, data)
+	})
+}
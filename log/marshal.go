@@ -1,7 +1,6 @@
 package log
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -39,77 +38,54 @@ func AppendEncode(enc []byte, inNode ipld.Node) ([]byte, error) {
 	}
 	wbs := shared.NewWriteableByteSlice(&enc)
 	if err := rlp.Encode(wbs, log); err != nil {
-		return nil, err
+		return nil, dageth.ErrInvalidForm{Type: "Log", Err: err}
 	}
 	return enc, nil
 }
 
 // EncodeLog packs the node into the go-ethereum Log
 func EncodeLog(log *types.Log, inNode ipld.Node) error {
-	// Wrap in a typed node for some basic schema form checking
-	builder := dageth.Type.Log.NewBuilder()
-	if err := builder.AssignNode(inNode); err != nil {
-		return err
+	// Wrap in a typed node for some basic schema form checking, unless it is one already
+	node, ok := inNode.(dageth.Log)
+	if !ok {
+		builder := dageth.Type.Log.NewBuilder()
+		if err := builder.AssignNode(inNode); err != nil {
+			return dageth.ErrInvalidForm{Type: "Log", Err: err}
+		}
+		node = builder.Build().(dageth.Log)
 	}
-	node := builder.Build()
 	for _, pFunc := range requiredPackFuncs {
 		if err := pFunc(log, node); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Log form (%v)", err)
+			return dageth.ErrInvalidForm{Type: "Log", Err: err}
 		}
 	}
 	return nil
 }
 
-var requiredPackFuncs = []func(*types.Log, ipld.Node) error{
+var requiredPackFuncs = []func(*types.Log, dageth.Log) error{
 	packAddress,
 	packTopics,
 	packData,
 }
 
-func packAddress(log *types.Log, node ipld.Node) error {
-	addrNode, err := node.LookupByString("Address")
-	if err != nil {
-		return fmt.Errorf("receipt log is missing an Address node: %v", err)
-	}
-	addrBytes, err := addrNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	log.Address = common.BytesToAddress(addrBytes)
+func packAddress(log *types.Log, node dageth.Log) error {
+	log.Address = common.BytesToAddress(node.FieldAddress().Bytes())
 	return nil
 }
 
-func packTopics(log *types.Log, node ipld.Node) error {
-	topicsNode, err := node.LookupByString("Topics")
-	if err != nil {
-		return fmt.Errorf("receipt log is missing a Topics node: %v", err)
-	}
+func packTopics(log *types.Log, node dageth.Log) error {
+	topicsNode := node.FieldTopics()
 	topics := make([]common.Hash, topicsNode.Length())
-	topicsIt := topicsNode.ListIterator()
+	topicsIt := topicsNode.Iterator()
 	for !topicsIt.Done() {
-		topicIndex, topicNode, err := topicsIt.Next()
-		if err != nil {
-			return err
-		}
-		topicBytes, err := topicNode.AsBytes()
-		if err != nil {
-			return err
-		}
-		topics[topicIndex] = common.BytesToHash(topicBytes)
+		topicIndex, topicNode := topicsIt.Next()
+		topics[topicIndex] = common.BytesToHash(topicNode.Bytes())
 	}
 	log.Topics = topics
 	return nil
 }
 
-func packData(log *types.Log, node ipld.Node) error {
-	dataNode, err := node.LookupByString("Data")
-	if err != nil {
-		return fmt.Errorf("receipt log is missing a Data node: %v", err)
-	}
-	data, err := dataNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	log.Data = data
+func packData(log *types.Log, node dageth.Log) error {
+	log.Data = node.FieldData().Bytes()
 	return nil
 }
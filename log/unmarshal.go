@@ -1,41 +1,86 @@
 package log
 
 import (
-	"fmt"
+	"bytes"
 	"io"
-	"io/ioutil"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ipld/go-ipld-prime"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
 )
 
 // Decode provides an IPLD codec decode interface for eth log IPLDs.
 // This function is registered via the go-ipld-prime link loader for multicodec
 // code 0x9a when this package is invoked via init.
 func Decode(na ipld.NodeAssembler, in io.Reader) error {
-	var src []byte
 	if buf, ok := in.(interface{ Bytes() []byte }); ok {
-		src = buf.Bytes()
-	} else {
-		var err error
-		src, err = ioutil.ReadAll(in)
-		if err != nil {
-			return err
-		}
+		return DecodeBytes(na, buf.Bytes())
+	}
+	trailing, err := shared.StreamDecode(in, func(s *rlp.Stream) error {
+		return decodeLogStream(na, s)
+	})
+	if err != nil {
+		return dageth.ErrInvalidBinary{Type: "Log", Err: err}
+	}
+	if trailing > 0 {
+		return dageth.ErrInvalidBinary{Type: "Log", Err: rlp.ErrMoreThanOneValue}
 	}
-	return DecodeBytes(na, src)
+	return nil
 }
 
 // DecodeBytes is like Decode, but it uses an input buffer directly.
-// Decode will grab or read all the bytes from an io.Reader anyway, so this can
-// save having to copy the bytes or create a bytes.Buffer.
+// Decode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
 func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
-	log := new(types.Log)
-	if err := rlp.DecodeBytes(src, log); err != nil {
+	return Decode(na, bytes.NewReader(src))
+}
+
+// decodeLogStream reads the log fields from the stream one by one, assembling each as it is read
+func decodeLogStream(na ipld.NodeAssembler, s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	ma, err := na.BeginMap(3)
+	if err != nil {
+		return err
+	}
+	if field, err := shared.UnpackStreamFields(ma, s, streamFields); err != nil {
+		return dageth.ErrInvalidField{Type: "Log", Field: field, Reason: "is malformed", Err: err}
+	}
+	if err := s.ListEnd(); err != nil {
+		return err
+	}
+	return ma.Finish()
+}
+
+// streamFields are the fields of the log, in the order of its RLP encoding
+var streamFields = []shared.StreamField{
+	{Name: "Address", Unpack: shared.StreamBytes(common.AddressLength)},
+	{Name: "Topics", Unpack: unpackStreamTopics},
+	{Name: "Data", Unpack: shared.StreamBytes(-1)},
+}
+
+func unpackStreamTopics(na ipld.NodeAssembler, s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	la, err := na.BeginList(-1)
+	if err != nil {
+		return err
+	}
+	for s.MoreDataInList() {
+		if err := shared.StreamBytes(common.HashLength)(la.AssembleValue(), s); err != nil {
+			return err
+		}
+	}
+	if err := s.ListEnd(); err != nil {
 		return err
 	}
-	return DecodeLog(na, *log)
+	return la.Finish()
 }
 
 // DecodeLog unpacks a go-ethereum Log into the NodeAssembler
@@ -46,7 +91,7 @@ func DecodeLog(na ipld.NodeAssembler, log types.Log) error {
 	}
 	for _, upFunc := range requiredUnpackFuncs {
 		if err := upFunc(ma, log); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Log binary (%v)", err)
+			return dageth.ErrInvalidBinary{Type: "Log", Err: err}
 		}
 	}
 	return ma.Finish()
@@ -5,7 +5,6 @@ import (
 
 	"github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
-	"github.com/ipld/go-ipld-prime/multicodec"
 	"github.com/ipld/go-ipld-prime/traversal"
 	"github.com/multiformats/go-multihash"
 
@@ -16,13 +15,12 @@ var (
 	_ ipld.Decoder = Decode
 	_ ipld.Encoder = Encode
 
-	MultiCodecType = uint64(0x99) // Proposed
+	MultiCodecType = dageth.EthLogTrie
 	MultiHashType  = uint64(multihash.KECCAK_256)
 )
 
 func init() {
-	multicodec.RegisterDecoder(MultiCodecType, Decode)
-	multicodec.RegisterEncoder(MultiCodecType, Encode)
+	dageth.RegisterCodec(MultiCodecType, Encode, Decode)
 }
 
 // AddSupportToChooser takes an existing node prototype chooser and subs in
@@ -4,17 +4,18 @@ import (
 	"github.com/ipfs/kubo/plugin"
 	"github.com/ipld/go-ipld-prime/multicodec"
 
-	"github.com/vulcanize/go-codec-dageth/header"
-	"github.com/vulcanize/go-codec-dageth/log"
-	"github.com/vulcanize/go-codec-dageth/log_trie"
-	"github.com/vulcanize/go-codec-dageth/rct"
-	"github.com/vulcanize/go-codec-dageth/rct_trie"
-	account "github.com/vulcanize/go-codec-dageth/state_account"
-	"github.com/vulcanize/go-codec-dageth/state_trie"
-	"github.com/vulcanize/go-codec-dageth/storage_trie"
-	"github.com/vulcanize/go-codec-dageth/tx"
-	"github.com/vulcanize/go-codec-dageth/tx_trie"
-	"github.com/vulcanize/go-codec-dageth/uncles"
+	dageth "github.com/vulcanize/go-codec-dageth"
+	_ "github.com/vulcanize/go-codec-dageth/header"
+	_ "github.com/vulcanize/go-codec-dageth/log"
+	_ "github.com/vulcanize/go-codec-dageth/log_trie"
+	_ "github.com/vulcanize/go-codec-dageth/rct"
+	_ "github.com/vulcanize/go-codec-dageth/rct_trie"
+	_ "github.com/vulcanize/go-codec-dageth/state_account"
+	_ "github.com/vulcanize/go-codec-dageth/state_trie"
+	_ "github.com/vulcanize/go-codec-dageth/storage_trie"
+	_ "github.com/vulcanize/go-codec-dageth/tx"
+	_ "github.com/vulcanize/go-codec-dageth/tx_trie"
+	_ "github.com/vulcanize/go-codec-dageth/uncles"
 )
 
 // Plugins is exported list of plugins that will be loaded
@@ -43,28 +44,5 @@ func (*ethIPLDPlugin) Init(_ *plugin.Environment) error {
 
 // Register satisfies the PluginIPLD interface
 func (*ethIPLDPlugin) Register(reg multicodec.Registry) error {
-	reg.RegisterDecoder(header.MultiCodecType, header.Decode)
-	reg.RegisterDecoder(uncles.MultiCodecType, uncles.Decode)
-	reg.RegisterDecoder(tx.MultiCodecType, tx.Decode)
-	reg.RegisterDecoder(tx_trie.MultiCodecType, tx_trie.Decode)
-	reg.RegisterDecoder(rct.MultiCodecType, rct.Decode)
-	reg.RegisterDecoder(rct_trie.MultiCodecType, rct_trie.Decode)
-	reg.RegisterDecoder(log.MultiCodecType, log.Decode)
-	reg.RegisterDecoder(log_trie.MultiCodecType, log_trie.Decode)
-	reg.RegisterDecoder(state_trie.MultiCodecType, state_trie.Decode)
-	reg.RegisterDecoder(account.MultiCodecType, account.Decode)
-	reg.RegisterDecoder(storage_trie.MultiCodecType, storage_trie.Decode)
-
-	reg.RegisterEncoder(header.MultiCodecType, header.Encode)
-	reg.RegisterEncoder(uncles.MultiCodecType, uncles.Encode)
-	reg.RegisterEncoder(tx.MultiCodecType, tx.Encode)
-	reg.RegisterEncoder(tx_trie.MultiCodecType, tx_trie.Encode)
-	reg.RegisterEncoder(rct.MultiCodecType, rct.Encode)
-	reg.RegisterEncoder(rct_trie.MultiCodecType, rct_trie.Encode)
-	reg.RegisterEncoder(log.MultiCodecType, log.Encode)
-	reg.RegisterEncoder(log_trie.MultiCodecType, log_trie.Encode)
-	reg.RegisterEncoder(state_trie.MultiCodecType, state_trie.Encode)
-	reg.RegisterEncoder(account.MultiCodecType, account.Encode)
-	reg.RegisterEncoder(storage_trie.MultiCodecType, storage_trie.Encode)
-	return nil
+	return dageth.RegisterAll(&reg)
 }
@@ -2,7 +2,6 @@ package rct
 
 import (
 	"bytes"
-	"encoding/binary"
 	"fmt"
 	"io"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/ipld/go-ipld-prime"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
+	dageth_log "github.com/vulcanize/go-codec-dageth/log"
 	"github.com/vulcanize/go-codec-dageth/shared"
 )
 
@@ -38,23 +38,23 @@ func AppendEncode(enc []byte, inNode ipld.Node) ([]byte, error) {
 	rct := new(receiptRLP)
 	txType, err := packReceiptRLP(rct, inNode)
 	if err != nil {
-		return enc, fmt.Errorf("unable to encode receiptRLP (%v)", err)
+		return enc, err
 	}
 	wbs := shared.NewWriteableByteSlice(&enc)
 	switch txType {
 	case types.LegacyTxType:
 		if err := rlp.Encode(wbs, rct); err != nil {
-			return enc, fmt.Errorf("invalid DAG-ETH Receipt form (%v)", err)
+			return enc, dageth.ErrInvalidForm{Type: "Receipt", Err: err}
 		}
 		return enc, nil
 	case types.AccessListTxType, types.DynamicFeeTxType:
 		enc = append(enc, txType)
 		if err := rlp.Encode(wbs, rct); err != nil {
-			return enc, fmt.Errorf("invalid DAG-ETH Receipt form (%v)", err)
+			return enc, dageth.ErrInvalidForm{Type: "Receipt", Err: err}
 		}
 		return enc, nil
 	default:
-		return enc, fmt.Errorf("invalid DAG-ETH Receipt form (unrecognized TxType %d)", txType)
+		return enc, dageth.ErrInvalidForm{Type: "Receipt", Err: dageth.ErrUnsupportedTxType{TxType: txType}}
 	}
 }
 
@@ -70,7 +70,7 @@ func EncodeReceipt(receipt *types.Receipt, inNode ipld.Node) error {
 	rct := new(receiptRLP)
 	txType, err := packReceiptRLP(rct, inNode)
 	if err != nil {
-		return fmt.Errorf("unable to pack receiptRLP struct: %v", err)
+		return err
 	}
 	receipt.Type = txType
 	receipt.Bloom = rct.Bloom
@@ -84,25 +84,30 @@ func EncodeReceipt(receipt *types.Receipt, inNode ipld.Node) error {
 	case len(rct.PostStateOrStatus) == len(common.Hash{}):
 		receipt.PostState = rct.PostStateOrStatus
 	default:
-		return fmt.Errorf("invalid DAG-ETH Receipt PostStateOrStatus %x", rct.PostStateOrStatus)
+		return dageth.ErrInvalidForm{Type: "Receipt", Err: dageth.ErrInvalidField{
+			Type: "Receipt", Field: "PostState", Reason: fmt.Sprintf("must be %d bytes", common.HashLength)}}
 	}
 	return nil
 }
 
 func packReceiptRLP(rct *receiptRLP, inNode ipld.Node) (uint8, error) {
-	// Wrap in a typed node for some basic schema form checking
-	builder := dageth.Type.Receipt.NewBuilder()
-	if err := builder.AssignNode(inNode); err != nil {
-		return 0, err
+	// Wrap in a typed node for some basic schema form checking, unless it is one already
+	node, ok := inNode.(dageth.Receipt)
+	if !ok {
+		builder := dageth.Type.Receipt.NewBuilder()
+		if err := builder.AssignNode(inNode); err != nil {
+			return 0, dageth.ErrInvalidForm{Type: "Receipt", Err: err}
+		}
+		node = builder.Build().(dageth.Receipt)
 	}
-	node := builder.Build()
-	txType, err := shared.GetTxType(node)
+	txType, err := shared.TxTypeFromBytes(node.FieldTxType().Bytes())
 	if err != nil {
-		return 0, fmt.Errorf("unable to get TxType from receiptRLP (%v)", err)
+		return 0, dageth.ErrInvalidForm{Type: "Receipt", Err: dageth.ErrInvalidField{
+			Type: "Receipt", Field: "TxType", Reason: "must be a single byte"}}
 	}
 	for _, pFunc := range requiredPackFuncs {
 		if err := pFunc(rct, node); err != nil {
-			return 0, fmt.Errorf("invalid DAG-ETH Receipt form (%v)", err)
+			return 0, dageth.ErrInvalidForm{Type: "Receipt", Err: err}
 		}
 	}
 	return txType, nil
@@ -117,126 +122,72 @@ type receiptRLP struct {
 	Logs              []*types.Log
 }
 
-var requiredPackFuncs = []func(*receiptRLP, ipld.Node) error{
+var requiredPackFuncs = []func(*receiptRLP, dageth.Receipt) error{
 	packPostStateOrStatus,
 	packCumulativeGasUsed,
 	packBloom,
 	packLogs,
+	checkLogRootCID,
 }
 
-func packPostStateOrStatus(rct *receiptRLP, node ipld.Node) error {
-	psNode, err := node.LookupByString("PostState")
-	if err != nil {
-		return fmt.Errorf("receipt is missing a PostState node: %v", err)
-	}
-	if !psNode.IsNull() {
-		psBytes, err := psNode.AsBytes()
-		if err != nil {
-			return fmt.Errorf("receipt PostState should be of type Bytes")
-		}
-		rct.PostStateOrStatus = psBytes
+func packPostStateOrStatus(rct *receiptRLP, node dageth.Receipt) error {
+	if ps := node.FieldPostState(); ps.Exists() {
+		rct.PostStateOrStatus = ps.Must().Bytes()
 		return nil
 	}
-
-	sNode, err := node.LookupByString("Status")
-	if err != nil {
-		return fmt.Errorf("receipt is missing a Status node: %v", err)
-	}
-	if sNode.IsNull() {
-		return fmt.Errorf("receipt Node must have either PostState or Status")
-	}
-	sBytes, err := sNode.AsBytes()
-	if err != nil {
-		return fmt.Errorf("receipt Status should be of type Bytes")
+	status := node.FieldStatus()
+	if !status.Exists() {
+		return dageth.ErrInvalidField{Type: "Receipt", Field: "Status", Reason: "is required when there is no PostState"}
 	}
+	sBytes := status.Must().Bytes()
 	switch {
 	case bytes.Equal(sBytes, receiptStatusFailed):
 		rct.PostStateOrStatus = receiptStatusFailedRLP
 	case bytes.Equal(sBytes, receiptStatusSuccessful):
 		rct.PostStateOrStatus = receiptStatusSuccessfulRLP
 	default:
-		return fmt.Errorf("unrecognized tx type")
+		return dageth.ErrInvalidField{Type: "Receipt", Field: "Status", Reason: "must be 0 or 1"}
 	}
 	return nil
 }
 
-func packCumulativeGasUsed(rct *receiptRLP, node ipld.Node) error {
-	cguNode, err := node.LookupByString("CumulativeGasUsed")
-	if err != nil {
-		return fmt.Errorf("receipt is missing a CumulativeGasUsed node: %v", err)
-	}
-	cguBytes, err := cguNode.AsBytes()
+func packCumulativeGasUsed(rct *receiptRLP, node dageth.Receipt) error {
+	v, err := shared.Uint64FromBytes(node.FieldCumulativeGasUsed().Bytes())
 	if err != nil {
-		return err
+		return dageth.ErrInvalidField{Type: "Receipt", Field: "CumulativeGasUsed", Reason: "must be 8 bytes"}
 	}
-	rct.CumulativeGasUsed = binary.BigEndian.Uint64(cguBytes)
+	rct.CumulativeGasUsed = v
 	return nil
 }
 
-func packBloom(rct *receiptRLP, node ipld.Node) error {
-	bloomNode, err := node.LookupByString("Bloom")
-	if err != nil {
-		return fmt.Errorf("receipt is missing a Bloom node: %v", err)
-	}
-	bloomBytes, err := bloomNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	rct.Bloom = types.BytesToBloom(bloomBytes)
+func packBloom(rct *receiptRLP, node dageth.Receipt) error {
+	rct.Bloom = types.BytesToBloom(node.FieldBloom().Bytes())
 	return nil
 }
 
-func packLogs(rct *receiptRLP, node ipld.Node) error {
-	logsNode, err := node.LookupByString("Logs")
-	if err != nil {
-		return fmt.Errorf("receipt is missing a Logs node: %v", err)
-	}
+func packLogs(rct *receiptRLP, node dageth.Receipt) error {
+	logsNode := node.FieldLogs()
 	logs := make([]*types.Log, logsNode.Length())
-	logsIt := logsNode.ListIterator()
+	logsIt := logsNode.Iterator()
 	for !logsIt.Done() {
-		logIndex, logNode, err := logsIt.Next()
-		if err != nil {
-			return err
-		}
-		addrNode, err := logNode.LookupByString("Address")
-		if err != nil {
-			return fmt.Errorf("receipt log is missing an Address node: %v", err)
-		}
-		addrBytes, err := addrNode.AsBytes()
-		if err != nil {
-			return err
-		}
-		topicsNode, err := logNode.LookupByString("Topics")
-		if err != nil {
-			return fmt.Errorf("receipt log is missing a Topics node: %v", err)
-		}
-		topics := make([]common.Hash, topicsNode.Length())
-		topicsIt := topicsNode.ListIterator()
-		for !topicsIt.Done() {
-			topicIndex, topicNode, err := topicsIt.Next()
-			if err != nil {
-				return err
-			}
-			topicBytes, err := topicNode.AsBytes()
-			if err != nil {
-				return err
-			}
-			topics[topicIndex] = common.BytesToHash(topicBytes)
-		}
-		dataNode, err := logNode.LookupByString("Data")
-		if err != nil {
-			return fmt.Errorf("receipt log is missing a Data node: %v", err)
-		}
-		data, err := dataNode.AsBytes()
-		if err != nil {
-			return err
-		}
-		logs[logIndex] = &types.Log{
-			Address: common.BytesToAddress(addrBytes),
-			Topics:  topics,
-			Data:    data,
+		logIndex, logNode := logsIt.Next()
+		logs[logIndex] = new(types.Log)
+		if err := dageth_log.EncodeLog(logs[logIndex], logNode); err != nil {
+			return dageth.ErrInvalidField{Type: "Receipt", Field: fmt.Sprintf("Logs/%d", logIndex), Reason: "is not a valid Log", Err: err}
 		}
 	}
 	rct.Logs = logs
 	return nil
 }
+
+// checkLogRootCID checks that the LogRootCID, if present, links to a log trie, it is not part of the consensus encoding
+func checkLogRootCID(rct *receiptRLP, node dageth.Receipt) error {
+	logRootCID := node.FieldLogRootCID()
+	if !logRootCID.Exists() {
+		return nil
+	}
+	if _, err := dageth.LinkDigest(logRootCID.Must().Link(), dageth.EthLogTrie); err != nil {
+		return dageth.ErrInvalidField{Type: "Receipt", Field: "LogRootCID", Reason: "is not a valid link", Err: err}
+	}
+	return nil
+}
@@ -2,16 +2,26 @@ package rct_test
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/multicodec"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+	"github.com/multiformats/go-multihash"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
 	"github.com/vulcanize/go-codec-dageth/rct"
 	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/shared/fuzztest"
 )
 
 var (
@@ -90,7 +100,7 @@ type Receipt struct {
 	CumulativeGasUsed Uint
 	Bloom             Bloom
 	Logs              Logs
-	LogRootCID        &TrieNode
+	LogRootCID        optional &TrieNode
 }
 
 type Receipts [Receipt]
@@ -168,3 +178,177 @@ func testReceiptEncoding(t *testing.T) {
 		t.Errorf("dynamic fee receipt encoding (%x) does not match the expected consensus encoding (%x)", dfRctBytes, dfReceiptConsensusEnc)
 	}
 }
+
+func TestDecodedLogRootCID(t *testing.T) {
+	enc, err := legacyReceipt.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unable to marshal legacy receipt binary: %v", err)
+	}
+	nb := dageth.Type.Receipt.NewBuilder()
+	if err := rct.Decode(nb, bytes.NewReader(enc)); err != nil {
+		t.Fatalf("unable to decode receipt: %v", err)
+	}
+	logRootNode, err := nb.Build().LookupByString("LogRootCID")
+	if err != nil {
+		t.Fatalf("decoded receipt is missing its LogRootCID: %v", err)
+	}
+	lnk, err := logRootNode.AsLink()
+	if err != nil {
+		t.Fatalf("decoded receipt LogRootCID is not a link: %v", err)
+	}
+	// the link references the root node of the log trie, not a log
+	logRootCID := lnk.(cidlink.Link).Cid
+	if codec := logRootCID.Prefix().Codec; codec != dageth.EthLogTrie {
+		t.Errorf("receipt LogRootCID has multicodec type (%d); expected the log trie multicodec type (%d)", codec, dageth.EthLogTrie)
+	}
+	if mhType := logRootCID.Prefix().MhType; mhType != multihash.KECCAK_256 {
+		t.Errorf("receipt LogRootCID has multihash type (%d); expected KECCAK_256", mhType)
+	}
+	// the log trie is keyed by the RLP encoded index of the logs
+	logTrie := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+	for i, log := range legacyReceipt.Logs {
+		key, err := rlp.EncodeToBytes(uint(i))
+		if err != nil {
+			t.Fatalf("unable to RLP encode log index: %v", err)
+		}
+		val, err := rlp.EncodeToBytes(log)
+		if err != nil {
+			t.Fatalf("unable to RLP encode log: %v", err)
+		}
+		if err := logTrie.Update(key, val); err != nil {
+			t.Fatalf("unable to add log to trie: %v", err)
+		}
+	}
+	if expected := shared.Keccak256ToCid(dageth.EthLogTrie, logTrie.Hash().Bytes()); !logRootCID.Equals(expected) {
+		t.Errorf("receipt LogRootCID (%s) does not match the root of its log trie (%s)", logRootCID, expected)
+	}
+}
+
+func TestLogRootCID(t *testing.T) {
+	// enough logs for the index keys to pass the single byte RLP encodings, which changes their sort order
+	logs := make([]*types.Log, 300)
+	for i := range logs {
+		logs[i] = &types.Log{
+			Address: shared.RandomAddr(),
+			Topics:  []common.Hash{shared.RandomHash()},
+			Data:    shared.RandomBytes(i % 50),
+		}
+	}
+	for _, n := range []int{0, 1, 2, 127, 128, 129, 300} {
+		logTrie := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+		for i, l := range logs[:n] {
+			key, _ := rlp.EncodeToBytes(uint(i))
+			val, _ := rlp.EncodeToBytes(l)
+			logTrie.Update(key, val)
+		}
+		expected := shared.Keccak256ToCid(dageth.EthLogTrie, logTrie.Hash().Bytes())
+		if got := rct.LogRootCID(logs[:n]); !got.Equals(expected) {
+			t.Errorf("log root CID of %d logs (%s) does not match the root of the log trie (%s)", n, got, expected)
+		}
+		// decoding hashes the logs as they are read from the stream
+		enc, err := (&types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: logs[:n]}).MarshalBinary()
+		if err != nil {
+			t.Fatalf("unable to marshal receipt binary: %v", err)
+		}
+		nb := dageth.Type.Receipt.NewBuilder()
+		if err := rct.Decode(nb, struct{ io.Reader }{bytes.NewReader(enc)}); err != nil {
+			t.Fatalf("unable to decode receipt with %d logs: %v", n, err)
+		}
+		logRootNode, err := nb.Build().LookupByString("LogRootCID")
+		if err != nil {
+			t.Fatalf("decoded receipt is missing its LogRootCID: %v", err)
+		}
+		lnk, err := logRootNode.AsLink()
+		if err != nil {
+			t.Fatalf("decoded receipt LogRootCID is not a link: %v", err)
+		}
+		if got := lnk.(cidlink.Link).Cid; !got.Equals(expected) {
+			t.Errorf("decoded LogRootCID of %d logs (%s) does not match the root of the log trie (%s)", n, got, expected)
+		}
+	}
+}
+
+func TestSkipLogRootCID(t *testing.T) {
+	enc, err := legacyReceipt.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unable to marshal legacy receipt binary: %v", err)
+	}
+	nb := basicnode.Prototype.Map.NewBuilder()
+	if err := (rct.DecodeOptions{SkipLogRootCID: true}).Decode(nb, bytes.NewReader(enc)); err != nil {
+		t.Fatalf("unable to decode receipt without its log root CID: %v", err)
+	}
+	node := nb.Build()
+	if _, err := node.LookupByString("LogRootCID"); err == nil {
+		t.Errorf("receipt decoded without its log root CID should not have the LogRootCID field")
+	}
+	if _, err := node.LookupByString("Logs"); err != nil {
+		t.Errorf("receipt decoded without its log root CID should have its logs: %v", err)
+	}
+
+	// the field is optional in typed receipts, which encode the same without it
+	typed := dageth.Type.Receipt.NewBuilder()
+	if err := (rct.DecodeOptions{SkipLogRootCID: true}).Decode(typed, bytes.NewReader(enc)); err != nil {
+		t.Fatalf("unable to decode typed receipt without its log root CID: %v", err)
+	}
+	typedNode := typed.Build().(dageth.Receipt)
+	if typedNode.FieldLogRootCID().Exists() {
+		t.Errorf("typed receipt decoded without its log root CID should not have the LogRootCID field")
+	}
+	buf := new(bytes.Buffer)
+	if err := rct.Encode(typedNode, buf); err != nil {
+		t.Fatalf("unable to encode receipt without its log root CID: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), enc) {
+		t.Errorf("receipt encoding without its log root CID (%x) does not match the original encoding (%x)", buf.Bytes(), enc)
+	}
+
+	// the options can be registered for the typed loads of a LinkSystem
+	var reg multicodec.Registry
+	reg.RegisterEncoder(rct.MultiCodecType, rct.Encode)
+	reg.RegisterDecoder(rct.MultiCodecType, rct.DecodeOptions{SkipLogRootCID: true}.Decode)
+	store := &memstore.Store{}
+	lsys := cidlink.LinkSystemUsingMulticodecRegistry(reg)
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	rctCID, err := dageth.StoreReceipt(ipld.LinkContext{}, lsys, typedNode)
+	if err != nil {
+		t.Fatalf("unable to store receipt: %v", err)
+	}
+	loaded, err := dageth.LoadNode(ipld.LinkContext{}, lsys, cidlink.Link{Cid: rctCID})
+	if err != nil {
+		t.Fatalf("unable to load receipt: %v", err)
+	}
+	if loaded.(dageth.Receipt).FieldLogRootCID().Exists() {
+		t.Errorf("receipt loaded with the registered options should not have the LogRootCID field")
+	}
+}
+
+func BenchmarkDecodeReceipt(b *testing.B) {
+	enc, err := legacyReceipt.MarshalBinary()
+	if err != nil {
+		b.Fatalf("unable to marshal legacy receipt binary: %v", err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := rct.DecodeBytes(dageth.Type.Receipt.NewBuilder(), enc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func FuzzDecodeBytes(f *testing.F) {
+	for _, seed := range []*types.Receipt{legacyReceipt, accessListReceipt, dynamicFeeReceipt} {
+		enc, err := seed.MarshalBinary()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(enc)
+	}
+	codec, ok := dageth.LookupCodec(rct.MultiCodecType)
+	if !ok {
+		f.Fatal("codec is not in the dageth.Codecs table")
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzztest.FuzzRoundTrip(t, codec, data)
+	})
+}
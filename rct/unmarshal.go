@@ -1,61 +1,206 @@
 package rct
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
-	"io/ioutil"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
-	"github.com/multiformats/go-multihash"
-	"github.com/vulcanize/go-codec-dageth/log"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	dageth_log "github.com/vulcanize/go-codec-dageth/log"
+	"github.com/vulcanize/go-codec-dageth/shared"
 )
 
 // Decode provides an IPLD codec decode interface for eth receipt IPLDs.
 // This function is registered via the go-ipld-prime link loader for multicodec
 // code 0x95 when this package is invoked via init.
 func Decode(na ipld.NodeAssembler, in io.Reader) error {
-	var src []byte
+	return DecodeOptions{}.Decode(na, in)
+}
+
+// DecodeBytes is like Decode, but it uses an input buffer directly.
+// Decode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
+func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
+	return DecodeOptions{}.DecodeBytes(na, src)
+}
+
+// DecodeReceipt unpacks a go-ethereum Receipt into the NodeAssembler
+func DecodeReceipt(na ipld.NodeAssembler, receipt types.Receipt) error {
+	return DecodeOptions{}.DecodeReceipt(na, receipt)
+}
+
+// DecodeOptions can be used to customize the behavior of the decoding functions
+type DecodeOptions struct {
+	// SkipLogRootCID leaves the LogRootCID out of the decoded receipt, skipping the hashing of its log trie.
+	// The LogRootCID is optional in dageth.Type.Receipt, so this works for typed and untyped nodes alike, and
+	// LogRootCID computes the skipped CID from the logs later, if it turns out to be needed.
+	// To skip the hashing when receipts are loaded through a LinkSystem, register the Decode method of the options
+	// in its multicodec registry, e.g. reg.RegisterDecoder(MultiCodecType, DecodeOptions{SkipLogRootCID: true}.Decode).
+	SkipLogRootCID bool
+}
+
+// Decode is like the package level Decode, but it uses the options
+func (opts DecodeOptions) Decode(na ipld.NodeAssembler, in io.Reader) error {
 	if buf, ok := in.(interface{ Bytes() []byte }); ok {
-		src = buf.Bytes()
-	} else {
-		var err error
-		src, err = ioutil.ReadAll(in)
+		return opts.DecodeBytes(na, buf.Bytes())
+	}
+	trailing, err := shared.StreamDecode(in, func(s *rlp.Stream) error {
+		return opts.decodeReceiptStream(na, s)
+	})
+	if err != nil {
+		return dageth.ErrInvalidBinary{Type: "Receipt", Err: err}
+	}
+	if trailing > 0 {
+		return dageth.ErrInvalidBinary{Type: "Receipt", Err: rlp.ErrMoreThanOneValue}
+	}
+	return nil
+}
+
+// DecodeBytes is like the package level DecodeBytes, but it uses the options
+func (opts DecodeOptions) DecodeBytes(na ipld.NodeAssembler, src []byte) error {
+	return opts.Decode(na, bytes.NewReader(src))
+}
+
+// decodeReceiptStream reads a receipt in its binary encoding from the stream, assembling each field as it is read:
+// a legacy receipt is an RLP list, a typed receipt is its type byte followed by the RLP list of its fields.
+// Each log is read whole, as the RLP encoding of the logs is hashed into the LogRootCID as they are read.
+func (opts DecodeOptions) decodeReceiptStream(na ipld.NodeAssembler, s *rlp.Stream) error {
+	kind, _, err := s.Kind()
+	if err != nil {
+		return err
+	}
+	rctType := byte(types.LegacyTxType)
+	switch kind {
+	case rlp.List:
+	case rlp.Byte:
+		b, err := s.Bytes()
 		if err != nil {
 			return err
 		}
+		switch rctType = b[0]; rctType {
+		case types.AccessListTxType, types.DynamicFeeTxType:
+		default:
+			return dageth.ErrUnsupportedTxType{TxType: rctType}
+		}
+	default:
+		return dageth.ErrInvalidField{Type: "Receipt", Field: "Type", Reason: "should be the leading byte of a typed receipt"}
+	}
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	ma, err := na.BeginMap(7)
+	if err != nil {
+		return err
+	}
+	if err := ma.AssembleKey().AssignString("TxType"); err != nil {
+		return err
+	}
+	if err := ma.AssembleValue().AssignBytes([]byte{rctType}); err != nil {
+		return err
+	}
+	postStateOrStatus, err := s.Bytes()
+	if err != nil {
+		return dageth.ErrInvalidField{Type: "Receipt", Field: "PostState", Reason: "is malformed", Err: err}
+	}
+	if err := unpackStreamPostStateOrStatus(ma, postStateOrStatus); err != nil {
+		return err
+	}
+	var hasher *logRootHasher
+	if !opts.SkipLogRootCID {
+		hasher = newLogRootHasher()
+	}
+	fields := []shared.StreamField{
+		{Name: "CumulativeGasUsed", Unpack: shared.StreamUint},
+		{Name: "Bloom", Unpack: shared.StreamBytes(types.BloomByteLength)},
+		{Name: "Logs", Unpack: func(na ipld.NodeAssembler, s *rlp.Stream) error {
+			return unpackStreamLogs(na, s, hasher)
+		}},
+	}
+	if field, err := shared.UnpackStreamFields(ma, s, fields); err != nil {
+		return dageth.ErrInvalidField{Type: "Receipt", Field: field, Reason: "is malformed", Err: err}
+	}
+	if err := s.ListEnd(); err != nil {
+		return err
+	}
+	if hasher != nil {
+		if err := ma.AssembleKey().AssignString("LogRootCID"); err != nil {
+			return err
+		}
+		if err := ma.AssembleValue().AssignLink(cidlink.Link{Cid: hasher.rootCID()}); err != nil {
+			return err
+		}
 	}
-	return DecodeBytes(na, src)
+	return ma.Finish()
 }
 
-// DecodeBytes is like Decode, but it uses an input buffer directly.
-// Decode will grab or read all the bytes from an io.Reader anyway, so this can
-// save having to copy the bytes or create a bytes.Buffer.
-func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
+// unpackStreamPostStateOrStatus assigns the PostState and Status from the first field of the receipt, which holds the
+// post transaction state root before Byzantium and the status after it
+func unpackStreamPostStateOrStatus(ma ipld.MapAssembler, postStateOrStatus []byte) error {
 	var rct types.Receipt
-	if err := rct.UnmarshalBinary(src); err != nil {
+	switch {
+	case bytes.Equal(postStateOrStatus, receiptStatusSuccessfulRLP):
+		rct.Status = types.ReceiptStatusSuccessful
+	case bytes.Equal(postStateOrStatus, receiptStatusFailedRLP):
+		rct.Status = types.ReceiptStatusFailed
+	case len(postStateOrStatus) == common.HashLength:
+		rct.PostState = postStateOrStatus
+	default:
+		return dageth.ErrInvalidField{Type: "Receipt", Field: "PostState", Reason: fmt.Sprintf("must be %d bytes", common.HashLength)}
+	}
+	return unpackPostStateOrStatus(ma, rct)
+}
+
+// unpackStreamLogs reads the logs one at a time, assembling each and adding it to the hasher, if there is one
+func unpackStreamLogs(na ipld.NodeAssembler, s *rlp.Stream, hasher *logRootHasher) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	la, err := na.BeginList(-1)
+	if err != nil {
+		return err
+	}
+	for i := 0; s.MoreDataInList(); i++ {
+		logRLP, err := s.Raw()
+		if err != nil {
+			return err
+		}
+		if err := dageth_log.DecodeBytes(la.AssembleValue(), logRLP); err != nil {
+			return dageth.ErrInvalidField{Type: "Receipt", Field: "Logs/" + strconv.Itoa(i), Reason: "is not a valid Log", Err: err}
+		}
+		if hasher != nil {
+			hasher.add(logRLP)
+		}
+	}
+	if err := s.ListEnd(); err != nil {
 		return err
 	}
-	return DecodeReceipt(na, rct)
+	return la.Finish()
 }
 
-// DecodeReceipt unpacks a go-ethereum Receipt into the NodeAssembler
-func DecodeReceipt(na ipld.NodeAssembler, receipt types.Receipt) error {
-	ma, err := na.BeginMap(5)
+// DecodeReceipt is like the package level DecodeReceipt, but it uses the options
+func (opts DecodeOptions) DecodeReceipt(na ipld.NodeAssembler, receipt types.Receipt) error {
+	ma, err := na.BeginMap(7)
 	if err != nil {
 		return err
 	}
 	for _, upFunc := range requiredUnpackFuncs {
 		if err := upFunc(ma, receipt); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Receipt binary (%v)", err)
+			return dageth.ErrInvalidBinary{Type: "Receipt", Err: err}
+		}
+	}
+	if !opts.SkipLogRootCID {
+		if err := unpackLogRootCID(ma, receipt); err != nil {
+			return dageth.ErrInvalidBinary{Type: "Receipt", Err: err}
 		}
 	}
 	return ma.Finish()
@@ -67,7 +212,6 @@ var requiredUnpackFuncs = []func(ipld.MapAssembler, types.Receipt) error{
 	unpackCumulativeGasUsed,
 	unpackBloom,
 	unpackLogs,
-	unpackLogRootCID,
 }
 
 func unpackTxType(ma ipld.MapAssembler, rct types.Receipt) error {
@@ -104,7 +248,7 @@ func unpackPostStateOrStatus(ma ipld.MapAssembler, rct types.Receipt) error {
 			return err
 		}
 	default:
-		return fmt.Errorf("unrecognized Receipt Status")
+		return dageth.ErrInvalidField{Type: "Receipt", Field: "Status", Reason: "must be 0 or 1"}
 	}
 	if err := ma.AssembleKey().AssignString("PostState"); err != nil {
 		return err
@@ -147,12 +291,6 @@ func unpackLogs(ma ipld.MapAssembler, rct types.Receipt) error {
 		if err := logMa.AssembleValue().AssignBytes(log.Address.Bytes()); err != nil {
 			return err
 		}
-		if err := logMa.AssembleKey().AssignString("Data"); err != nil {
-			return err
-		}
-		if err := logMa.AssembleValue().AssignBytes(log.Data); err != nil {
-			return err
-		}
 		if err := logMa.AssembleKey().AssignString("Topics"); err != nil {
 			return err
 		}
@@ -168,6 +306,12 @@ func unpackLogs(ma ipld.MapAssembler, rct types.Receipt) error {
 		if err := topicsLa.Finish(); err != nil {
 			return err
 		}
+		if err := logMa.AssembleKey().AssignString("Data"); err != nil {
+			return err
+		}
+		if err := logMa.AssembleValue().AssignBytes(log.Data); err != nil {
+			return err
+		}
 		if err := logMa.Finish(); err != nil {
 			return err
 		}
@@ -176,72 +320,65 @@ func unpackLogs(ma ipld.MapAssembler, rct types.Receipt) error {
 }
 
 func unpackLogRootCID(ma ipld.MapAssembler, rct types.Receipt) error {
-	logTrieRoot, err := processLogs(rct.Logs)
-	if err != nil {
-		return err
-	}
-	logMh, err := multihash.Encode(logTrieRoot, log.MultiHashType)
-	if err != nil {
-		return err
-	}
-	logCID := cid.NewCidV1(log.MultiCodecType, logMh)
-	logLinkCID := cidlink.Link{Cid: logCID}
+	logRootCID := LogRootCID(rct.Logs)
 	if err := ma.AssembleKey().AssignString("LogRootCID"); err != nil {
 		return err
 	}
-	return ma.AssembleValue().AssignLink(logLinkCID)
+	return ma.AssembleValue().AssignLink(cidlink.Link{Cid: logRootCID})
 }
 
-// processLogs takes the logs in a receipt and
-// creates a new log trie out of them, returning the root hash
-func processLogs(logs []*types.Log) ([]byte, error) {
-	lTrie := newlocalTrie()
+// LogRootCID returns the CID of the root node of the trie of the logs, keyed by their RLP encoded index.
+// The root hash is computed with a stack trie, which hashes the nodes as the sorted keys are inserted
+// without storing them.
+func LogRootCID(logs []*types.Log) cid.Cid {
+	root := types.DeriveSha(logList(logs), trie.NewStackTrie(nil))
+	return shared.Keccak256ToCid(dageth.EthLogTrie, root.Bytes())
+}
 
-	for idx, l := range logs {
-		logRLP, err := rlp.EncodeToBytes(l)
-		if err != nil {
-			return nil, err
-		}
-		if err := lTrie.add(idx, logRLP); err != nil {
-			return nil, err
-		}
-	}
-	return lTrie.rootHash(), nil
+// logList satisfies types.DerivableList for a receipt's logs
+type logList []*types.Log
+
+// Len satisfies types.DerivableList
+func (l logList) Len() int {
+	return len(l)
 }
 
-// localTrie wraps a go-ethereum trie and its underlying memory db.
-// It contributes to the creation of the trie node objects.
-type localTrie struct {
-	DB     ethdb.Database
-	trieDB *trie.Database
-	trie   *trie.Trie
+// EncodeIndex satisfies types.DerivableList
+func (l logList) EncodeIndex(i int, w *bytes.Buffer) {
+	rlp.Encode(w, l[i])
 }
 
-// newlocalTrie initializes and returns a localTrie object
-func newlocalTrie() *localTrie {
-	var err error
-	lt := &localTrie{}
-	lt.DB = rawdb.NewMemoryDatabase()
-	lt.trieDB = trie.NewDatabase(lt.DB)
-	lt.trie, err = trie.New(trie.TrieID(common.Hash{}), lt.trieDB)
-	if err != nil {
-		panic(err)
-	}
-	return lt
+// logRootHasher hashes the log trie of a receipt as its logs are read. The trie is keyed by the RLP encoded log
+// indexes, and the stack trie needs the keys in order, so the first log, keyed by 0x80, is held back and added after
+// the logs keyed by 0x01 to 0x7f, as types.DeriveSha does.
+type logRootHasher struct {
+	stackTrie *trie.StackTrie
+	first     []byte
+	count     int
 }
 
-// add receives the index of an object and its rawdata value
-// and includes it into the localTrie
-func (lt *localTrie) add(idx int, rawdata []byte) error {
-	key, err := rlp.EncodeToBytes(uint(idx))
-	if err != nil {
-		panic(err)
+func newLogRootHasher() *logRootHasher {
+	return &logRootHasher{stackTrie: trie.NewStackTrie(nil)}
+}
+
+// add adds the RLP encoding of the next log
+func (h *logRootHasher) add(logRLP []byte) {
+	switch {
+	case h.count == 0:
+		h.first = logRLP
+	case h.count == 0x80:
+		h.stackTrie.Update(rlp.AppendUint64(nil, 0), h.first)
+		fallthrough
+	default:
+		h.stackTrie.Update(rlp.AppendUint64(nil, uint64(h.count)), logRLP)
 	}
-	return lt.trie.Update(key, rawdata)
+	h.count++
 }
 
-// rootHash returns the computed trie root.
-// Useful for sanity checks on parsed data.
-func (lt *localTrie) rootHash() []byte {
-	return lt.trie.Hash().Bytes()
+// rootCID returns the CID of the root node of the log trie of the logs added
+func (h *logRootHasher) rootCID() cid.Cid {
+	if h.count > 0 && h.count <= 0x80 {
+		h.stackTrie.Update(rlp.AppendUint64(nil, 0), h.first)
+	}
+	return shared.Keccak256ToCid(dageth.EthLogTrie, h.stackTrie.Hash().Bytes())
 }
@@ -6,7 +6,6 @@ import (
 	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
-	"github.com/ipld/go-ipld-prime/multicodec"
 	"github.com/ipld/go-ipld-prime/traversal"
 	"github.com/multiformats/go-multihash"
 
@@ -22,8 +21,7 @@ var (
 )
 
 func init() {
-	multicodec.RegisterDecoder(MultiCodecType, Decode)
-	multicodec.RegisterEncoder(MultiCodecType, Encode)
+	dageth.RegisterCodec(MultiCodecType, Encode, Decode)
 }
 
 // AddSupportToChooser takes an existing node prototype chooser and subs in
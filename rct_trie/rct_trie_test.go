@@ -13,7 +13,10 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/datamodel"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/traversal"
 	"github.com/multiformats/go-multihash"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
@@ -215,6 +218,46 @@ func testReceiptTrieDecode(t *testing.T) {
 	leafNodeAL = leafNodeBuilderAL.Build()
 }
 
+func TestSkipLogRootCID(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		opts    rct_trie.DecodeOptions
+		hasRoot bool
+	}{
+		{"default", rct_trie.DecodeOptions{}, true},
+		{"skip", rct_trie.DecodeOptions{SkipLogRootCID: true}, false},
+	} {
+		nb := basicnode.Prototype.Map.NewBuilder()
+		if err := tc.opts.Decode(nb, bytes.NewReader(mockLeafNodeRLPLegacyReceipt)); err != nil {
+			t.Fatalf("%s: unable to decode receipt trie leaf node: %v", tc.name, err)
+		}
+		rctNode, err := traversal.Get(nb.Build(), datamodel.ParsePath(trie.LEAF_NODE.String()+"/Value/"+trie.RCT_VALUE.String()))
+		if err != nil {
+			t.Fatalf("%s: unable to resolve the receipt held in the leaf: %v", tc.name, err)
+		}
+		if _, err := rctNode.LookupByString("Logs"); err != nil {
+			t.Errorf("%s: receipt held in the leaf should have its logs: %v", tc.name, err)
+		}
+		_, err = rctNode.LookupByString("LogRootCID")
+		if hasRoot := err == nil; hasRoot != tc.hasRoot {
+			t.Errorf("%s: receipt held in the leaf has LogRootCID (%t); expected (%t)", tc.name, hasRoot, tc.hasRoot)
+		}
+	}
+
+	// the LogRootCID is optional in typed receipts, so typed nodes can skip it too
+	typed := dageth.Type.TrieNode.NewBuilder()
+	if err := (rct_trie.DecodeOptions{SkipLogRootCID: true}).Decode(typed, bytes.NewReader(mockLeafNodeRLPLegacyReceipt)); err != nil {
+		t.Fatalf("unable to decode typed receipt trie leaf node without log root CIDs: %v", err)
+	}
+	rctNode, err := traversal.Get(typed.Build(), datamodel.ParsePath(trie.LEAF_NODE.String()+"/Value/"+trie.RCT_VALUE.String()))
+	if err != nil {
+		t.Fatalf("unable to resolve the receipt held in the typed leaf: %v", err)
+	}
+	if rctNode.(dageth.Receipt).FieldLogRootCID().Exists() {
+		t.Errorf("typed receipt held in the leaf should not have the LogRootCID field")
+	}
+}
+
 func testReceiptTrieNodeContents(t *testing.T) {
 	verifyBranchNodeContents(t)
 	verifyExtensionNodeContents(t)
@@ -344,7 +387,11 @@ func verifyExtensionNodeContents(t *testing.T) {
 	if err != nil {
 		t.Fatalf("receipt trie extension node missing Child: %v", err)
 	}
-	childLink, err := childNode.AsLink()
+	childLinkNode, err := childNode.LookupByString("Link")
+	if err != nil {
+		t.Fatalf("receipt trie extension node Child should be a Link: %v", err)
+	}
+	childLink, err := childLinkNode.AsLink()
 	if err != nil {
 		t.Fatalf("receipt trie extension node Child should be of kind Link: %v", err)
 	}
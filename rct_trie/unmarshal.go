@@ -5,6 +5,7 @@ import (
 
 	"github.com/ipld/go-ipld-prime"
 
+	"github.com/vulcanize/go-codec-dageth/rct"
 	dageth_trie "github.com/vulcanize/go-codec-dageth/trie"
 )
 
@@ -17,9 +18,31 @@ func Decode(na ipld.NodeAssembler, in io.Reader) error {
 }
 
 // DecodeBytes is like Decode, but it uses an input buffer directly.
-// Decode will grab or read all the bytes from an io.Reader anyway, so this can
-// save having to copy the bytes or create a bytes.Buffer.
+// Decode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
 // This simply wraps dageth_trie.DecodeTrieNodeBytes with the proper multicodec type
 func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
 	return dageth_trie.DecodeTrieNodeBytes(na, src, MultiCodecType)
 }
+
+// DecodeOptions can be used to customize the behavior of the decoding functions
+type DecodeOptions struct {
+	// SkipLogRootCID leaves the LogRootCID out of the receipts held in the decoded node, see rct.DecodeOptions.
+	// As there, the Decode method of the options can be registered for MultiCodecType in the multicodec registry of a
+	// LinkSystem to skip the hashing when receipt trie nodes are loaded through it.
+	SkipLogRootCID bool
+}
+
+// Decode is like the package level Decode, but it uses the options
+func (opts DecodeOptions) Decode(na ipld.NodeAssembler, in io.Reader) error {
+	return opts.trieOptions().DecodeTrieNode(na, in, MultiCodecType)
+}
+
+// DecodeBytes is like the package level DecodeBytes, but it uses the options
+func (opts DecodeOptions) DecodeBytes(na ipld.NodeAssembler, src []byte) error {
+	return opts.trieOptions().DecodeTrieNodeBytes(na, src, MultiCodecType)
+}
+
+func (opts DecodeOptions) trieOptions() dageth_trie.DecodeOptions {
+	return dageth_trie.DecodeOptions{Receipt: rct.DecodeOptions{SkipLogRootCID: opts.SkipLogRootCID}}
+}
@@ -0,0 +1,182 @@
+// Package selectors provides prebuilt go-ipld-prime selectors for common traversals of the DAG-ETH graph.
+// The selectors are usable with traversal.WalkMatching and graphsync; they are written against the field names of
+// the dageth.Type schema, so traversals need to be configured with dageth.NodePrototypeChooser.
+package selectors
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+var ssb = builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+
+// HeaderOnly returns a selector that matches only the header it is applied to
+func HeaderOnly() datamodel.Node {
+	return ssb.Matcher().Node()
+}
+
+// HeaderWithTxTrie returns a selector that, applied to a header, matches the header and every node of its
+// transaction trie along with the transactions held in the trie leaves
+func HeaderWithTxTrie() datamodel.Node {
+	return ssb.ExploreUnion(
+		ssb.Matcher(),
+		ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("TxRootCID", Subtrie(matchValue()))
+		}),
+	).Node()
+}
+
+// HeaderWithReceipts returns a selector that, applied to a header, matches the header, every node of its receipt
+// trie along with the receipts held in the trie leaves and every node of the log trie of each receipt along with the
+// logs held in their leaves
+func HeaderWithReceipts() datamodel.Node {
+	return ssb.ExploreUnion(
+		ssb.Matcher(),
+		ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("RctRootCID", Subtrie(ssb.ExploreUnion(
+				matchValue(),
+				ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+					efsb.Insert("Receipt", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+						efsb.Insert("LogRootCID", Subtrie(matchValue()))
+					}))
+				}),
+			)))
+		}),
+	).Node()
+}
+
+// HeaderWithStatePath returns a selector that, applied to a header, matches the header and the state trie nodes on the
+// path to the account for the provided address, along with the account itself
+func HeaderWithStatePath(address common.Address) datamodel.Node {
+	return ssb.ExploreUnion(
+		ssb.Matcher(),
+		ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("StateRootCID", TriePath(shared.AddressToLeafKey(address), matchValue()))
+		}),
+	).Node()
+}
+
+// HeaderWithStorageSlots returns a selector that, applied to a header, matches the header, the state trie nodes on the
+// path to the account for the provided address, the account, and the storage trie nodes on the paths to each of the
+// provided storage slots, along with the slot values.
+// Slots are the raw storage slot positions, they are hashed with keccak256 to produce the storage trie keys.
+func HeaderWithStorageSlots(address common.Address, slots ...common.Hash) datamodel.Node {
+	if len(slots) == 0 {
+		return HeaderWithStatePath(address)
+	}
+	storagePaths := make([]builder.SelectorSpec, 0, len(slots))
+	for _, slot := range slots {
+		storagePaths = append(storagePaths, TriePath(crypto.Keccak256(slot.Bytes()), matchValue()))
+	}
+	return ssb.ExploreUnion(
+		ssb.Matcher(),
+		ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("StateRootCID", TriePath(shared.AddressToLeafKey(address), ssb.ExploreUnion(
+				matchValue(),
+				ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+					efsb.Insert("Account", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+						efsb.Insert("StorageRootCID", union(storagePaths...))
+					}))
+				}),
+			)))
+		}),
+	).Node()
+}
+
+// Ancestors returns a selector that, applied to a header, matches the header and up to n of its ancestors,
+// following the ParentCID links
+func Ancestors(n int64) datamodel.Node {
+	// the recursion depth counts the header itself
+	return ssb.ExploreRecursive(selector.RecursionLimitDepth(n+1), ssb.ExploreUnion(
+		ssb.Matcher(),
+		ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("ParentCID", ssb.ExploreRecursiveEdge())
+		}),
+	)).Node()
+}
+
+// Subtrie returns a selector spec that, applied to a TrieNode, matches it and every trie node below it.
+// The value selector spec is applied to the Value union of every branch and leaf node holding a value; the Value
+// union is keyed by the value kind (Transaction, Receipt, Account, Bytes or Log).
+// The value selector may itself contain ExploreRecursive selectors, these do not interfere with the recursion over
+// the trie.
+func Subtrie(value builder.SelectorSpec) builder.SelectorSpec {
+	return ssb.ExploreRecursive(selector.RecursionLimitNone(), ssb.ExploreUnion(
+		ssb.Matcher(),
+		ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("TrieBranchNode", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				for i := 0; i < 16; i++ {
+					efsb.Insert(trie.BranchChildKey(i), child(ssb.ExploreRecursiveEdge()))
+				}
+				efsb.Insert("Value", value)
+			}))
+			efsb.Insert("TrieExtensionNode", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				efsb.Insert("Child", child(ssb.ExploreRecursiveEdge()))
+			}))
+			efsb.Insert("TrieLeafNode", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				efsb.Insert("Value", value)
+			}))
+		}),
+	))
+}
+
+// TriePath returns a selector spec that, applied to a TrieNode, matches the trie nodes on the path to the provided
+// key. The value selector spec is applied to the Value union of the node holding the value for the key.
+// Keys are used as is, so for the state and storage tries the caller must provide the keccak256 hash of the address
+// or slot.
+// The path is followed through the branch nodes for every nibble of the key. A selector cannot know in advance how
+// many nibbles of the key an extension node consumes, and following the key from every position an extension could
+// end at grows the selector exponentially with the key length, so the whole subtrie below an extension node on the
+// path is selected with Subtrie and the value selector is applied to every value in it. In tries keyed by keccak256
+// hashes the subtrie below an extension node holds only the few keys sharing its long prefix.
+// For the same reason the value selector is applied to the value of a leaf node on the path even if the leaf
+// belongs to another key, when the key is absent from the trie.
+func TriePath(key []byte, value builder.SelectorSpec) builder.SelectorSpec {
+	return triePath(shared.KeyToNibbles(key), value)
+}
+
+func triePath(path shared.Nibbles, value builder.SelectorSpec) builder.SelectorSpec {
+	return ssb.ExploreUnion(
+		ssb.Matcher(),
+		ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("TrieBranchNode", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				if len(path) == 0 {
+					// the key ends at the branch, which holds its value
+					efsb.Insert("Value", value)
+					return
+				}
+				efsb.Insert(trie.BranchChildKey(int(path[0])), child(triePath(path[1:], value)))
+			}))
+			efsb.Insert("TrieExtensionNode", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				efsb.Insert("Child", child(Subtrie(value)))
+			}))
+			efsb.Insert("TrieLeafNode", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				efsb.Insert("Value", value)
+			}))
+		}),
+	)
+}
+
+// child applies the selector spec to a branch or extension node Child, whether it is a link or an embedded node
+func child(next builder.SelectorSpec) builder.SelectorSpec {
+	return ssb.ExploreAll(next)
+}
+
+// matchValue matches the typed value held in a Value union
+func matchValue() builder.SelectorSpec {
+	return ssb.ExploreAll(ssb.Matcher())
+}
+
+func union(members ...builder.SelectorSpec) builder.SelectorSpec {
+	if len(members) == 1 {
+		return members[0]
+	}
+	return ssb.ExploreUnion(members...)
+}
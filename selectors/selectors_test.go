@@ -0,0 +1,335 @@
+package selectors_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	_ "github.com/ipld/go-ipld-prime/codec/raw"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+	"github.com/ipld/go-ipld-prime/traversal"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	_ "github.com/vulcanize/go-codec-dageth/header"
+	_ "github.com/vulcanize/go-codec-dageth/log_trie"
+	_ "github.com/vulcanize/go-codec-dageth/rct_trie"
+	"github.com/vulcanize/go-codec-dageth/selectors"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	_ "github.com/vulcanize/go-codec-dageth/state_trie"
+	_ "github.com/vulcanize/go-codec-dageth/storage_trie"
+	"github.com/vulcanize/go-codec-dageth/trie"
+	_ "github.com/vulcanize/go-codec-dageth/tx_trie"
+)
+
+const (
+	testTxs      = 20
+	testAccounts = 100
+	testSlots    = 30
+)
+
+var (
+	ssb = builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+
+	testAddress = common.BigToAddress(big.NewInt(7))
+	testCode    = []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+)
+
+type fixture struct {
+	lsys    ipld.LinkSystem
+	headers []cid.Cid
+}
+
+func TestSelectors(t *testing.T) {
+	f := newFixture(t)
+	head := f.headers[len(f.headers)-1]
+
+	counts := f.walk(t, head, selectors.HeaderOnly())
+	if counts[dageth.Type.Header] != 1 || len(counts) != 1 {
+		t.Errorf("header only selector should match a single header; got %v", counts)
+	}
+
+	counts = f.walk(t, head, selectors.HeaderWithTxTrie())
+	if counts[dageth.Type.Transaction] != testTxs {
+		t.Errorf("tx trie selector matched %d transactions; expected %d", counts[dageth.Type.Transaction], testTxs)
+	}
+	if counts[dageth.Type.TrieNode] == 0 {
+		t.Errorf("tx trie selector should match tx trie nodes")
+	}
+
+	counts = f.walk(t, head, selectors.HeaderWithReceipts())
+	if counts[dageth.Type.Receipt] != testTxs {
+		t.Errorf("receipts selector matched %d receipts; expected %d", counts[dageth.Type.Receipt], testTxs)
+	}
+	if counts[dageth.Type.Log] != 2*testTxs {
+		t.Errorf("receipts selector matched %d logs; expected %d", counts[dageth.Type.Log], 2*testTxs)
+	}
+
+	counts = f.walk(t, head, selectors.HeaderWithStatePath(testAddress))
+	if counts[dageth.Type.Account] < 1 || counts[dageth.Type.Account] >= testAccounts {
+		t.Errorf("state path selector should match the account without matching the whole state; matched %d accounts", counts[dageth.Type.Account])
+	}
+	if counts[dageth.Type.Bytes] != 0 {
+		t.Errorf("state path selector should not match storage values")
+	}
+
+	slots := []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(5)), common.BigToHash(big.NewInt(100))}
+	var slotValues []common.Hash
+	err := f.progress().WalkMatching(f.load(t, head), compile(t, selectors.HeaderWithStorageSlots(testAddress, slots...)), func(prog traversal.Progress, n ipld.Node) error {
+		if n.Prototype() == dageth.Type.Bytes && prog.Path.Last().String() == trie.STORAGE_VALUE.String() {
+			val, err := n.AsBytes()
+			if err != nil {
+				return err
+			}
+			slotValues = append(slotValues, common.BytesToHash(val))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to walk storage slots selector: %v", err)
+	}
+	for _, slot := range slots[:2] {
+		found := false
+		for _, val := range slotValues {
+			if val == slotValue(slot) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("storage slots selector did not match the value of slot %x", slot)
+		}
+	}
+	if len(slotValues) >= testSlots {
+		t.Errorf("storage slots selector should not match the whole storage trie; matched %d values", len(slotValues))
+	}
+
+	counts = f.walk(t, head, selectors.Ancestors(1))
+	if counts[dageth.Type.Header] != 2 {
+		t.Errorf("ancestors selector with a depth of 1 matched %d headers; expected 2", counts[dageth.Type.Header])
+	}
+	counts = f.walk(t, head, selectors.Ancestors(int64(len(f.headers)-1)))
+	if counts[dageth.Type.Header] != len(f.headers) {
+		t.Errorf("ancestors selector matched %d headers; expected %d", counts[dageth.Type.Header], len(f.headers))
+	}
+}
+
+func TestTriePathFollowsWholeKey(t *testing.T) {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	// keys branching off the path to the zero key at each of its first 16 nibbles, so that the path is a branch node
+	// at every depth down to the leaf of the key, with no extension nodes
+	trieBuilder := newBuilder(t, lsys, cid.EthStorageTrie)
+	key := make([]byte, 32)
+	trieBuilder.Put(key, crypto.Keccak256(key))
+	for d := 0; d < 16; d++ {
+		other := make([]byte, 32)
+		other[d/2] = 0x10 >> (4 * (d % 2))
+		trieBuilder.Put(other, crypto.Keccak256(other))
+	}
+	root := commit(t, trieBuilder)
+	rootNode, err := lsys.Load(ipld.LinkContext{}, cidlink.Link{Cid: root}, dageth.Type.TrieNode)
+	if err != nil {
+		t.Fatalf("unable to load trie root: %v", err)
+	}
+	var values [][]byte
+	prog := traversal.Progress{Cfg: &traversal.Config{LinkSystem: lsys, LinkTargetNodePrototypeChooser: dageth.NodePrototypeChooser}}
+	sel := compile(t, selectors.TriePath(key, ssb.ExploreAll(ssb.Matcher())).Node())
+	if err := prog.WalkMatching(rootNode, sel, func(_ traversal.Progress, n ipld.Node) error {
+		if n.Prototype() == dageth.Type.Bytes {
+			val, err := n.AsBytes()
+			if err != nil {
+				return err
+			}
+			values = append(values, val)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unable to walk trie path selector: %v", err)
+	}
+	if len(values) != 1 || !bytes.Equal(values[0], crypto.Keccak256(key)) {
+		t.Errorf("trie path selector should match only the value of the key; matched %d values", len(values))
+	}
+}
+
+func slotValue(slot common.Hash) common.Hash {
+	return crypto.Keccak256Hash(slot.Bytes())
+}
+
+func compile(t *testing.T, selNode datamodel.Node) selector.Selector {
+	sel, err := selector.CompileSelector(selNode)
+	if err != nil {
+		t.Fatalf("unable to compile selector: %v", err)
+	}
+	return sel
+}
+
+func (f *fixture) progress() traversal.Progress {
+	return traversal.Progress{
+		Cfg: &traversal.Config{
+			LinkSystem:                     f.lsys,
+			LinkTargetNodePrototypeChooser: dageth.NodePrototypeChooser,
+		},
+	}
+}
+
+func (f *fixture) load(t *testing.T, c cid.Cid) ipld.Node {
+	n, err := f.lsys.Load(ipld.LinkContext{}, cidlink.Link{Cid: c}, dageth.Type.Header)
+	if err != nil {
+		t.Fatalf("unable to load header: %v", err)
+	}
+	return n
+}
+
+// walk walks the selector from the header and counts the matched nodes by prototype
+func (f *fixture) walk(t *testing.T, header cid.Cid, selNode datamodel.Node) map[ipld.NodePrototype]int {
+	counts := make(map[ipld.NodePrototype]int)
+	err := f.progress().WalkMatching(f.load(t, header), compile(t, selNode), func(_ traversal.Progress, n ipld.Node) error {
+		counts[n.Prototype()]++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to walk selector: %v", err)
+	}
+	return counts
+}
+
+func newFixture(t *testing.T) *fixture {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	f := &fixture{lsys: lsys}
+
+	codeCID, err := shared.RawToCid(cid.Raw, testCode)
+	if err != nil {
+		t.Fatalf("unable to make code CID: %v", err)
+	}
+	if err := store.Put(context.Background(), string(codeCID.Bytes()), testCode); err != nil {
+		t.Fatalf("unable to store code: %v", err)
+	}
+
+	storageBuilder := newBuilder(t, lsys, cid.EthStorageTrie)
+	for i := int64(0); i < testSlots; i++ {
+		slot := common.BigToHash(big.NewInt(i))
+		storageBuilder.Put(crypto.Keccak256(slot.Bytes()), slotValue(slot).Bytes())
+	}
+	storageRoot := digest(t, commit(t, storageBuilder))
+
+	// every account shares the same storage and code, so that there are no links to blocks missing from the store
+	stateBuilder := newBuilder(t, lsys, cid.EthStateTrie)
+	for i := int64(0); i < testAccounts; i++ {
+		acctRLP, err := rlp.EncodeToBytes(&types.StateAccount{
+			Nonce:    uint64(i),
+			Balance:  big.NewInt(i),
+			Root:     storageRoot,
+			CodeHash: crypto.Keccak256(testCode),
+		})
+		if err != nil {
+			t.Fatalf("unable to RLP encode account: %v", err)
+		}
+		stateBuilder.Put(shared.AddressToLeafKey(common.BigToAddress(big.NewInt(i))), acctRLP)
+	}
+	stateRoot := digest(t, commit(t, stateBuilder))
+
+	txs := make(types.Transactions, testTxs)
+	rcts := make(types.Receipts, testTxs)
+	for i := range txs {
+		txs[i] = types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(i),
+			GasPrice: big.NewInt(1),
+			Gas:      21000,
+			To:       &testAddress,
+			Value:    big.NewInt(int64(i)),
+		})
+		rcts[i] = &types.Receipt{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(i+1) * 21000,
+			Logs: []*types.Log{
+				{Address: testAddress, Topics: []common.Hash{common.BigToHash(big.NewInt(int64(i)))}, Data: []byte{1}},
+				{Address: testAddress, Topics: []common.Hash{}, Data: []byte{2}},
+			},
+		}
+		rcts[i].Bloom = types.CreateBloom(types.Receipts{rcts[i]})
+		logBuilder := newBuilder(t, lsys, dageth.EthLogTrie)
+		if err := logBuilder.PutEncodable(rcts[i].Logs[0], rcts[i].Logs[1]); err != nil {
+			t.Fatalf("unable to add logs to log trie: %v", err)
+		}
+		commit(t, logBuilder)
+	}
+	txBuilder := newBuilder(t, lsys, cid.EthTxTrie)
+	if err := txBuilder.PutIndexed(txs); err != nil {
+		t.Fatalf("unable to add txs to trie: %v", err)
+	}
+	txRoot := digest(t, commit(t, txBuilder))
+	rctBuilder := newBuilder(t, lsys, cid.EthTxReceiptTrie)
+	if err := rctBuilder.PutIndexed(rcts); err != nil {
+		t.Fatalf("unable to add receipts to trie: %v", err)
+	}
+	rctRoot := digest(t, commit(t, rctBuilder))
+
+	var parentHash common.Hash
+	for i := int64(0); i < 3; i++ {
+		header := &types.Header{
+			ParentHash:  parentHash,
+			UncleHash:   types.EmptyUncleHash,
+			Root:        stateRoot,
+			TxHash:      txRoot,
+			ReceiptHash: rctRoot,
+			Difficulty:  big.NewInt(1),
+			Number:      big.NewInt(i),
+			Extra:       []byte{},
+		}
+		headerRLP, err := rlp.EncodeToBytes(header)
+		if err != nil {
+			t.Fatalf("unable to RLP encode header: %v", err)
+		}
+		headerCID, err := shared.RawToCid(cid.EthBlock, headerRLP)
+		if err != nil {
+			t.Fatalf("unable to make header CID: %v", err)
+		}
+		if err := store.Put(context.Background(), string(headerCID.Bytes()), headerRLP); err != nil {
+			t.Fatalf("unable to store header: %v", err)
+		}
+		f.headers = append(f.headers, headerCID)
+		parentHash = header.Hash()
+	}
+	return f
+}
+
+func newBuilder(t *testing.T, lsys ipld.LinkSystem, codec uint64) *trie.Builder {
+	builder, err := trie.NewBuilder(lsys, codec)
+	if err != nil {
+		t.Fatalf("unable to create trie builder: %v", err)
+	}
+	return builder
+}
+
+func commit(t *testing.T, builder *trie.Builder) cid.Cid {
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit trie: %v", err)
+	}
+	return root
+}
+
+func digest(t *testing.T, c cid.Cid) common.Hash {
+	decodedMh, err := multihash.Decode(c.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode multihash: %v", err)
+	}
+	return common.BytesToHash(decodedMh.Digest)
+}
@@ -0,0 +1,87 @@
+// Package fuzztest holds the fuzz test helpers shared by the DAG-ETH codec packages. It imports the testing package,
+// so it is kept out of the codec packages and only imported from their tests.
+package fuzztest
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ipld/go-ipld-prime"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+)
+
+// FuzzRoundTrip checks that fuzz input which the codec decodes is in the canonical form: it must re-encode to the
+// same bytes, and those bytes must decode to an equal node. Input which fails to decode is ignored.
+// It also checks that decoding from readers, which do not expose their buffer, agrees with decoding from the buffer.
+func FuzzRoundTrip(t *testing.T, codec *dageth.Codec, data []byte) {
+	fuzzReaders(t, codec, data)
+	builder := codec.Prototype.NewBuilder()
+	if err := codec.Decoder(builder, bytes.NewBuffer(data)); err != nil {
+		return
+	}
+	node := builder.Build()
+	enc := new(bytes.Buffer)
+	if err := codec.Encoder(node, enc); err != nil {
+		t.Fatalf("decoded input (%x) failed to encode: %v", data, err)
+	}
+	if !bytes.Equal(enc.Bytes(), data) {
+		t.Fatalf("decoded input (%x) re-encodes to different bytes (%x)", data, enc.Bytes())
+	}
+	redecoded := codec.Prototype.NewBuilder()
+	if err := codec.Decoder(redecoded, bytes.NewBuffer(enc.Bytes())); err != nil {
+		t.Fatalf("re-encoded input (%x) failed to decode: %v", enc.Bytes(), err)
+	}
+	if !ipld.DeepEqual(node, redecoded.Build()) {
+		t.Fatalf("re-encoded input (%x) decodes to a different node", enc.Bytes())
+	}
+}
+
+// FuzzStrict checks that the codec's DecodeStrict rejects exactly the fuzz input which does not round trip: input
+// which the decoder rejects, or which re-encodes to different bytes. Input which DecodeStrict accepts must decode to
+// the same node as with the decoder.
+func FuzzStrict(t *testing.T, codec *dageth.Codec, data []byte) {
+	strict := codec.Prototype.NewBuilder()
+	strictErr := codec.DecodeStrict(strict, bytes.NewBuffer(data))
+	builder := codec.Prototype.NewBuilder()
+	if err := codec.Decoder(builder, bytes.NewBuffer(data)); err != nil {
+		if strictErr == nil {
+			t.Fatalf("input (%x) rejected by the decoder (%v) is accepted by DecodeStrict", data, err)
+		}
+		return
+	}
+	node := builder.Build()
+	enc := new(bytes.Buffer)
+	roundTrips := codec.Encoder(node, enc) == nil && bytes.Equal(enc.Bytes(), data)
+	if roundTrips && strictErr != nil {
+		t.Fatalf("input (%x) which round trips is rejected by DecodeStrict: %v", data, strictErr)
+	}
+	if !roundTrips && strictErr == nil {
+		t.Fatalf("input (%x) which does not round trip is accepted by DecodeStrict", data)
+	}
+	if strictErr == nil && !ipld.DeepEqual(node, strict.Build()) {
+		t.Fatalf("input (%x) decodes to a different node with DecodeStrict", data)
+	}
+}
+
+// fuzzReaders checks that the codec decodes the fuzz input from a reader of unknown size, a *bytes.Reader and an
+// *io.LimitedReader the same as from a bytes.Buffer
+func fuzzReaders(t *testing.T, codec *dageth.Codec, data []byte) {
+	bufBuilder := codec.Prototype.NewBuilder()
+	bufErr := codec.Decoder(bufBuilder, bytes.NewBuffer(data))
+	for name, in := range map[string]io.Reader{
+		"reader":         struct{ io.Reader }{bytes.NewReader(data)},
+		"bytes.Reader":   bytes.NewReader(data),
+		"io.LimitReader": io.LimitReader(bytes.NewReader(data), int64(len(data))),
+	} {
+		builder := codec.Prototype.NewBuilder()
+		err := codec.Decoder(builder, in)
+		if (err == nil) != (bufErr == nil) {
+			t.Fatalf("decoding input (%x) from a %s (%v) does not match decoding from a buffer (%v)", data, name, err, bufErr)
+		}
+		if err == nil && !ipld.DeepEqual(builder.Build(), bufBuilder.Build()) {
+			t.Fatalf("input (%x) decodes to a different node from a %s", data, name)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Nibbles is a hex nibble path through a merkle patricia trie, with each byte holding a single nibble (0-15).
+// Leaf node partial paths, as decoded by CompactToHex, end with the terminator flag nibble 16.
+type Nibbles []byte
+
+// KeyToNibbles converts key bytes into their nibble path, without the terminator flag
+func KeyToNibbles(key []byte) Nibbles {
+	nibbles := make(Nibbles, len(key)*2)
+	for i, b := range key {
+		nibbles[i*2] = b / 16
+		nibbles[i*2+1] = b % 16
+	}
+	return nibbles
+}
+
+// CompactToNibbles converts a compact encoded path to a nibble path
+func CompactToNibbles(compact []byte) Nibbles {
+	return compactToHex(compact)
+}
+
+// Append returns a new nibble path made of n followed by each of the provided paths; n is not modified
+func (n Nibbles) Append(paths ...Nibbles) Nibbles {
+	l := len(n)
+	for _, p := range paths {
+		l += len(p)
+	}
+	joined := make(Nibbles, 0, l)
+	joined = append(joined, n...)
+	for _, p := range paths {
+		joined = append(joined, p...)
+	}
+	return joined
+}
+
+// HasPrefix returns whether the nibble path begins with the provided prefix
+func (n Nibbles) HasPrefix(prefix Nibbles) bool {
+	return bytes.HasPrefix(n, prefix)
+}
+
+// CommonPrefixLen returns the number of leading nibbles the two paths have in common
+func (n Nibbles) CommonPrefixLen(other Nibbles) int {
+	i := 0
+	for ; i < len(n) && i < len(other); i++ {
+		if n[i] != other[i] {
+			break
+		}
+	}
+	return i
+}
+
+// HasTerm returns whether the nibble path ends with the terminator flag
+func (n Nibbles) HasTerm() bool {
+	return hasTerm(n)
+}
+
+// TrimTerm returns the nibble path without the terminator flag, if it has one
+func (n Nibbles) TrimTerm() Nibbles {
+	if hasTerm(n) {
+		return n[:len(n)-1]
+	}
+	return n
+}
+
+// Key converts the nibble path back into key bytes, ignoring the terminator flag.
+// The path needs to be made of an even number of nibbles to be converted.
+func (n Nibbles) Key() ([]byte, error) {
+	n = n.TrimTerm()
+	if len(n)%2 != 0 {
+		return nil, fmt.Errorf("nibble path of odd length %d cannot be converted to key bytes", len(n))
+	}
+	for i, nibble := range n {
+		if nibble > 15 {
+			return nil, fmt.Errorf("invalid nibble %d at position %d", nibble, i)
+		}
+	}
+	key := make([]byte, len(n)/2)
+	decodeNibbles(n, key)
+	return key, nil
+}
+
+// Compact returns the compact (hex-prefix) encoding of the nibble path
+func (n Nibbles) Compact() []byte {
+	return hexToCompact(n)
+}
+
+// String returns the nibble path as a string of hex digits, with the terminator flag rendered as "T"
+func (n Nibbles) String() string {
+	const digits = "0123456789abcdef"
+	s := make([]byte, len(n))
+	for i, nibble := range n {
+		if nibble < 16 {
+			s[i] = digits[nibble]
+		} else {
+			s[i] = 'T'
+		}
+	}
+	return string(s)
+}
@@ -0,0 +1,48 @@
+package shared_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+func TestNibbles(t *testing.T) {
+	key := []byte{0x12, 0xab}
+	nibbles := shared.KeyToNibbles(key)
+	if !bytes.Equal(nibbles, shared.Nibbles{1, 2, 10, 11}) {
+		t.Fatalf("key nibbles (%v) do not match expected nibbles", nibbles)
+	}
+	if nibbles.String() != "12ab" {
+		t.Errorf("nibbles string (%s) does not match expected string (12ab)", nibbles.String())
+	}
+
+	prefix := nibbles[:1]
+	joined := prefix.Append(shared.Nibbles{2, 10}, shared.Nibbles{11, 16})
+	if !bytes.Equal(prefix, shared.Nibbles{1}) {
+		t.Errorf("Append should not modify the receiver")
+	}
+	if !joined.HasTerm() || !joined.HasPrefix(nibbles[:3]) || joined.HasPrefix(shared.Nibbles{2}) {
+		t.Errorf("joined nibbles (%s) are not the expected terminated path", joined.String())
+	}
+	if joined.CommonPrefixLen(shared.Nibbles{1, 2, 3}) != 2 {
+		t.Errorf("common prefix length should be 2")
+	}
+	joinedKey, err := joined.Key()
+	if err != nil {
+		t.Fatalf("unable to convert nibbles to key: %v", err)
+	}
+	if !bytes.Equal(joinedKey, key) {
+		t.Errorf("key (%x) does not match expected key (%x)", joinedKey, key)
+	}
+	if _, err := nibbles[:3].Key(); err == nil {
+		t.Errorf("expected odd length nibbles to fail key conversion")
+	}
+
+	for _, path := range []shared.Nibbles{{}, {1}, {1, 2}, {16}, {1, 16}, {1, 2, 16}} {
+		compact := path.Compact()
+		if !bytes.Equal(shared.CompactToNibbles(compact), path) {
+			t.Errorf("compact encoding of %v (%x) does not decode back to it", path, compact)
+		}
+	}
+}
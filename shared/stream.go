@@ -0,0 +1,149 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+)
+
+// MaxStreamSize is the most input StreamDecode reads from a reader that does not know its size.
+// The rlp.Stream allocates for the sizes the RLP declares, so this also bounds the size of any single value it
+// allocates for. It needs to be raised to decode blocks larger than 32 MiB from such readers.
+var MaxStreamSize int64 = 32 << 20
+
+// StreamDecode decodes a single RLP value from the input with the decode function, through an rlp.Stream.
+// StreamDecode returns the number of bytes left in the input after the value.
+//
+// The input is read incrementally, and the rlp.Stream rejects values declaring sizes larger than what is left of the
+// input rather than allocating for them. For an *io.LimitedReader that is its limit, and for a *bytes.Reader or
+// *strings.Reader their length. The size of any other input is unknown, so it is capped at MaxStreamSize; input
+// declaring a larger value is rejected with rlp.ErrValueTooLarge, and only the bytes up to the cap are counted as left.
+// The DAG-ETH decoders read their fields from the stream one by one into the NodeAssembler, so the input is not held
+// in memory beyond the fields of the decoded node.
+func StreamDecode(in io.Reader, decode func(s *rlp.Stream) error) (int64, error) {
+	limit, ok := inputLimit(in)
+	if !ok {
+		// read one byte past the cap, so that trailing bytes are found after a value of exactly MaxStreamSize
+		in = io.LimitReader(in, MaxStreamSize+1)
+		limit = uint64(MaxStreamSize)
+	}
+	r, ok := in.(byteReader)
+	if !ok {
+		r = bufio.NewReader(in)
+	}
+	if err := decode(rlp.NewStream(r, limit)); err != nil {
+		return 0, err
+	}
+	return io.Copy(ioutil.Discard, r)
+}
+
+// byteReader is a reader the rlp.Stream reads from without buffering it
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// inputLimit returns the number of bytes left in the input, if the reader knows it
+func inputLimit(in io.Reader) (uint64, bool) {
+	var n int64
+	switch r := in.(type) {
+	case *io.LimitedReader:
+		n = r.N
+	case *bytes.Reader:
+		n = int64(r.Len())
+	case *strings.Reader:
+		n = int64(r.Len())
+	}
+	// an rlp.Stream with a limit of 0 has no limit, so empty input is capped like input of unknown size
+	if n <= 0 {
+		return 0, false
+	}
+	return uint64(n), true
+}
+
+// StreamField is a field of a DAG-ETH type, with the function that reads the field from an rlp.Stream and assigns
+// it to the NodeAssembler in its IPLD representation
+type StreamField struct {
+	Name   string
+	Unpack func(na ipld.NodeAssembler, s *rlp.Stream) error
+}
+
+// UnpackStreamFields reads the fields from the stream, in their order, and assembles each under its name in the map.
+// If a field fails it returns the field's name along with the error.
+func UnpackStreamFields(ma ipld.MapAssembler, s *rlp.Stream, fields []StreamField) (string, error) {
+	for _, field := range fields {
+		if err := ma.AssembleKey().AssignString(field.Name); err != nil {
+			return field.Name, err
+		}
+		if err := field.Unpack(ma.AssembleValue(), s); err != nil {
+			return field.Name, err
+		}
+	}
+	return "", nil
+}
+
+// StreamBytes returns a StreamField unpack function for a byte string of the given size, or of any size if it is
+// negative
+func StreamBytes(size int) func(ipld.NodeAssembler, *rlp.Stream) error {
+	return func(na ipld.NodeAssembler, s *rlp.Stream) error {
+		b, err := s.Bytes()
+		if err != nil {
+			return err
+		}
+		if size >= 0 && len(b) != size {
+			return fmt.Errorf("must be %d bytes, got %d", size, len(b))
+		}
+		return na.AssignBytes(b)
+	}
+}
+
+// StreamUint is a StreamField unpack function for an RLP integer of at most 64 bits, which it assigns as the 8
+// big-endian bytes of a Uint
+func StreamUint(na ipld.NodeAssembler, s *rlp.Stream) error {
+	i, err := s.Uint64()
+	if err != nil {
+		return err
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, i)
+	return na.AssignBytes(b)
+}
+
+// StreamBigInt is a StreamField unpack function for an RLP integer of any size, which it assigns as the big-endian
+// bytes of a BigInt
+func StreamBigInt(na ipld.NodeAssembler, s *rlp.Stream) error {
+	i, err := s.BigInt()
+	if err != nil {
+		return err
+	}
+	return na.AssignBytes(i.Bytes())
+}
+
+// StreamLink returns a StreamField unpack function for a keccak256 hash, which it assigns as a link to the CID of
+// the hash with the given codec
+func StreamLink(codec uint64) func(ipld.NodeAssembler, *rlp.Stream) error {
+	return func(na ipld.NodeAssembler, s *rlp.Stream) error {
+		h, err := s.Bytes()
+		if err != nil {
+			return err
+		}
+		if len(h) != 32 {
+			return fmt.Errorf("must be 32 bytes, got %d", len(h))
+		}
+		return na.AssignLink(cidlink.Link{Cid: Keccak256ToCid(codec, h)})
+	}
+}
+
+// StreamNull is a StreamField unpack function for a field the binary encoding of the type does not hold; it reads
+// nothing from the stream and assigns null
+func StreamNull(na ipld.NodeAssembler, s *rlp.Stream) error {
+	return na.AssignNull()
+}
@@ -0,0 +1,56 @@
+package shared_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+func TestStreamDecodeSizeCap(t *testing.T) {
+	readBytes := func(s *rlp.Stream) error {
+		_, err := s.Bytes()
+		return err
+	}
+	enc, err := rlp.EncodeToBytes(bytes.Repeat([]byte{0xff}, 100))
+	if err != nil {
+		t.Fatalf("unable to RLP encode bytes: %v", err)
+	}
+
+	// a string declaring 4 GiB in 6 bytes is rejected before allocating for it
+	huge := []byte{0xbc, 0x01, 0x00, 0x00, 0x00, 0x00}
+	if _, err := shared.StreamDecode(struct{ io.Reader }{bytes.NewReader(huge)}, readBytes); !errors.Is(err, rlp.ErrValueTooLarge) {
+		t.Errorf("expected rlp.ErrValueTooLarge decoding a string larger than its input; got %v", err)
+	}
+
+	defer func(max int64) { shared.MaxStreamSize = max }(shared.MaxStreamSize)
+	shared.MaxStreamSize = int64(len(enc)) - 1
+	if _, err := shared.StreamDecode(struct{ io.Reader }{bytes.NewReader(enc)}, readBytes); !errors.Is(err, rlp.ErrValueTooLarge) {
+		t.Errorf("expected rlp.ErrValueTooLarge decoding a value larger than MaxStreamSize; got %v", err)
+	}
+	// the cap is for readers of unknown size, readers knowing their size are limited by it
+	if _, err := shared.StreamDecode(bytes.NewReader(enc), readBytes); err != nil {
+		t.Errorf("unable to decode a value larger than MaxStreamSize from a reader of known size: %v", err)
+	}
+
+	// a value of exactly MaxStreamSize decodes, and bytes after it are found
+	shared.MaxStreamSize = int64(len(enc))
+	trailing, err := shared.StreamDecode(struct{ io.Reader }{bytes.NewReader(enc)}, readBytes)
+	if err != nil {
+		t.Fatalf("unable to decode a value of MaxStreamSize: %v", err)
+	}
+	if trailing != 0 {
+		t.Errorf("expected no trailing bytes; got %d", trailing)
+	}
+	trailing, err = shared.StreamDecode(struct{ io.Reader }{bytes.NewReader(append(enc, 0x80, 0x80))}, readBytes)
+	if err != nil {
+		t.Fatalf("unable to decode a value of MaxStreamSize: %v", err)
+	}
+	if trailing == 0 {
+		t.Error("expected trailing bytes after a value of MaxStreamSize to be found")
+	}
+}
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/multiformats/go-multihash"
@@ -61,12 +62,25 @@ func GetTxType(node ipld.Node) (uint8, error) {
 	if err != nil {
 		return 0, err
 	}
+	return TxTypeFromBytes(tyBytes)
+}
+
+// TxTypeFromBytes returns the eth tx type held in the bytes of a TxType node
+func TxTypeFromBytes(tyBytes []byte) (uint8, error) {
 	if len(tyBytes) != 1 {
 		return 0, fmt.Errorf("tx type should be a single byte")
 	}
 	return tyBytes[0], nil
 }
 
+// Uint64FromBytes returns the uint64 held in the 8 big-endian bytes of a Uint node
+func Uint64FromBytes(b []byte) (uint64, error) {
+	if len(b) != 8 {
+		return 0, fmt.Errorf("uint64 should be 8 bytes")
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
 type WriteableByteSlice struct {
 	enc *[]byte
 }
@@ -0,0 +1,235 @@
+// Package snapshot exports the state held in the DAG-ETH graph as a geth flat state snapshot, so that geth nodes and
+// snap sync servers can be bootstrapped from IPFS-held state.
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethsnapshot "github.com/ethereum/go-ethereum/core/state/snapshot"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/multiformats/go-multihash"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+	account "github.com/vulcanize/go-codec-dageth/state_account"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+// Option configures the range and progress reporting of an export
+type Option func(*options)
+
+type options struct {
+	start    []byte
+	limit    []byte
+	progress func(accountHash common.Hash) error
+}
+
+// WithRange limits the export to the accounts with hashes from start, inclusive, to limit, exclusive.
+// A nil limit exports to the end of the state trie.
+func WithRange(start common.Hash, limit *common.Hash) Option {
+	return func(o *options) {
+		o.start = start.Bytes()
+		o.limit = nil
+		if limit != nil {
+			o.limit = limit.Bytes()
+		}
+	}
+}
+
+// WithProgress sets a function called with the hash of every account once the account and all of its storage have
+// been written. An export interrupted after the function was called for an account can be resumed from the next
+// hash, with WithRange. Returning an error from the function stops the export.
+func WithProgress(fn func(accountHash common.Hash) error) Option {
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// errLimitReached stops the state trie walk once it passes the end of the export range
+var errLimitReached = errors.New("limit reached")
+
+// Export walks the state trie rooted at the provided CID, loading the state and storage tries from the LinkSystem,
+// and writes the flat snapshot entries for every account in the export range into the database in geth's snapshot
+// layout: the slim RLP of each account keyed by its account hash, and each storage value keyed by the account hash
+// followed by the slot hash. Accounts are written in ascending hash order. When an export reaches the end of the
+// state trie it also writes the state root as the snapshot root, with a finished generation progress record and an
+// empty diff layer journal, so that geth loads the snapshot rather than regenerating it.
+// Export returns the number of accounts written.
+func Export(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, stateRoot cid.Cid, db ethdb.KeyValueWriter, opts ...Option) (int, error) {
+	if stateRoot.Prefix().Codec != cid.EthStateTrie {
+		return 0, fmt.Errorf("snapshot export requires a state trie root CID; got multicodec type (%d)", stateRoot.Prefix().Codec)
+	}
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	start := shared.KeyToNibbles(o.start)
+	var limit shared.Nibbles
+	if o.limit != nil {
+		limit = shared.KeyToNibbles(o.limit)
+	}
+	var count int
+	err := trie.Walk(lnkCtx, lsys, stateRoot, func(n trie.WalkNode) error {
+		if n.Key == nil {
+			// the keys below the node all start with its path, so the subtrie can be skipped if that prefix sorts
+			// before the start of the range and the walk ends if it sorts after the end of the range
+			if bytes.Compare(n.Path, prefix(start, len(n.Path))) < 0 {
+				return trie.SkipChildren
+			}
+			if limit != nil && bytes.Compare(n.Path, prefix(limit, len(n.Path))) > 0 {
+				return errLimitReached
+			}
+			return nil
+		}
+		if bytes.Compare(n.Key, o.start) < 0 {
+			return nil
+		}
+		if o.limit != nil && bytes.Compare(n.Key, o.limit) >= 0 {
+			return errLimitReached
+		}
+		accountHash := common.BytesToHash(n.Key)
+		if err := exportAccount(lnkCtx, lsys, db, accountHash, n.Node); err != nil {
+			return fmt.Errorf("account %s: %v", accountHash.Hex(), err)
+		}
+		count++
+		if o.progress != nil {
+			return o.progress(accountHash)
+		}
+		return nil
+	})
+	if err == errLimitReached {
+		return count, nil
+	}
+	if err != nil {
+		return count, err
+	}
+	if o.limit == nil {
+		decodedMh, err := multihash.Decode(stateRoot.Hash())
+		if err != nil {
+			return count, err
+		}
+		if err := writeSnapshotMetadata(db, common.BytesToHash(decodedMh.Digest)); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// journalVersion is the version of geth's snapshot diff layer journal, which the snapshot package does not export
+const journalVersion uint64 = 0
+
+// generator is geth's snapshot generation progress record (the snapshot package's unexported journalGenerator)
+type generator struct {
+	Wiping   bool // deprecated, kept for compatibility
+	Done     bool
+	Marker   []byte
+	Accounts uint64
+	Slots    uint64
+	Storage  uint64
+}
+
+// writeSnapshotMetadata writes the entries geth requires to load the exported snapshot instead of regenerating it:
+// the snapshot root, a finished generation progress record and a journal holding no diff layers.
+// The record holds no generation stats, which geth only logs, so that an export resumed from a range writes the
+// same record as an export in one go.
+// The entries are written with geth's rawdb writers, which like the rest of geth treat a failed write as fatal.
+func writeSnapshotMetadata(db ethdb.KeyValueWriter, root common.Hash) error {
+	// a finished generation has no marker, as in geth's journalProgress
+	gen, err := rlp.EncodeToBytes(generator{Done: true})
+	if err != nil {
+		return err
+	}
+	journal := new(bytes.Buffer)
+	if err := rlp.Encode(journal, journalVersion); err != nil {
+		return err
+	}
+	if err := rlp.Encode(journal, root); err != nil {
+		return err
+	}
+	rawdb.WriteSnapshotRoot(db, root)
+	rawdb.WriteSnapshotGenerator(db, gen)
+	rawdb.WriteSnapshotJournal(db, journal.Bytes())
+	return nil
+}
+
+// exportAccount writes the snapshot entries for the account held by the state trie node and its storage
+func exportAccount(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, db ethdb.KeyValueWriter, accountHash common.Hash, node ipld.Node) error {
+	n, _, err := trie.NodeAndKind(node)
+	if err != nil {
+		return err
+	}
+	valUnionNode, err := n.LookupByString("Value")
+	if err != nil {
+		return err
+	}
+	acctNode, _, err := trie.ValueAndKind(valUnionNode)
+	if err != nil {
+		return err
+	}
+	acctBuf := new(bytes.Buffer)
+	if err := account.Encode(acctNode, acctBuf); err != nil {
+		return err
+	}
+	acct := new(types.StateAccount)
+	if err := rlp.DecodeBytes(acctBuf.Bytes(), acct); err != nil {
+		return err
+	}
+	slim := gethsnapshot.SlimAccountRLP(acct.Nonce, acct.Balance, acct.Root, acct.CodeHash)
+	if err := db.Put(accountKey(accountHash), slim); err != nil {
+		return err
+	}
+	if acct.Root == types.EmptyRootHash {
+		return nil
+	}
+	storageRoot := shared.Keccak256ToCid(cid.EthStorageTrie, acct.Root.Bytes())
+	return trie.Walk(lnkCtx, lsys, storageRoot, func(n trie.WalkNode) error {
+		if n.Key == nil {
+			return nil
+		}
+		sn, _, err := trie.NodeAndKind(n.Node)
+		if err != nil {
+			return err
+		}
+		valUnionNode, err := sn.LookupByString("Value")
+		if err != nil {
+			return err
+		}
+		valNode, _, err := trie.ValueAndKind(valUnionNode)
+		if err != nil {
+			return err
+		}
+		// storage snapshot entries hold the RLP encoded value, as the storage trie leaves do
+		val, err := valNode.AsBytes()
+		if err != nil {
+			return err
+		}
+		return db.Put(storageKey(accountHash, common.BytesToHash(n.Key)), val)
+	})
+}
+
+// accountKey returns the snapshot key of an account, rawdb.SnapshotAccountPrefix followed by the account hash
+func accountKey(accountHash common.Hash) []byte {
+	return append(common.CopyBytes(rawdb.SnapshotAccountPrefix), accountHash.Bytes()...)
+}
+
+// storageKey returns the snapshot key of a storage slot, rawdb.SnapshotStoragePrefix followed by the account hash and
+// the slot hash
+func storageKey(accountHash, slotHash common.Hash) []byte {
+	key := append(common.CopyBytes(rawdb.SnapshotStoragePrefix), accountHash.Bytes()...)
+	return append(key, slotHash.Bytes()...)
+}
+
+// prefix returns the first n nibbles of the path, padded with zero nibbles if the path is shorter
+func prefix(path shared.Nibbles, n int) shared.Nibbles {
+	if len(path) >= n {
+		return path[:n]
+	}
+	return append(common.CopyBytes(path), make([]byte, n-len(path))...)
+}
@@ -0,0 +1,224 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethsnapshot "github.com/ethereum/go-ethereum/core/state/snapshot"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethtrie "github.com/ethereum/go-ethereum/trie"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+	"github.com/multiformats/go-multihash"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/snapshot"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+const (
+	testAccounts = 100
+	testSlots    = 20
+)
+
+type testAccount struct {
+	hash    common.Hash
+	account *types.StateAccount
+	storage map[common.Hash][]byte
+}
+
+func TestExport(t *testing.T) {
+	lsys, stateRoot, accounts := newFixture(t)
+
+	db := rawdb.NewMemoryDatabase()
+	count, err := snapshot.Export(ipld.LinkContext{}, lsys, stateRoot, db)
+	if err != nil {
+		t.Fatalf("unable to export snapshot: %v", err)
+	}
+	if count != testAccounts {
+		t.Errorf("exported %d accounts; expected %d", count, testAccounts)
+	}
+	decodedMh, err := multihash.Decode(stateRoot.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode state root multihash: %v", err)
+	}
+	if root := rawdb.ReadSnapshotRoot(db); root != common.BytesToHash(decodedMh.Digest) {
+		t.Errorf("snapshot root (%s) does not match the state root", root.Hex())
+	}
+	if rawdb.ReadSnapshotGenerator(db) == nil || rawdb.ReadSnapshotJournal(db) == nil {
+		t.Errorf("snapshot generator and journal should be written")
+	}
+	for _, acct := range accounts {
+		slim := gethsnapshot.SlimAccountRLP(acct.account.Nonce, acct.account.Balance, acct.account.Root, acct.account.CodeHash)
+		if got := rawdb.ReadAccountSnapshot(db, acct.hash); !bytes.Equal(got, slim) {
+			t.Errorf("account %s snapshot (%x) does not match expected slim account (%x)", acct.hash.Hex(), got, slim)
+		}
+		for slotHash, val := range acct.storage {
+			if got := rawdb.ReadStorageSnapshot(db, acct.hash, slotHash); !bytes.Equal(got, val) {
+				t.Errorf("account %s slot %s snapshot (%x) does not match expected value (%x)", acct.hash.Hex(), slotHash.Hex(), got, val)
+			}
+		}
+	}
+
+	// an export split at an arbitrary hash and resumed from it produces the same snapshot
+	mid := common.HexToHash("0x7777000000000000000000000000000000000000000000000000000000000000")
+	split := rawdb.NewMemoryDatabase()
+	var last common.Hash
+	first, err := snapshot.Export(ipld.LinkContext{}, lsys, stateRoot, split, snapshot.WithRange(common.Hash{}, &mid),
+		snapshot.WithProgress(func(accountHash common.Hash) error {
+			if bytes.Compare(accountHash.Bytes(), last.Bytes()) <= 0 && last != (common.Hash{}) {
+				t.Errorf("accounts should be exported in ascending hash order")
+			}
+			last = accountHash
+			return nil
+		}))
+	if err != nil {
+		t.Fatalf("unable to export first half of snapshot: %v", err)
+	}
+	if bytes.Compare(last.Bytes(), mid.Bytes()) >= 0 {
+		t.Errorf("first half of export passed the range limit")
+	}
+	if rawdb.ReadSnapshotRoot(split) != (common.Hash{}) {
+		t.Errorf("a partial export should not write the snapshot root")
+	}
+	second, err := snapshot.Export(ipld.LinkContext{}, lsys, stateRoot, split, snapshot.WithRange(mid, nil))
+	if err != nil {
+		t.Fatalf("unable to export second half of snapshot: %v", err)
+	}
+	if first == 0 || second == 0 || first+second != testAccounts {
+		t.Errorf("split export wrote %d and %d accounts; expected %d in total", first, second, testAccounts)
+	}
+	compareDBs(t, db, split)
+
+	// the export can be moved as a stream
+	buf := new(bytes.Buffer)
+	if _, err := snapshot.Export(ipld.LinkContext{}, lsys, stateRoot, snapshot.NewStreamWriter(buf)); err != nil {
+		t.Fatalf("unable to export snapshot stream: %v", err)
+	}
+	imported := rawdb.NewMemoryDatabase()
+	if _, err := snapshot.Import(buf, imported); err != nil {
+		t.Fatalf("unable to import snapshot stream: %v", err)
+	}
+	compareDBs(t, db, imported)
+}
+
+func TestExportLoadsInGeth(t *testing.T) {
+	lsys, stateRoot, accounts := newFixture(t)
+	db := rawdb.NewMemoryDatabase()
+	if _, err := snapshot.Export(ipld.LinkContext{}, lsys, stateRoot, db); err != nil {
+		t.Fatalf("unable to export snapshot: %v", err)
+	}
+	decodedMh, err := multihash.Decode(stateRoot.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode state root multihash: %v", err)
+	}
+	root := common.BytesToHash(decodedMh.Digest)
+	// with NoBuild geth returns the error instead of regenerating a snapshot it fails to load
+	snaps, err := gethsnapshot.New(gethsnapshot.Config{CacheSize: 1, NoBuild: true}, db, gethtrie.NewDatabase(db), root)
+	if err != nil {
+		t.Fatalf("geth is unable to load the exported snapshot: %v", err)
+	}
+	snap := snaps.Snapshot(root)
+	if snap == nil {
+		t.Fatalf("geth loaded no snapshot layer for the state root")
+	}
+	acct, err := snap.Account(accounts[1].hash)
+	if err != nil {
+		t.Fatalf("unable to read account from the loaded snapshot: %v", err)
+	}
+	if acct == nil || acct.Nonce != accounts[1].account.Nonce {
+		t.Errorf("loaded snapshot account does not match the exported account")
+	}
+}
+
+func compareDBs(t *testing.T, expected, actual ethdb.Database) {
+	var entries int
+	it := expected.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		entries++
+		val, err := actual.Get(it.Key())
+		if err != nil || !bytes.Equal(val, it.Value()) {
+			t.Errorf("database entry %x does not match", it.Key())
+		}
+	}
+	actualIt := actual.NewIterator(nil, nil)
+	defer actualIt.Release()
+	for actualIt.Next() {
+		entries--
+	}
+	if entries != 0 {
+		t.Errorf("databases differ in their number of entries")
+	}
+}
+
+func newFixture(t *testing.T) (ipld.LinkSystem, cid.Cid, []testAccount) {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+
+	stateBuilder, err := trie.NewBuilder(lsys, cid.EthStateTrie)
+	if err != nil {
+		t.Fatalf("unable to create state trie builder: %v", err)
+	}
+	accounts := make([]testAccount, testAccounts)
+	for i := range accounts {
+		addr := common.BigToAddress(big.NewInt(int64(i)))
+		acct := testAccount{
+			hash: crypto.Keccak256Hash(addr.Bytes()),
+			account: &types.StateAccount{
+				Nonce:    uint64(i),
+				Balance:  big.NewInt(int64(i) * 1000),
+				Root:     types.EmptyRootHash,
+				CodeHash: crypto.Keccak256(nil),
+			},
+			storage: make(map[common.Hash][]byte),
+		}
+		// every third account is a contract with storage
+		if i%3 == 0 {
+			storageBuilder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+			if err != nil {
+				t.Fatalf("unable to create storage trie builder: %v", err)
+			}
+			for j := 0; j < testSlots; j++ {
+				slotHash := crypto.Keccak256Hash(common.BigToHash(big.NewInt(int64(j))).Bytes())
+				val, err := rlp.EncodeToBytes(big.NewInt(int64(i*testSlots + j + 1)))
+				if err != nil {
+					t.Fatalf("unable to RLP encode slot value: %v", err)
+				}
+				acct.storage[slotHash] = val
+				storageBuilder.Put(slotHash.Bytes(), val)
+			}
+			storageRoot, err := storageBuilder.Commit(ipld.LinkContext{})
+			if err != nil {
+				t.Fatalf("unable to commit storage trie: %v", err)
+			}
+			decodedMh, err := multihash.Decode(storageRoot.Hash())
+			if err != nil {
+				t.Fatalf("unable to decode storage root multihash: %v", err)
+			}
+			acct.account.Root = common.BytesToHash(decodedMh.Digest)
+			acct.account.CodeHash = crypto.Keccak256([]byte{byte(i)})
+		}
+		acctRLP, err := rlp.EncodeToBytes(acct.account)
+		if err != nil {
+			t.Fatalf("unable to RLP encode account: %v", err)
+		}
+		stateBuilder.Put(shared.AddressToLeafKey(addr), acctRLP)
+		accounts[i] = acct
+	}
+	stateRoot, err := stateBuilder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit state trie: %v", err)
+	}
+	return lsys, stateRoot, accounts
+}
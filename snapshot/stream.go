@@ -0,0 +1,54 @@
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// StreamWriter is an ethdb.KeyValueWriter that writes each entry to an io.Writer as an RLP list of its key and value.
+// It provides a portable file format for exports, which Import loads into a geth database.
+type StreamWriter struct {
+	w io.Writer
+}
+
+// NewStreamWriter returns a StreamWriter writing to the provided writer
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: w}
+}
+
+// Put satisfies the ethdb.KeyValueWriter interface
+func (s *StreamWriter) Put(key, value []byte) error {
+	return rlp.Encode(s.w, [][]byte{key, value})
+}
+
+// Delete satisfies the ethdb.KeyValueWriter interface, streams only hold the entries put into them
+func (s *StreamWriter) Delete(key []byte) error {
+	return errors.New("snapshot streams do not support deletes")
+}
+
+// Import reads the entries written by a StreamWriter and puts them into the database, returning the number of
+// entries imported
+func Import(r io.Reader, db ethdb.KeyValueWriter) (int, error) {
+	stream := rlp.NewStream(r, 0)
+	var count int
+	for {
+		var entry [][]byte
+		if err := stream.Decode(&entry); err != nil {
+			if err == io.EOF {
+				return count, nil
+			}
+			return count, fmt.Errorf("unable to decode snapshot stream entry %d: %v", count, err)
+		}
+		if len(entry) != 2 {
+			return count, fmt.Errorf("snapshot stream entry %d has %d members; expected 2", count, len(entry))
+		}
+		if err := db.Put(entry[0], entry[1]); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
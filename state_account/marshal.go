@@ -1,17 +1,14 @@
 package account
 
 import (
-	"encoding/binary"
-	"fmt"
 	"io"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
-	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
-	"github.com/multiformats/go-multihash"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
 	"github.com/vulcanize/go-codec-dageth/shared"
@@ -42,100 +39,65 @@ func AppendEncode(enc []byte, inNode ipld.Node) ([]byte, error) {
 	}
 	wbs := shared.NewWriteableByteSlice(&enc)
 	if err := rlp.Encode(wbs, account); err != nil {
-		return enc, fmt.Errorf("invalid DAG-ETH Account form (unable to RLP encode account: %v)", err)
+		return enc, dageth.ErrInvalidForm{Type: "Account", Err: err}
 	}
 	return enc, nil
 }
 
 // EncodeAccount packs the node into the provided go-ethereum Account
 func EncodeAccount(header *types.StateAccount, inNode ipld.Node) error {
-	// Wrap in a typed node for some basic schema form checking
-	builder := dageth.Type.Account.NewBuilder()
-	if err := builder.AssignNode(inNode); err != nil {
-		return err
+	// Wrap in a typed node for some basic schema form checking, unless it is one already
+	node, ok := inNode.(dageth.Account)
+	if !ok {
+		builder := dageth.Type.Account.NewBuilder()
+		if err := builder.AssignNode(inNode); err != nil {
+			return dageth.ErrInvalidForm{Type: "Account", Err: err}
+		}
+		node = builder.Build().(dageth.Account)
 	}
-	node := builder.Build()
 	for _, pFunc := range requiredPackFuncs {
 		if err := pFunc(header, node); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Account form (%v)", err)
+			return dageth.ErrInvalidForm{Type: "Account", Err: err}
 		}
 	}
 	return nil
 }
 
-var requiredPackFuncs = []func(*types.StateAccount, ipld.Node) error{
+var requiredPackFuncs = []func(*types.StateAccount, dageth.Account) error{
 	packNonce,
 	packBalance,
 	packStorageRootCID,
 	packCodeCID,
 }
 
-func packNonce(account *types.StateAccount, node ipld.Node) error {
-	n, err := node.LookupByString("Nonce")
-	if err != nil {
-		return err
-	}
-	nBytes, err := n.AsBytes()
+func packNonce(account *types.StateAccount, node dageth.Account) error {
+	v, err := shared.Uint64FromBytes(node.FieldNonce().Bytes())
 	if err != nil {
-		return err
+		return dageth.ErrInvalidField{Type: "Account", Field: "Nonce", Reason: "must be 8 bytes"}
 	}
-	account.Nonce = binary.BigEndian.Uint64(nBytes)
+	account.Nonce = v
 	return nil
 }
 
-func packBalance(account *types.StateAccount, node ipld.Node) error {
-	b, err := node.LookupByString("Balance")
-	if err != nil {
-		return err
-	}
-	bBytes, err := b.AsBytes()
-	if err != nil {
-		return err
-	}
-	account.Balance = new(big.Int).SetBytes(bBytes)
+func packBalance(account *types.StateAccount, node dageth.Account) error {
+	account.Balance = new(big.Int).SetBytes(node.FieldBalance().Bytes())
 	return nil
 }
 
-func packStorageRootCID(account *types.StateAccount, node ipld.Node) error {
-	srCID, err := node.LookupByString("StorageRootCID")
+func packStorageRootCID(account *types.StateAccount, node dageth.Account) error {
+	digest, err := dageth.LinkDigest(node.FieldStorageRootCID().Link(), cid.EthStorageTrie)
 	if err != nil {
-		return err
+		return dageth.ErrInvalidField{Type: "Account", Field: "StorageRootCID", Reason: "is not a valid link", Err: err}
 	}
-	srLink, err := srCID.AsLink()
-	if err != nil {
-		return err
-	}
-	srCIDLink, ok := srLink.(cidlink.Link)
-	if !ok {
-		return fmt.Errorf("account must have a StateRootCID")
-	}
-	srMh := srCIDLink.Hash()
-	decodedSrMh, err := multihash.Decode(srMh)
-	if err != nil {
-		return fmt.Errorf("unable to decode StorageRootCID multihash: %v", err)
-	}
-	account.Root = common.BytesToHash(decodedSrMh.Digest)
+	account.Root = common.BytesToHash(digest)
 	return nil
 }
 
-func packCodeCID(account *types.StateAccount, node ipld.Node) error {
-	cCID, err := node.LookupByString("CodeCID")
-	if err != nil {
-		return err
-	}
-	cLink, err := cCID.AsLink()
-	if err != nil {
-		return err
-	}
-	cCIDLink, ok := cLink.(cidlink.Link)
-	if !ok {
-		return fmt.Errorf("account must have a CodeCID")
-	}
-	cMh := cCIDLink.Hash()
-	decodedCMh, err := multihash.Decode(cMh)
+func packCodeCID(account *types.StateAccount, node dageth.Account) error {
+	digest, err := dageth.LinkDigest(node.FieldCodeCID().Link(), cid.Raw)
 	if err != nil {
-		return fmt.Errorf("unable to decode CodeCID multihash: %v", err)
+		return dageth.ErrInvalidField{Type: "Account", Field: "CodeCID", Reason: "is not a valid link", Err: err}
 	}
-	account.CodeHash = decodedCMh.Digest
+	account.CodeHash = digest
 	return nil
 }
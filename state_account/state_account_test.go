@@ -14,6 +14,7 @@ import (
 	"github.com/multiformats/go-multihash"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared/fuzztest"
 	account "github.com/vulcanize/go-codec-dageth/state_account"
 )
 
@@ -141,3 +142,18 @@ func testAccountEncode(t *testing.T) {
 		t.Errorf("state account encoding (%x) does not match the expected RLP encoding (%x)", encodedAccountBytes, accountRLP)
 	}
 }
+
+func FuzzDecodeBytes(f *testing.F) {
+	enc, err := rlp.EncodeToBytes(mockAccount)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(enc)
+	codec, ok := dageth.LookupCodec(account.MultiCodecType)
+	if !ok {
+		f.Fatal("codec is not in the dageth.Codecs table")
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzztest.FuzzRoundTrip(t, codec, data)
+	})
+}
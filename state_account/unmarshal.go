@@ -1,10 +1,9 @@
 package account
 
 import (
+	"bytes"
 	"encoding/binary"
-	"fmt"
 	"io"
-	"io/ioutil"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -12,34 +11,74 @@ import (
 	"github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
 )
 
 // Decode provides an IPLD codec decode interface for eth state account IPLDs.
 // This function is registered via the go-ipld-prime link loader for multicodec
 // code 0x97 when this package is invoked via init.
 func Decode(na ipld.NodeAssembler, in io.Reader) error {
-	var src []byte
 	if buf, ok := in.(interface{ Bytes() []byte }); ok {
-		src = buf.Bytes()
-	} else {
-		var err error
-		src, err = ioutil.ReadAll(in)
-		if err != nil {
-			return err
-		}
+		return DecodeBytes(na, buf.Bytes())
+	}
+	trailing, err := shared.StreamDecode(in, func(s *rlp.Stream) error {
+		return decodeAccountStream(na, s)
+	})
+	if err != nil {
+		return dageth.ErrInvalidBinary{Type: "Account", Err: err}
 	}
-	return DecodeBytes(na, src)
+	if trailing > 0 {
+		return dageth.ErrInvalidBinary{Type: "Account", Err: rlp.ErrMoreThanOneValue}
+	}
+	return nil
 }
 
 // DecodeBytes is like Decode, but it uses an input buffer directly.
-// Decode will grab or read all the bytes from an io.Reader anyway, so this can
-// save having to copy the bytes or create a bytes.Buffer.
+// Decode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
 func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
-	var account types.StateAccount
-	if err := rlp.DecodeBytes(src, &account); err != nil {
+	return Decode(na, bytes.NewReader(src))
+}
+
+// decodeAccountStream reads the account fields from the stream one by one, assembling each as it is read
+func decodeAccountStream(na ipld.NodeAssembler, s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	ma, err := na.BeginMap(4)
+	if err != nil {
+		return err
+	}
+	if field, err := shared.UnpackStreamFields(ma, s, streamFields); err != nil {
+		return dageth.ErrInvalidField{Type: "Account", Field: field, Reason: "is malformed", Err: err}
+	}
+	if err := s.ListEnd(); err != nil {
+		return err
+	}
+	return ma.Finish()
+}
+
+// streamFields are the fields of the account, in the order of its RLP encoding
+var streamFields = []shared.StreamField{
+	{Name: "Nonce", Unpack: shared.StreamUint},
+	{Name: "Balance", Unpack: shared.StreamBigInt},
+	{Name: "StorageRootCID", Unpack: shared.StreamLink(cid.EthStorageTrie)},
+	{Name: "CodeCID", Unpack: unpackStreamCodeCID},
+}
+
+// unpackStreamCodeCID assigns the code hash as a link to the raw code; go-ethereum does not fix the size of the hash
+func unpackStreamCodeCID(na ipld.NodeAssembler, s *rlp.Stream) error {
+	codeHash, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	cMh, err := multihash.Encode(codeHash, MultiHashType)
+	if err != nil {
 		return err
 	}
-	return DecodeAccount(na, account)
+	return na.AssignLink(cidlink.Link{Cid: cid.NewCidV1(cid.Raw, cMh)})
 }
 
 // DecodeAccount unpacks a go-ethereum Account into a NodeAssembler
@@ -50,7 +89,7 @@ func DecodeAccount(na ipld.NodeAssembler, header types.StateAccount) error {
 	}
 	for _, upFunc := range requiredUnpackFuncs {
 		if err := upFunc(ma, header); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Account binary (%v)", err)
+			return dageth.ErrInvalidBinary{Type: "Account", Err: err}
 		}
 	}
 	return ma.Finish()
@@ -77,7 +116,7 @@ func unpackNonce(ma ipld.MapAssembler, account types.StateAccount) error {
 
 func unpackBalance(ma ipld.MapAssembler, account types.StateAccount) error {
 	if account.Balance == nil {
-		return fmt.Errorf("account balance cannot be null")
+		return dageth.ErrInvalidField{Type: "Account", Field: "Balance", Reason: "cannot be nil"}
 	}
 	if err := ma.AssembleKey().AssignString("Balance"); err != nil {
 		return err
@@ -0,0 +1,147 @@
+package state_trie
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+	dageth_trie "github.com/vulcanize/go-codec-dageth/trie"
+)
+
+// DiffKind describes how an account or storage slot changed between two state roots
+type DiffKind string
+
+const (
+	CREATED  DiffKind = "created"
+	DELETED  DiffKind = "deleted"
+	MODIFIED DiffKind = "modified"
+)
+
+func (d DiffKind) String() string {
+	return string(d)
+}
+
+// AccountDiff describes an account that differs between two state roots
+type AccountDiff struct {
+	// LeafKey is the keccak256 hash of the account address
+	LeafKey common.Hash
+	Kind    DiffKind
+	// Old and New are the Account nodes before and after the change, Old is nil for created accounts
+	// and New is nil for deleted accounts
+	Old, New ipld.Node
+	// Storage holds the slot changes of the account, when its StorageRootCID changed
+	Storage []StorageDiff
+}
+
+// StorageDiff describes a storage slot that differs between two storage roots
+type StorageDiff struct {
+	// LeafKey is the keccak256 hash of the storage slot
+	LeafKey common.Hash
+	Kind    DiffKind
+	// Old and New are the RLP encoded slot values before and after the change, Old is nil for created slots
+	// and New is nil for deleted slots
+	Old, New []byte
+}
+
+// Diff walks the state tries rooted at the two CIDs in parallel and returns the accounts that were created, deleted
+// or modified between them, in leaf key order. Subtries referenced by the same CID in both tries are skipped.
+// Whenever an account's StorageRootCID differs between the two states, including for created and deleted accounts
+// with non-empty storage, the storage tries are diffed as well and the slot changes are reported with the account.
+func Diff(ctx context.Context, lsys ipld.LinkSystem, oldRoot, newRoot cid.Cid) ([]AccountDiff, error) {
+	if oldRoot.Prefix().Codec != MultiCodecType || newRoot.Prefix().Codec != MultiCodecType {
+		return nil, fmt.Errorf("state diff requires state trie root CIDs")
+	}
+	lnkCtx := ipld.LinkContext{Ctx: ctx}
+	var diffs []AccountDiff
+	err := dageth_trie.Diff(lnkCtx, lsys, oldRoot, newRoot, func(key []byte, oldVal, newVal ipld.Node) error {
+		diff := AccountDiff{
+			LeafKey: common.BytesToHash(key),
+			Kind:    diffKind(oldVal, newVal),
+			Old:     oldVal,
+			New:     newVal,
+		}
+		oldStorageRoot, err := storageRootCID(oldVal)
+		if err != nil {
+			return err
+		}
+		newStorageRoot, err := storageRootCID(newVal)
+		if err != nil {
+			return err
+		}
+		if !oldStorageRoot.Equals(newStorageRoot) {
+			diff.Storage, err = diffStorage(lnkCtx, lsys, oldStorageRoot, newStorageRoot)
+			if err != nil {
+				return fmt.Errorf("unable to diff storage of account %x: %v", key, err)
+			}
+		}
+		diffs = append(diffs, diff)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return diffs, nil
+}
+
+func diffStorage(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, oldRoot, newRoot cid.Cid) ([]StorageDiff, error) {
+	var diffs []StorageDiff
+	err := dageth_trie.Diff(lnkCtx, lsys, oldRoot, newRoot, func(key []byte, oldVal, newVal ipld.Node) error {
+		diff := StorageDiff{
+			LeafKey: common.BytesToHash(key),
+			Kind:    diffKind(oldVal, newVal),
+		}
+		var err error
+		if oldVal != nil {
+			if diff.Old, err = oldVal.AsBytes(); err != nil {
+				return err
+			}
+		}
+		if newVal != nil {
+			if diff.New, err = newVal.AsBytes(); err != nil {
+				return err
+			}
+		}
+		diffs = append(diffs, diff)
+		return nil
+	})
+	return diffs, err
+}
+
+func diffKind(oldVal, newVal ipld.Node) DiffKind {
+	switch {
+	case oldVal == nil:
+		return CREATED
+	case newVal == nil:
+		return DELETED
+	default:
+		return MODIFIED
+	}
+}
+
+var emptyStorageRootCID = shared.Keccak256ToCid(cid.EthStorageTrie, types.EmptyRootHash.Bytes())
+
+// storageRootCID returns the StorageRootCID of the Account node, or the empty storage root CID if there is no account
+func storageRootCID(accountNode ipld.Node) (cid.Cid, error) {
+	if accountNode == nil {
+		return emptyStorageRootCID, nil
+	}
+	srNode, err := accountNode.LookupByString("StorageRootCID")
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	srLink, err := srNode.AsLink()
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	srCIDLink, ok := srLink.(cidlink.Link)
+	if !ok {
+		return cid.Cid{}, fmt.Errorf("account StorageRootCID needs to be a CID")
+	}
+	return srCIDLink.Cid, nil
+}
@@ -0,0 +1,175 @@
+package state_trie_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+	"github.com/multiformats/go-multihash"
+
+	"github.com/vulcanize/go-codec-dageth/state_trie"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+type testAccount struct {
+	balance int64
+	storage map[common.Hash][]byte
+}
+
+func TestStateDiff(t *testing.T) {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+
+	slot := func(i int64) common.Hash { return crypto.Keccak256Hash(common.BigToHash(big.NewInt(i)).Bytes()) }
+	value := func(i int64) []byte { v, _ := rlp.EncodeToBytes(uint64(i)); return v }
+	account := func(i int64) common.Hash { return crypto.Keccak256Hash(common.BigToAddress(big.NewInt(i)).Bytes()) }
+
+	oldState := make(map[common.Hash]testAccount)
+	newState := make(map[common.Hash]testAccount)
+	for i := int64(0); i < 100; i++ {
+		storage := make(map[common.Hash][]byte)
+		if i%10 == 0 {
+			for j := int64(1); j < 20; j++ {
+				storage[slot(j)] = value(i + j)
+			}
+		}
+		oldState[account(i)] = testAccount{balance: i, storage: storage}
+		newState[account(i)] = testAccount{balance: i, storage: storage}
+	}
+	// modify the balance of account 1
+	newState[account(1)] = testAccount{balance: 1001, storage: oldState[account(1)].storage}
+	// modify, create and delete storage slots of account 10
+	modifiedStorage := make(map[common.Hash][]byte)
+	for k, v := range oldState[account(10)].storage {
+		modifiedStorage[k] = v
+	}
+	modifiedStorage[slot(1)] = value(1337)
+	modifiedStorage[slot(100)] = value(100)
+	delete(modifiedStorage, slot(2))
+	newState[account(10)] = testAccount{balance: 10, storage: modifiedStorage}
+	// delete account 20, along with its storage
+	delete(newState, account(20))
+	// create account 100
+	newState[account(100)] = testAccount{balance: 100, storage: map[common.Hash][]byte{}}
+
+	oldRoot := buildState(t, lsys, oldState)
+	newRoot := buildState(t, lsys, newState)
+
+	diffs, err := state_trie.Diff(context.Background(), lsys, oldRoot, newRoot)
+	if err != nil {
+		t.Fatalf("unable to diff state tries: %v", err)
+	}
+	expected := map[common.Hash]state_trie.DiffKind{
+		account(1):   state_trie.MODIFIED,
+		account(10):  state_trie.MODIFIED,
+		account(20):  state_trie.DELETED,
+		account(100): state_trie.CREATED,
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("expected %d account diffs; got %d", len(expected), len(diffs))
+	}
+	for i, diff := range diffs {
+		if i > 0 && bytes.Compare(diffs[i-1].LeafKey.Bytes(), diff.LeafKey.Bytes()) >= 0 {
+			t.Errorf("account diffs should be in ascending leaf key order")
+		}
+		kind, ok := expected[diff.LeafKey]
+		if !ok {
+			t.Fatalf("unexpected account diff for %x", diff.LeafKey)
+		}
+		if diff.Kind != kind {
+			t.Errorf("account %x diff kind (%s) does not match expected kind (%s)", diff.LeafKey, diff.Kind, kind)
+		}
+		switch diff.LeafKey {
+		case account(1):
+			if len(diff.Storage) != 0 {
+				t.Errorf("account with unchanged storage should not report storage diffs; got %d", len(diff.Storage))
+			}
+		case account(10):
+			expectedStorage := map[common.Hash]state_trie.DiffKind{
+				slot(1):   state_trie.MODIFIED,
+				slot(2):   state_trie.DELETED,
+				slot(100): state_trie.CREATED,
+			}
+			if len(diff.Storage) != len(expectedStorage) {
+				t.Fatalf("expected %d storage diffs; got %d", len(expectedStorage), len(diff.Storage))
+			}
+			for _, storageDiff := range diff.Storage {
+				if storageDiff.Kind != expectedStorage[storageDiff.LeafKey] {
+					t.Errorf("slot %x diff kind (%s) does not match expected kind (%s)", storageDiff.LeafKey, storageDiff.Kind, expectedStorage[storageDiff.LeafKey])
+				}
+				if storageDiff.LeafKey == slot(1) && !bytes.Equal(storageDiff.New, value(1337)) {
+					t.Errorf("slot %x new value (%x) does not match expected value (%x)", storageDiff.LeafKey, storageDiff.New, value(1337))
+				}
+			}
+		case account(20):
+			if diff.New != nil {
+				t.Errorf("deleted account should not have a new value")
+			}
+			if len(diff.Storage) != len(oldState[account(20)].storage) {
+				t.Errorf("deleted account should report %d deleted slots; got %d", len(oldState[account(20)].storage), len(diff.Storage))
+			}
+		case account(100):
+			if diff.Old != nil {
+				t.Errorf("created account should not have an old value")
+			}
+		}
+	}
+
+	noDiffs, err := state_trie.Diff(context.Background(), lsys, oldRoot, oldRoot)
+	if err != nil {
+		t.Fatalf("unable to diff state trie against itself: %v", err)
+	}
+	if len(noDiffs) != 0 {
+		t.Errorf("diffing a state trie against itself should report no diffs; got %d", len(noDiffs))
+	}
+}
+
+func buildState(t *testing.T, lsys ipld.LinkSystem, state map[common.Hash]testAccount) cid.Cid {
+	stateBuilder, err := trie.NewBuilder(lsys, cid.EthStateTrie)
+	if err != nil {
+		t.Fatalf("unable to create state trie builder: %v", err)
+	}
+	for key, acct := range state {
+		storageBuilder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+		if err != nil {
+			t.Fatalf("unable to create storage trie builder: %v", err)
+		}
+		for slot, val := range acct.storage {
+			storageBuilder.Put(slot.Bytes(), val)
+		}
+		storageRoot, err := storageBuilder.Commit(ipld.LinkContext{})
+		if err != nil {
+			t.Fatalf("unable to commit storage trie: %v", err)
+		}
+		decodedMh, err := multihash.Decode(storageRoot.Hash())
+		if err != nil {
+			t.Fatalf("unable to decode storage root multihash: %v", err)
+		}
+		accountRLP, err := rlp.EncodeToBytes(&types.StateAccount{
+			Nonce:    1,
+			Balance:  big.NewInt(acct.balance),
+			Root:     common.BytesToHash(decodedMh.Digest),
+			CodeHash: crypto.Keccak256(nil),
+		})
+		if err != nil {
+			t.Fatalf("unable to RLP encode account: %v", err)
+		}
+		stateBuilder.Put(key.Bytes(), accountRLP)
+	}
+	root, err := stateBuilder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit state trie: %v", err)
+	}
+	return root
+}
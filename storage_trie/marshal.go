@@ -11,15 +11,15 @@ import (
 // Encode provides an IPLD codec encode interface for eth storage trie node IPLDs.
 // This function is registered via the go-ipld-prime link loader for multicodec
 // code 0x98 when this package is invoked via init.
-// This is a pure wrapping around dageth_trie.Encode to expose it from this package
+// This simply wraps dageth_trie.EncodeTrieNode with the proper multicodec type
 func Encode(node ipld.Node, w io.Writer) error {
-	return dageth_trie.Encode(node, w)
+	return dageth_trie.EncodeTrieNode(node, w, MultiCodecType)
 }
 
 // AppendEncode is like Encode, but it uses a destination buffer directly.
 // This means less copying of bytes, and if the destination has enough capacity,
 // fewer allocations.
-// This is a pure wrapping around dageth_trie.AppendEncode to expose it from this package
+// This simply wraps dageth_trie.AppendEncodeTrieNode with the proper multicodec type
 func AppendEncode(enc []byte, inNode ipld.Node) ([]byte, error) {
-	return dageth_trie.AppendEncode(enc, inNode)
+	return dageth_trie.AppendEncodeTrieNode(enc, inNode, MultiCodecType)
 }
@@ -17,8 +17,8 @@ func Decode(na ipld.NodeAssembler, in io.Reader) error {
 }
 
 // DecodeBytes is like Decode, but it uses an input buffer directly.
-// Decode will grab or read all the bytes from an io.Reader anyway, so this can
-// save having to copy the bytes or create a bytes.Buffer.
+// Decode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
 // This simply wraps dageth_trie.DecodeTrieNodeBytes with the proper multicodec type
 func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
 	return dageth_trie.DecodeTrieNodeBytes(na, src, MultiCodecType)
@@ -0,0 +1,159 @@
+// Package storagelayout resolves the named state variables of a Solidity contract in its storage trie, using the
+// storage layout emitted by solc (solc --storage-layout), and decodes their values into Go types.
+package storagelayout
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Layout is the storage layout of a contract, as emitted by solc
+type Layout struct {
+	Storage []Variable      `json:"storage"`
+	Types   map[string]Type `json:"types"`
+}
+
+// Variable is a state variable, or a member of a struct, in a storage layout
+type Variable struct {
+	Label  string `json:"label"`
+	Offset int    `json:"offset"`
+	Slot   string `json:"slot"`
+	Type   string `json:"type"`
+}
+
+// Type is a type in a storage layout
+type Type struct {
+	Encoding      string     `json:"encoding"`
+	Label         string     `json:"label"`
+	NumberOfBytes string     `json:"numberOfBytes"`
+	Key           string     `json:"key,omitempty"`
+	Value         string     `json:"value,omitempty"`
+	Base          string     `json:"base,omitempty"`
+	Members       []Variable `json:"members,omitempty"`
+}
+
+// type encodings used by solc
+const (
+	encodingInplace      = "inplace"
+	encodingMapping      = "mapping"
+	encodingDynamicArray = "dynamic_array"
+	encodingBytes        = "bytes"
+)
+
+// ParseLayout parses a solc storage layout JSON document, checking that every type it references is defined
+func ParseLayout(data []byte) (*Layout, error) {
+	layout := new(Layout)
+	if err := json.Unmarshal(data, layout); err != nil {
+		return nil, fmt.Errorf("unable to parse storage layout: %v", err)
+	}
+	check := func(id string) error {
+		if _, ok := layout.Types[id]; !ok {
+			return fmt.Errorf("storage layout is missing type %s", id)
+		}
+		return nil
+	}
+	for _, v := range layout.Storage {
+		if err := check(v.Type); err != nil {
+			return nil, err
+		}
+		if _, err := v.slot(); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range layout.Types {
+		for _, id := range []string{t.Key, t.Value, t.Base} {
+			if id == "" {
+				continue
+			}
+			if err := check(id); err != nil {
+				return nil, err
+			}
+		}
+		for _, m := range t.Members {
+			if err := check(m.Type); err != nil {
+				return nil, err
+			}
+			if _, err := m.slot(); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := t.size(); err != nil {
+			return nil, err
+		}
+	}
+	return layout, nil
+}
+
+// Variable returns the state variable with the provided label
+func (l *Layout) Variable(label string) (Variable, bool) {
+	for _, v := range l.Storage {
+		if v.Label == label {
+			return v, true
+		}
+	}
+	return Variable{}, false
+}
+
+// slot returns the slot of the variable, relative to its enclosing struct for struct members
+func (v Variable) slot() (*big.Int, error) {
+	slot, ok := new(big.Int).SetString(v.Slot, 10)
+	if !ok {
+		return nil, fmt.Errorf("variable %s has invalid slot %q", v.Label, v.Slot)
+	}
+	return slot, nil
+}
+
+// size returns the number of bytes the type occupies in storage
+func (t Type) size() (int, error) {
+	size, err := strconv.Atoi(t.NumberOfBytes)
+	if err != nil {
+		return 0, fmt.Errorf("type %s has invalid number of bytes %q", t.Label, t.NumberOfBytes)
+	}
+	return size, nil
+}
+
+// valueKind classifies the value types that fit in a single slot
+type valueKind int
+
+const (
+	unsupportedKind valueKind = iota
+	boolKind
+	addressKind
+	uintKind
+	intKind
+	fixedBytesKind
+)
+
+var staticArrayLength = regexp.MustCompile(`\)(\d+)_storage$`)
+
+// kind returns the kind of a value type from its type ID, such as t_uint256 or t_contract(Token)12
+func kind(id string) valueKind {
+	switch {
+	case id == "t_bool":
+		return boolKind
+	case strings.HasPrefix(id, "t_address"), strings.HasPrefix(id, "t_contract("):
+		return addressKind
+	case strings.HasPrefix(id, "t_uint"), strings.HasPrefix(id, "t_enum("):
+		return uintKind
+	case strings.HasPrefix(id, "t_int"):
+		return intKind
+	case strings.HasPrefix(id, "t_bytes") && id != "t_bytes_storage" && id != "t_bytes_memory_ptr":
+		return fixedBytesKind
+	default:
+		return unsupportedKind
+	}
+}
+
+// arrayLength returns the length of a static array type from its type ID, such as t_array(t_uint256)3_storage
+func arrayLength(id string) (int64, bool) {
+	m := staticArrayLength.FindStringSubmatch(id)
+	if m == nil {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	return n, err == nil
+}
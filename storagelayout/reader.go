@@ -0,0 +1,442 @@
+package storagelayout
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+// MaxLength is the largest length of a dynamic array, string or bytes value Get will decode in full
+const MaxLength = 1 << 16
+
+var (
+	two256 = new(big.Int).Lsh(big.NewInt(1), 256)
+	// uint256Max is the largest value a slot can hold
+	uint256Max = new(big.Int).Sub(two256, big.NewInt(1))
+)
+
+// Reader reads the state variables of a contract from its storage trie
+type Reader struct {
+	lnkCtx ipld.LinkContext
+	lsys   ipld.LinkSystem
+	layout *Layout
+	root   cid.Cid
+	words  map[common.Hash]common.Hash
+}
+
+// NewReader returns a Reader for the storage trie with the provided StorageRootCID, loading trie nodes from the
+// LinkSystem and resolving variables with the provided layout
+func NewReader(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, layout *Layout, storageRoot cid.Cid) (*Reader, error) {
+	if storageRoot.Prefix().Codec != cid.EthStorageTrie {
+		return nil, fmt.Errorf("storage layout reader requires a storage trie root CID; got multicodec type (%d)", storageRoot.Prefix().Codec)
+	}
+	return &Reader{
+		lnkCtx: lnkCtx,
+		lsys:   lsys,
+		layout: layout,
+		root:   storageRoot,
+		words:  make(map[common.Hash]common.Hash),
+	}, nil
+}
+
+// Get resolves the state variable with the provided label, following the path into it, and returns its value.
+// Each path element indexes into the type reached so far: a mapping key for mappings, an index for static and
+// dynamic arrays, and a member label for structs. Mapping keys can be Go values of the key type: a bool, a
+// common.Address or hex string for addresses, a *big.Int or Go integer for integers and enums, a []byte or
+// common.Hash for fixed-size bytes, and a string or []byte for string and bytes keys. Indexes can be a *big.Int or
+// Go integer.
+// Values are returned as a bool, common.Address, *big.Int for integers and enums, []byte for fixed-size bytes and
+// bytes, string for strings, []interface{} for arrays and map[string]interface{} for structs.
+// Slots that are not present in the storage trie hold zero.
+func (r *Reader) Get(label string, path ...interface{}) (interface{}, error) {
+	v, ok := r.layout.Variable(label)
+	if !ok {
+		return nil, fmt.Errorf("storage layout has no variable %s", label)
+	}
+	slot, err := v.slot()
+	if err != nil {
+		return nil, err
+	}
+	typeID, offset := v.Type, v.Offset
+	for _, p := range path {
+		t := r.layout.Types[typeID]
+		switch {
+		case t.Encoding == encodingMapping:
+			key, err := encodeKey(t.Key, r.layout.Types[t.Key], p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid key for %s: %v", t.Label, err)
+			}
+			slot = new(big.Int).SetBytes(crypto.Keccak256(key, slotWord(slot)))
+			typeID, offset = t.Value, 0
+		case t.Encoding == encodingDynamicArray:
+			length, err := r.length(slot)
+			if err != nil {
+				return nil, err
+			}
+			index, err := toIndex(p, length)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index for %s: %v", t.Label, err)
+			}
+			if slot, offset, err = r.element(dataSlot(slot), t.Base, index); err != nil {
+				return nil, err
+			}
+			typeID = t.Base
+		case t.Encoding == encodingInplace && t.Base != "":
+			length, ok := arrayLength(typeID)
+			if !ok {
+				return nil, fmt.Errorf("unable to determine the length of %s", t.Label)
+			}
+			index, err := toIndex(p, big.NewInt(length))
+			if err != nil {
+				return nil, fmt.Errorf("invalid index for %s: %v", t.Label, err)
+			}
+			if slot, offset, err = r.element(slot, t.Base, index); err != nil {
+				return nil, err
+			}
+			typeID = t.Base
+		case t.Encoding == encodingInplace && len(t.Members) > 0:
+			name, ok := p.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s requires a member label; got %T", t.Label, p)
+			}
+			member, ok := findMember(t.Members, name)
+			if !ok {
+				return nil, fmt.Errorf("%s has no member %s", t.Label, name)
+			}
+			memberSlot, err := member.slot()
+			if err != nil {
+				return nil, err
+			}
+			slot = addSlot(slot, memberSlot)
+			typeID, offset = member.Type, member.Offset
+		default:
+			return nil, fmt.Errorf("%s cannot be indexed", t.Label)
+		}
+	}
+	return r.decode(slot, offset, typeID)
+}
+
+// decode decodes the value of the type stored at the slot and byte offset
+func (r *Reader) decode(slot *big.Int, offset int, typeID string) (interface{}, error) {
+	t := r.layout.Types[typeID]
+	switch {
+	case t.Encoding == encodingMapping:
+		return nil, fmt.Errorf("%s requires a key", t.Label)
+	case t.Encoding == encodingBytes:
+		data, err := r.bytes(slot)
+		if err != nil {
+			return nil, err
+		}
+		if t.Label == "string" {
+			return string(data), nil
+		}
+		return data, nil
+	case t.Encoding == encodingDynamicArray:
+		length, err := r.length(slot)
+		if err != nil {
+			return nil, err
+		}
+		if length.Cmp(big.NewInt(MaxLength)) > 0 {
+			return nil, fmt.Errorf("%s length %s exceeds the maximum decodable length", t.Label, length.String())
+		}
+		return r.array(dataSlot(slot), t.Base, length.Int64())
+	case t.Encoding == encodingInplace && t.Base != "":
+		length, ok := arrayLength(typeID)
+		if !ok {
+			return nil, fmt.Errorf("unable to determine the length of %s", t.Label)
+		}
+		if length > MaxLength {
+			return nil, fmt.Errorf("%s length %d exceeds the maximum decodable length", t.Label, length)
+		}
+		return r.array(slot, t.Base, length)
+	case t.Encoding == encodingInplace && len(t.Members) > 0:
+		members := make(map[string]interface{}, len(t.Members))
+		for _, m := range t.Members {
+			memberSlot, err := m.slot()
+			if err != nil {
+				return nil, err
+			}
+			val, err := r.decode(addSlot(slot, memberSlot), m.Offset, m.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", t.Label, m.Label, err)
+			}
+			members[m.Label] = val
+		}
+		return members, nil
+	case t.Encoding == encodingInplace:
+		size, err := t.size()
+		if err != nil {
+			return nil, err
+		}
+		if offset < 0 || size < 1 || offset+size > common.HashLength {
+			return nil, fmt.Errorf("%s does not fit in a slot at offset %d", t.Label, offset)
+		}
+		word, err := r.word(slot)
+		if err != nil {
+			return nil, err
+		}
+		return decodeValue(typeID, word[common.HashLength-offset-size:common.HashLength-offset])
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q of %s", t.Encoding, t.Label)
+	}
+}
+
+// array decodes the elements of an array whose data starts at the slot
+func (r *Reader) array(slot *big.Int, baseID string, length int64) ([]interface{}, error) {
+	elements := make([]interface{}, length)
+	for i := range elements {
+		elemSlot, offset, err := r.element(slot, baseID, big.NewInt(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		if elements[i], err = r.decode(elemSlot, offset, baseID); err != nil {
+			return nil, fmt.Errorf("element %d: %v", i, err)
+		}
+	}
+	return elements, nil
+}
+
+// element returns the slot and byte offset of the element at the index of an array whose data starts at the slot.
+// Value type elements are packed as many to a slot as fit, all other elements start a new slot.
+func (r *Reader) element(slot *big.Int, baseID string, index *big.Int) (*big.Int, int, error) {
+	base := r.layout.Types[baseID]
+	size, err := base.size()
+	if err != nil {
+		return nil, 0, err
+	}
+	if size < 1 {
+		return nil, 0, fmt.Errorf("%s has no size", base.Label)
+	}
+	if base.Encoding == encodingInplace && base.Base == "" && len(base.Members) == 0 && size <= common.HashLength {
+		perSlot := big.NewInt(int64(common.HashLength / size))
+		slotIndex, elemIndex := new(big.Int).DivMod(index, perSlot, new(big.Int))
+		return addSlot(slot, slotIndex), int(elemIndex.Int64()) * size, nil
+	}
+	slots := big.NewInt(int64((size + common.HashLength - 1) / common.HashLength))
+	return addSlot(slot, new(big.Int).Mul(index, slots)), 0, nil
+}
+
+// length returns the length of the dynamic array stored at the slot
+func (r *Reader) length(slot *big.Int) (*big.Int, error) {
+	word, err := r.word(slot)
+	if err != nil {
+		return nil, err
+	}
+	return word.Big(), nil
+}
+
+// bytes returns the data of the string or bytes value stored at the slot.
+// Values shorter than 32 bytes are stored in the slot with twice their length in the lowest byte, longer values
+// store twice their length plus one in the slot and their data in consecutive slots starting at keccak256(slot).
+func (r *Reader) bytes(slot *big.Int) ([]byte, error) {
+	word, err := r.word(slot)
+	if err != nil {
+		return nil, err
+	}
+	if word[common.HashLength-1]&1 == 0 {
+		length := int(word[common.HashLength-1] / 2)
+		if length >= common.HashLength {
+			return nil, fmt.Errorf("invalid short string length %d", length)
+		}
+		return common.CopyBytes(word[:length]), nil
+	}
+	length := new(big.Int).Rsh(word.Big(), 1)
+	if length.Cmp(big.NewInt(MaxLength)) > 0 {
+		return nil, fmt.Errorf("string length %s exceeds the maximum decodable length", length.String())
+	}
+	data := make([]byte, 0, length.Int64())
+	for i := int64(0); int64(len(data)) < length.Int64(); i++ {
+		word, err := r.word(addSlot(dataSlot(slot), big.NewInt(i)))
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, word[:]...)
+	}
+	return data[:length.Int64()], nil
+}
+
+// word returns the 32-byte word stored at the slot, looking it up in the storage trie by the keccak256 hash of the slot
+func (r *Reader) word(slot *big.Int) (common.Hash, error) {
+	slotHash := common.BytesToHash(slotWord(slot))
+	if word, ok := r.words[slotHash]; ok {
+		return word, nil
+	}
+	val, err := trie.Get(r.lnkCtx, r.lsys, r.root, crypto.Keccak256(slotHash.Bytes()))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("unable to look up slot %s: %v", slotHash.Hex(), err)
+	}
+	var word common.Hash
+	if val != nil {
+		valRLP, err := val.AsBytes()
+		if err != nil {
+			return common.Hash{}, err
+		}
+		content, _, err := rlp.SplitString(valRLP)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("invalid value RLP at slot %s: %v", slotHash.Hex(), err)
+		}
+		if len(content) > common.HashLength {
+			return common.Hash{}, fmt.Errorf("value at slot %s is longer than 32 bytes", slotHash.Hex())
+		}
+		word = common.BytesToHash(content)
+	}
+	r.words[slotHash] = word
+	return word, nil
+}
+
+// decodeValue decodes the bytes of a value type occupying part of a slot
+func decodeValue(typeID string, raw []byte) (interface{}, error) {
+	switch kind(typeID) {
+	case boolKind:
+		return raw[len(raw)-1] != 0, nil
+	case addressKind:
+		return common.BytesToAddress(raw), nil
+	case uintKind:
+		return new(big.Int).SetBytes(raw), nil
+	case intKind:
+		val := new(big.Int).SetBytes(raw)
+		if len(raw) > 0 && raw[0]&0x80 != 0 {
+			val.Sub(val, new(big.Int).Lsh(big.NewInt(1), uint(len(raw)*8)))
+		}
+		return val, nil
+	case fixedBytesKind:
+		return common.CopyBytes(raw), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", typeID)
+	}
+}
+
+// encodeKey returns the bytes a mapping key is hashed as: the padded 32-byte word for value types and the raw data
+// for string and bytes keys
+func encodeKey(keyID string, keyType Type, key interface{}) ([]byte, error) {
+	if keyType.Encoding == encodingBytes {
+		switch k := key.(type) {
+		case string:
+			return []byte(k), nil
+		case []byte:
+			return k, nil
+		default:
+			return nil, fmt.Errorf("%s key needs to be a string or []byte; got %T", keyType.Label, key)
+		}
+	}
+	switch kind(keyID) {
+	case boolKind:
+		b, ok := key.(bool)
+		if !ok {
+			return nil, fmt.Errorf("bool key needs to be a bool; got %T", key)
+		}
+		if b {
+			return common.BigToHash(big.NewInt(1)).Bytes(), nil
+		}
+		return common.Hash{}.Bytes(), nil
+	case addressKind:
+		switch k := key.(type) {
+		case common.Address:
+			return common.BytesToHash(k.Bytes()).Bytes(), nil
+		case string:
+			if !common.IsHexAddress(k) {
+				return nil, fmt.Errorf("invalid address %q", k)
+			}
+			return common.BytesToHash(common.HexToAddress(k).Bytes()).Bytes(), nil
+		default:
+			return nil, fmt.Errorf("address key needs to be a common.Address or hex string; got %T", key)
+		}
+	case uintKind, intKind:
+		i, err := toBig(key)
+		if err != nil {
+			return nil, err
+		}
+		if i.Sign() < 0 {
+			if kind(keyID) == uintKind {
+				return nil, fmt.Errorf("unsigned key cannot be negative")
+			}
+			i = new(big.Int).Add(i, two256)
+		}
+		if i.Sign() < 0 || i.Cmp(uint256Max) > 0 {
+			return nil, fmt.Errorf("key %v does not fit in a slot", key)
+		}
+		return slotWord(i), nil
+	case fixedBytesKind:
+		var b []byte
+		switch k := key.(type) {
+		case []byte:
+			b = k
+		case common.Hash:
+			b = k.Bytes()
+		default:
+			return nil, fmt.Errorf("%s key needs to be a []byte or common.Hash; got %T", keyType.Label, key)
+		}
+		if len(b) > common.HashLength {
+			return nil, fmt.Errorf("%s key is too long", keyType.Label)
+		}
+		// fixed-size bytes are left aligned
+		return common.RightPadBytes(b, common.HashLength), nil
+	default:
+		return nil, fmt.Errorf("unsupported mapping key type %s", keyID)
+	}
+}
+
+// toIndex converts an array index to a big.Int, checking it is within the length of the array
+func toIndex(index interface{}, length *big.Int) (*big.Int, error) {
+	i, err := toBig(index)
+	if err != nil {
+		return nil, err
+	}
+	if i.Sign() < 0 || i.Cmp(length) >= 0 {
+		return nil, fmt.Errorf("index %s out of range for length %s", i.String(), length.String())
+	}
+	return i, nil
+}
+
+func toBig(v interface{}) (*big.Int, error) {
+	switch i := v.(type) {
+	case *big.Int:
+		return i, nil
+	case int:
+		return big.NewInt(int64(i)), nil
+	case int64:
+		return big.NewInt(i), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(i)), nil
+	case uint64:
+		return new(big.Int).SetUint64(i), nil
+	case int32:
+		return big.NewInt(int64(i)), nil
+	case uint32:
+		return big.NewInt(int64(i)), nil
+	default:
+		return nil, fmt.Errorf("integer needs to be a *big.Int or Go integer; got %T", v)
+	}
+}
+
+func findMember(members []Variable, label string) (Variable, bool) {
+	for _, m := range members {
+		if m.Label == label {
+			return m, true
+		}
+	}
+	return Variable{}, false
+}
+
+// slotWord returns the slot as a 32-byte big-endian word
+func slotWord(slot *big.Int) []byte {
+	return common.BigToHash(slot).Bytes()
+}
+
+// dataSlot returns the slot the data of the dynamic array or long string stored at the slot starts at
+func dataSlot(slot *big.Int) *big.Int {
+	return new(big.Int).SetBytes(crypto.Keccak256(slotWord(slot)))
+}
+
+// addSlot adds the slots modulo 2^256
+func addSlot(a, b *big.Int) *big.Int {
+	sum := new(big.Int).Add(a, b)
+	return sum.Mod(sum, two256)
+}
@@ -0,0 +1,227 @@
+package storagelayout_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+
+	"github.com/vulcanize/go-codec-dageth/storagelayout"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+// testLayout is the solc storage layout of
+//
+//	contract Test {
+//	    struct Position { uint64 x; uint256 y; }
+//	    uint128 a; uint128 b;
+//	    address owner; bool flag; int8 neg;
+//	    mapping(address => uint256) balances;
+//	    uint256[] nums;
+//	    string name;
+//	    string description;
+//	    Position pos;
+//	    mapping(string => Position) positions;
+//	    uint16[3] small;
+//	    bytes4 selector;
+//	}
+const testLayout = `{
+  "storage": [
+    {"astId": 1, "contract": "Test.sol:Test", "label": "a", "offset": 0, "slot": "0", "type": "t_uint128"},
+    {"astId": 2, "contract": "Test.sol:Test", "label": "b", "offset": 16, "slot": "0", "type": "t_uint128"},
+    {"astId": 3, "contract": "Test.sol:Test", "label": "owner", "offset": 0, "slot": "1", "type": "t_address"},
+    {"astId": 4, "contract": "Test.sol:Test", "label": "flag", "offset": 20, "slot": "1", "type": "t_bool"},
+    {"astId": 5, "contract": "Test.sol:Test", "label": "neg", "offset": 21, "slot": "1", "type": "t_int8"},
+    {"astId": 6, "contract": "Test.sol:Test", "label": "balances", "offset": 0, "slot": "2", "type": "t_mapping(t_address,t_uint256)"},
+    {"astId": 7, "contract": "Test.sol:Test", "label": "nums", "offset": 0, "slot": "3", "type": "t_array(t_uint256)dyn_storage"},
+    {"astId": 8, "contract": "Test.sol:Test", "label": "name", "offset": 0, "slot": "4", "type": "t_string_storage"},
+    {"astId": 9, "contract": "Test.sol:Test", "label": "description", "offset": 0, "slot": "5", "type": "t_string_storage"},
+    {"astId": 10, "contract": "Test.sol:Test", "label": "pos", "offset": 0, "slot": "6", "type": "t_struct(Position)20_storage"},
+    {"astId": 11, "contract": "Test.sol:Test", "label": "positions", "offset": 0, "slot": "8", "type": "t_mapping(t_string_memory_ptr,t_struct(Position)20_storage)"},
+    {"astId": 12, "contract": "Test.sol:Test", "label": "small", "offset": 0, "slot": "9", "type": "t_array(t_uint16)3_storage"},
+    {"astId": 13, "contract": "Test.sol:Test", "label": "selector", "offset": 0, "slot": "10", "type": "t_bytes4"}
+  ],
+  "types": {
+    "t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+    "t_array(t_uint16)3_storage": {"base": "t_uint16", "encoding": "inplace", "label": "uint16[3]", "numberOfBytes": "32"},
+    "t_array(t_uint256)dyn_storage": {"base": "t_uint256", "encoding": "dynamic_array", "label": "uint256[]", "numberOfBytes": "32"},
+    "t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+    "t_bytes4": {"encoding": "inplace", "label": "bytes4", "numberOfBytes": "4"},
+    "t_int8": {"encoding": "inplace", "label": "int8", "numberOfBytes": "1"},
+    "t_mapping(t_address,t_uint256)": {"encoding": "mapping", "key": "t_address", "label": "mapping(address => uint256)", "numberOfBytes": "32", "value": "t_uint256"},
+    "t_mapping(t_string_memory_ptr,t_struct(Position)20_storage)": {"encoding": "mapping", "key": "t_string_memory_ptr", "label": "mapping(string => struct Test.Position)", "numberOfBytes": "32", "value": "t_struct(Position)20_storage"},
+    "t_string_memory_ptr": {"encoding": "bytes", "label": "string", "numberOfBytes": "32"},
+    "t_string_storage": {"encoding": "bytes", "label": "string", "numberOfBytes": "32"},
+    "t_struct(Position)20_storage": {"encoding": "inplace", "label": "struct Test.Position", "numberOfBytes": "64", "members": [
+      {"astId": 14, "contract": "Test.sol:Test", "label": "x", "offset": 0, "slot": "0", "type": "t_uint64"},
+      {"astId": 15, "contract": "Test.sol:Test", "label": "y", "offset": 0, "slot": "1", "type": "t_uint256"}
+    ]},
+    "t_uint128": {"encoding": "inplace", "label": "uint128", "numberOfBytes": "16"},
+    "t_uint16": {"encoding": "inplace", "label": "uint16", "numberOfBytes": "2"},
+    "t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+    "t_uint64": {"encoding": "inplace", "label": "uint64", "numberOfBytes": "8"}
+  }
+}`
+
+var (
+	owner       = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	holder      = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	description = strings.Repeat("a long description ", 4)
+)
+
+func TestReader(t *testing.T) {
+	layout, err := storagelayout.ParseLayout([]byte(testLayout))
+	if err != nil {
+		t.Fatalf("unable to parse storage layout: %v", err)
+	}
+	words := make(map[common.Hash]common.Hash)
+	slot := func(i int64) common.Hash { return common.BigToHash(big.NewInt(i)) }
+	add := func(h common.Hash, i int64) common.Hash {
+		return common.BigToHash(new(big.Int).Add(h.Big(), big.NewInt(i)))
+	}
+
+	// a = 1, b = 2 packed into slot 0
+	var slot0 common.Hash
+	slot0[31], slot0[15] = 1, 2
+	words[slot(0)] = slot0
+	// owner, flag = true and neg = -2 packed into slot 1
+	var slot1 common.Hash
+	copy(slot1[12:], owner.Bytes())
+	slot1[11], slot1[10] = 1, 0xfe
+	words[slot(1)] = slot1
+	// balances[holder] = 1000
+	words[crypto.Keccak256Hash(common.LeftPadBytes(holder.Bytes(), 32), slot(2).Bytes())] = common.BigToHash(big.NewInt(1000))
+	// nums = [7, 8, 9]
+	words[slot(3)] = slot(3)
+	numsData := crypto.Keccak256Hash(slot(3).Bytes())
+	for i := int64(0); i < 3; i++ {
+		words[add(numsData, i)] = slot(7 + i)
+	}
+	// name = "dageth" stored in its slot
+	var nameWord common.Hash
+	copy(nameWord[:], "dageth")
+	nameWord[31] = byte(2 * len("dageth"))
+	words[slot(4)] = nameWord
+	// description is longer than 31 bytes and is stored from keccak256(5)
+	words[slot(5)] = common.BigToHash(big.NewInt(int64(2*len(description) + 1)))
+	descData := crypto.Keccak256Hash(slot(5).Bytes())
+	for i := 0; i*32 < len(description); i++ {
+		var w common.Hash
+		copy(w[:], description[i*32:])
+		words[add(descData, int64(i))] = w
+	}
+	// pos = Position{x: 3, y: 4}
+	words[slot(6)] = slot(3)
+	words[slot(7)] = slot(4)
+	// positions["home"] = Position{x: 5, y: 6}
+	home := crypto.Keccak256Hash([]byte("home"), slot(8).Bytes())
+	words[home] = slot(5)
+	words[add(home, 1)] = slot(6)
+	// small = [1, 2, 3] packed into slot 9
+	var smallWord common.Hash
+	smallWord[31], smallWord[29], smallWord[27] = 1, 2, 3
+	words[slot(9)] = smallWord
+	// selector = 0xdeadbeef
+	var selectorWord common.Hash
+	copy(selectorWord[28:], []byte{0xde, 0xad, 0xbe, 0xef})
+	words[slot(10)] = selectorWord
+
+	r := newReader(t, layout, words)
+	tests := []struct {
+		label    string
+		path     []interface{}
+		expected interface{}
+	}{
+		{"a", nil, big.NewInt(1)},
+		{"b", nil, big.NewInt(2)},
+		{"owner", nil, owner},
+		{"flag", nil, true},
+		{"neg", nil, big.NewInt(-2)},
+		{"balances", []interface{}{holder}, big.NewInt(1000)},
+		{"balances", []interface{}{holder.Hex()}, big.NewInt(1000)},
+		{"balances", []interface{}{owner}, big.NewInt(0)},
+		{"nums", nil, []interface{}{big.NewInt(7), big.NewInt(8), big.NewInt(9)}},
+		{"nums", []interface{}{2}, big.NewInt(9)},
+		{"name", nil, "dageth"},
+		{"description", nil, description},
+		{"pos", nil, map[string]interface{}{"x": big.NewInt(3), "y": big.NewInt(4)}},
+		{"pos", []interface{}{"y"}, big.NewInt(4)},
+		{"positions", []interface{}{"home", "x"}, big.NewInt(5)},
+		{"positions", []interface{}{"home"}, map[string]interface{}{"x": big.NewInt(5), "y": big.NewInt(6)}},
+		{"small", nil, []interface{}{big.NewInt(1), big.NewInt(2), big.NewInt(3)}},
+		{"small", []interface{}{uint64(1)}, big.NewInt(2)},
+		{"selector", nil, []byte{0xde, 0xad, 0xbe, 0xef}},
+	}
+	for _, test := range tests {
+		val, err := r.Get(test.label, test.path...)
+		if err != nil {
+			t.Errorf("unable to get %s %v: %v", test.label, test.path, err)
+			continue
+		}
+		// values are compared by their formatting, as equal big.Ints can differ in their internal representation
+		if fmt.Sprintf("%T %v", val, val) != fmt.Sprintf("%T %v", test.expected, test.expected) {
+			t.Errorf("%s %v: got %v; expected %v", test.label, test.path, val, test.expected)
+		}
+	}
+
+	for _, test := range []struct {
+		label string
+		path  []interface{}
+	}{
+		{"missing", nil},
+		{"balances", nil},
+		{"balances", []interface{}{1}},
+		{"nums", []interface{}{3}},
+		{"small", []interface{}{-1}},
+		{"pos", []interface{}{"z"}},
+		{"a", []interface{}{0}},
+	} {
+		if _, err := r.Get(test.label, test.path...); err == nil {
+			t.Errorf("expected an error getting %s %v", test.label, test.path)
+		}
+	}
+}
+
+func TestParseLayoutRejectsMissingTypes(t *testing.T) {
+	layout := `{"storage": [{"label": "a", "offset": 0, "slot": "0", "type": "t_uint256"}], "types": {}}`
+	if _, err := storagelayout.ParseLayout([]byte(layout)); err == nil {
+		t.Errorf("expected an error parsing a layout with an undefined type")
+	}
+}
+
+// newReader writes the slot words into a storage trie, the way geth stores them, and returns a reader over it
+func newReader(t *testing.T, layout *storagelayout.Layout, words map[common.Hash]common.Hash) *storagelayout.Reader {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create storage trie builder: %v", err)
+	}
+	for slot, word := range words {
+		val, err := rlp.EncodeToBytes(bytes.TrimLeft(word.Bytes(), "\x00"))
+		if err != nil {
+			t.Fatalf("unable to RLP encode slot value: %v", err)
+		}
+		builder.Put(crypto.Keccak256(slot.Bytes()), val)
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+	r, err := storagelayout.NewReader(ipld.LinkContext{}, lsys, layout, root)
+	if err != nil {
+		t.Fatalf("unable to create reader: %v", err)
+	}
+	return r
+}
@@ -0,0 +1,221 @@
+package dageth_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/header"
+	"github.com/vulcanize/go-codec-dageth/log"
+	"github.com/vulcanize/go-codec-dageth/rct"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	account "github.com/vulcanize/go-codec-dageth/state_account"
+	"github.com/vulcanize/go-codec-dageth/tx"
+	"github.com/vulcanize/go-codec-dageth/uncles"
+)
+
+// streamTestCase is a value which is decoded from its binary encoding, field by field from an rlp.Stream, and
+// unpacked from its go-ethereum type
+type streamTestCase struct {
+	name      string
+	prototype ipld.NodePrototype
+	enc       []byte
+	decode    func(ipld.NodeAssembler, io.Reader) error
+	unpack    func(ipld.NodeAssembler) error
+}
+
+func TestStreamDecodeMatchesUnpack(t *testing.T) {
+	londonHeader := &types.Header{
+		ParentHash:  shared.RandomHash(),
+		UncleHash:   types.EmptyUncleHash,
+		Coinbase:    shared.RandomAddr(),
+		Root:        shared.RandomHash(),
+		TxHash:      shared.RandomHash(),
+		ReceiptHash: shared.RandomHash(),
+		Bloom:       types.BytesToBloom(shared.RandomBytes(types.BloomByteLength)),
+		Difficulty:  big.NewInt(0),
+		Number:      big.NewInt(15537394),
+		GasLimit:    30000000,
+		GasUsed:     29983362,
+		Time:        1663224179,
+		Extra:       []byte("extra"),
+		MixDigest:   shared.RandomHash(),
+		Nonce:       types.EncodeNonce(1),
+		BaseFee:     big.NewInt(48611773972),
+	}
+	frontierHeader := &types.Header{Difficulty: big.NewInt(17179869184), Number: big.NewInt(0)}
+	acct := types.StateAccount{
+		Nonce:    7,
+		Balance:  big.NewInt(1000000000),
+		Root:     shared.RandomHash(),
+		CodeHash: crypto.Keccak256([]byte{0x60, 0x00}),
+	}
+	lg := &types.Log{
+		Address: shared.RandomAddr(),
+		Topics:  []common.Hash{shared.RandomHash(), shared.RandomHash()},
+		Data:    shared.RandomBytes(70),
+	}
+	to := shared.RandomAddr()
+	accessList := types.AccessList{
+		{Address: shared.RandomAddr(), StorageKeys: []common.Hash{shared.RandomHash(), shared.RandomHash()}},
+		{Address: shared.RandomAddr(), StorageKeys: []common.Hash{}},
+	}
+	txs := map[string]*types.Transaction{
+		"legacy transaction": types.NewTx(&types.LegacyTx{
+			Nonce: 1, GasPrice: big.NewInt(2), Gas: 3, To: &to, Value: big.NewInt(4), Data: []byte{5},
+			V: big.NewInt(37), R: big.NewInt(6), S: big.NewInt(7),
+		}),
+		"contract creation": types.NewTx(&types.LegacyTx{
+			Nonce: 1, GasPrice: big.NewInt(2), Gas: 3, Value: big.NewInt(0), Data: shared.RandomBytes(300),
+			V: big.NewInt(27), R: big.NewInt(6), S: big.NewInt(7),
+		}),
+		"access list transaction": types.NewTx(&types.AccessListTx{
+			ChainID: big.NewInt(1), Nonce: 1, GasPrice: big.NewInt(2), Gas: 3, To: &to, Value: big.NewInt(4),
+			AccessList: accessList, V: big.NewInt(1), R: big.NewInt(6), S: big.NewInt(7),
+		}),
+		"dynamic fee transaction": types.NewTx(&types.DynamicFeeTx{
+			ChainID: big.NewInt(1), Nonce: 1, GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(3), Gas: 4,
+			Value: big.NewInt(5), AccessList: accessList, V: big.NewInt(0), R: big.NewInt(6), S: big.NewInt(7),
+		}),
+	}
+	rcts := map[string]*types.Receipt{
+		"legacy receipt": {
+			Type: types.LegacyTxType, PostState: shared.RandomHash().Bytes(), CumulativeGasUsed: 1, Logs: []*types.Log{lg},
+		},
+		"access list receipt": {
+			Type: types.AccessListTxType, Status: types.ReceiptStatusFailed, CumulativeGasUsed: 2,
+		},
+		"dynamic fee receipt": {
+			Type: types.DynamicFeeTxType, Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 3, Logs: []*types.Log{lg, lg},
+		},
+	}
+
+	var testCases []streamTestCase
+	for name, h := range map[string]*types.Header{"London header": londonHeader, "Frontier header": frontierHeader} {
+		h := h
+		testCases = append(testCases, streamTestCase{
+			name:      name,
+			prototype: dageth.Type.Header,
+			enc:       mustRLP(t, h),
+			decode:    header.Decode,
+			unpack:    func(na ipld.NodeAssembler) error { return header.DecodeHeader(na, *h) },
+		})
+	}
+	unclesList := []*types.Header{londonHeader, frontierHeader}
+	testCases = append(testCases, streamTestCase{
+		name:      "uncles",
+		prototype: dageth.Type.Uncles,
+		enc:       mustRLP(t, unclesList),
+		decode:    uncles.Decode,
+		unpack:    func(na ipld.NodeAssembler) error { return uncles.DecodeUncles(na, unclesList) },
+	}, streamTestCase{
+		name:      "account",
+		prototype: dageth.Type.Account,
+		enc:       mustRLP(t, &acct),
+		decode:    account.Decode,
+		unpack:    func(na ipld.NodeAssembler) error { return account.DecodeAccount(na, acct) },
+	}, streamTestCase{
+		name:      "log",
+		prototype: dageth.Type.Log,
+		enc:       mustRLP(t, lg),
+		decode:    log.Decode,
+		unpack:    func(na ipld.NodeAssembler) error { return log.DecodeLog(na, *lg) },
+	})
+	for name, trx := range txs {
+		trx := trx
+		enc, err := trx.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unable to marshal %s: %v", name, err)
+		}
+		testCases = append(testCases, streamTestCase{
+			name:      name,
+			prototype: dageth.Type.Transaction,
+			enc:       enc,
+			decode:    tx.Decode,
+			unpack:    func(na ipld.NodeAssembler) error { return tx.DecodeTx(na, *trx) },
+		})
+	}
+	for name, receipt := range rcts {
+		receipt := receipt
+		enc, err := receipt.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unable to marshal %s: %v", name, err)
+		}
+		testCases = append(testCases, streamTestCase{
+			name:      name,
+			prototype: dageth.Type.Receipt,
+			enc:       enc,
+			decode:    rct.Decode,
+			unpack:    func(na ipld.NodeAssembler) error { return rct.DecodeReceipt(na, *receipt) },
+		}, streamTestCase{
+			name:      name + " without LogRootCID",
+			prototype: dageth.Type.Receipt,
+			enc:       enc,
+			decode:    rct.DecodeOptions{SkipLogRootCID: true}.Decode,
+			unpack: func(na ipld.NodeAssembler) error {
+				return rct.DecodeOptions{SkipLogRootCID: true}.DecodeReceipt(na, *receipt)
+			},
+		})
+	}
+
+	for _, tc := range testCases {
+		for _, prototype := range []ipld.NodePrototype{tc.prototype, basicnode.Prototype.Any} {
+			builder := prototype.NewBuilder()
+			if err := tc.unpack(builder); err != nil {
+				t.Fatalf("%s: unable to unpack: %v", tc.name, err)
+			}
+			expected := builder.Build()
+			for readerName, in := range map[string]io.Reader{
+				"buffer": bytes.NewBuffer(tc.enc),
+				"reader": struct{ io.Reader }{bytes.NewReader(tc.enc)},
+			} {
+				decoded := prototype.NewBuilder()
+				if err := tc.decode(decoded, in); err != nil {
+					t.Fatalf("%s: unable to decode from %s: %v", tc.name, readerName, err)
+				}
+				if !ipld.DeepEqual(decoded.Build(), expected) {
+					t.Errorf("%s: node decoded from %s does not match the node unpacked from its go-ethereum type", tc.name, readerName)
+				}
+			}
+		}
+	}
+}
+
+func TestStreamDecodeSizeCap(t *testing.T) {
+	enc := mustRLP(t, &types.Header{Difficulty: big.NewInt(1), Number: big.NewInt(1), Extra: make([]byte, 100)})
+	defer func(max int64) { shared.MaxStreamSize = max }(shared.MaxStreamSize)
+	shared.MaxStreamSize = int64(len(enc)) - 1
+
+	err := header.Decode(dageth.Type.Header.NewBuilder(), struct{ io.Reader }{bytes.NewReader(enc)})
+	var invalidBinary dageth.ErrInvalidBinary
+	if !errors.As(err, &invalidBinary) || invalidBinary.Type != "Header" || !errors.Is(err, rlp.ErrValueTooLarge) {
+		t.Errorf("expected a Header ErrInvalidBinary wrapping rlp.ErrValueTooLarge; got %v", err)
+	}
+	codec, _ := dageth.LookupCodec(header.MultiCodecType)
+	err = codec.DecodeStrict(dageth.Type.Header.NewBuilder(), struct{ io.Reader }{bytes.NewReader(enc)})
+	if !errors.As(err, &invalidBinary) || !errors.Is(err, rlp.ErrValueTooLarge) {
+		t.Errorf("expected DecodeStrict to reject input larger than MaxStreamSize with rlp.ErrValueTooLarge; got %v", err)
+	}
+	// input which exposes its buffer is not capped
+	if err := header.Decode(dageth.Type.Header.NewBuilder(), bytes.NewBuffer(enc)); err != nil {
+		t.Errorf("unable to decode a header larger than MaxStreamSize from a buffer: %v", err)
+	}
+}
+
+func mustRLP(t *testing.T, val interface{}) []byte {
+	enc, err := rlp.EncodeToBytes(val)
+	if err != nil {
+		t.Fatalf("unable to RLP encode %T: %v", val, err)
+	}
+	return enc
+}
@@ -0,0 +1,153 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethtrie "github.com/ethereum/go-ethereum/trie"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+// Builder accumulates the key/value pairs of an Ethereum merkle patricia trie and, on Commit, computes the trie root
+// and writes every branch, extension and leaf node of the resulting trie into an ipld.LinkSystem as a DAG-ETH block.
+// Nodes whose RLP encoding is less than 32 bytes are embedded in their parent, as they are in Ethereum, and are not
+// written as separate blocks.
+// Keys are used as is, so for the state and storage tries the caller must provide the keccak256 hash of the address
+// or slot.
+type Builder struct {
+	lsys  ipld.LinkSystem
+	codec uint64
+	kvs   map[string][]byte
+}
+
+// NewBuilder returns a Builder that writes trie nodes of the given trie multicodec type into the provided LinkSystem
+func NewBuilder(lsys ipld.LinkSystem, codec uint64) (*Builder, error) {
+	if !isTrieCodec(codec) {
+		return nil, ErrUnsupportedCodec{Codec: codec}
+	}
+	return &Builder{
+		lsys:  lsys,
+		codec: codec,
+		kvs:   make(map[string][]byte),
+	}, nil
+}
+
+// Put adds the key/value pair to the trie, overwriting any value previously put at the key.
+// An empty value removes the key from the trie.
+func (b *Builder) Put(key, value []byte) {
+	if len(value) == 0 {
+		delete(b.kvs, string(key))
+		return
+	}
+	b.kvs[string(key)] = common.CopyBytes(value)
+}
+
+// PutIndexed adds each item of the list to the trie keyed by the RLP encoding of its index,
+// as is done for the transaction and receipt tries
+func (b *Builder) PutIndexed(list types.DerivableList) error {
+	buf := new(bytes.Buffer)
+	for i := 0; i < list.Len(); i++ {
+		key, err := indexKey(i)
+		if err != nil {
+			return err
+		}
+		buf.Reset()
+		list.EncodeIndex(i, buf)
+		b.Put(key, buf.Bytes())
+	}
+	return nil
+}
+
+// PutEncodable RLP encodes each item and adds it to the trie keyed by the RLP encoding of its index,
+// as is done for the log trie
+func (b *Builder) PutEncodable(items ...interface{}) error {
+	for i, item := range items {
+		key, err := indexKey(i)
+		if err != nil {
+			return err
+		}
+		val, err := rlp.EncodeToBytes(item)
+		if err != nil {
+			return fmt.Errorf("unable to RLP encode trie item %d: %v", i, err)
+		}
+		b.Put(key, val)
+	}
+	return nil
+}
+
+// Commit computes the trie root and writes every node of the trie into the LinkSystem, returning the root CID.
+// An empty trie has no nodes to write, its root CID is derived from the empty root hash.
+func (b *Builder) Commit(lnkCtx ipld.LinkContext) (cid.Cid, error) {
+	if b.lsys.StorageWriteOpener == nil {
+		return cid.Cid{}, fmt.Errorf("trie builder requires a LinkSystem with a StorageWriteOpener")
+	}
+	keys := make([]string, 0, len(b.kvs))
+	for k := range b.kvs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var writeErr error
+	st := gethtrie.NewStackTrie(func(_ common.Hash, _ []byte, hash common.Hash, blob []byte) {
+		if writeErr != nil {
+			return
+		}
+		writeErr = b.writeNode(lnkCtx, hash, blob)
+	})
+	for _, k := range keys {
+		if err := st.Update([]byte(k), b.kvs[k]); err != nil {
+			return cid.Cid{}, err
+		}
+		if writeErr != nil {
+			return cid.Cid{}, writeErr
+		}
+	}
+	root, err := st.Commit()
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	if writeErr != nil {
+		return cid.Cid{}, writeErr
+	}
+	return shared.Keccak256ToCid(b.codec, root.Bytes()), nil
+}
+
+// writeNode writes the RLP encoded trie node into the LinkSystem's storage under the CID derived from its hash
+func (b *Builder) writeNode(lnkCtx ipld.LinkContext, hash common.Hash, blob []byte) error {
+	w, commit, err := b.lsys.StorageWriteOpener(lnkCtx)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(blob); err != nil {
+		return err
+	}
+	return commit(cidlink.Link{Cid: shared.Keccak256ToCid(b.codec, hash.Bytes())})
+}
+
+// indexKey returns the RLP encoding of the index, the key used in the index-keyed tries
+func indexKey(i int) ([]byte, error) {
+	key, err := rlp.EncodeToBytes(uint(i))
+	if err != nil {
+		return nil, fmt.Errorf("unable to RLP encode trie index %d: %v", i, err)
+	}
+	return key, nil
+}
+
+// isTrieCodec returns whether the multicodec type is one of the eth trie node types
+func isTrieCodec(codec uint64) bool {
+	switch codec {
+	case cid.EthTxTrie, cid.EthTxReceiptTrie, cid.EthStateTrie, cid.EthStorageTrie, dageth.EthLogTrie:
+		return true
+	default:
+		return false
+	}
+}
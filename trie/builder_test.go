@@ -0,0 +1,141 @@
+package trie_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethtrie "github.com/ethereum/go-ethereum/trie"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestBuilder(t *testing.T) {
+	testBuildTxTrie(t)
+	testBuildStorageTrie(t)
+	testBuildEmptyTrie(t)
+}
+
+func newTestLinkSystem() (ipld.LinkSystem, *memstore.Store) {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	return lsys, store
+}
+
+func testBuildTxTrie(t *testing.T) {
+	txs := make(types.Transactions, 150)
+	for i := range txs {
+		txs[i] = types.NewTransaction(uint64(i), common.BigToAddress(big.NewInt(int64(i))), big.NewInt(int64(i)), 21000, big.NewInt(1), nil)
+	}
+	lsys, store := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthTxTrie)
+	if err != nil {
+		t.Fatalf("unable to create tx trie builder: %v", err)
+	}
+	if err := builder.PutIndexed(txs); err != nil {
+		t.Fatalf("unable to add txs to trie: %v", err)
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit tx trie: %v", err)
+	}
+	expectedRoot := types.DeriveSha(txs, gethtrie.NewStackTrie(nil))
+	verifyRoot(t, root, cid.EthTxTrie, expectedRoot)
+	verifyBlocks(t, store, root, cid.EthTxTrie)
+}
+
+func testBuildStorageTrie(t *testing.T) {
+	gethTrie := gethtrie.NewEmpty(gethtrie.NewDatabase(rawdb.NewMemoryDatabase()))
+	lsys, store := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create storage trie builder: %v", err)
+	}
+	for i := 0; i < 300; i++ {
+		key := crypto.Keccak256(common.BigToHash(big.NewInt(int64(i))).Bytes())
+		// small values produce leaves small enough to be embedded in their parent branch
+		val, _ := rlp.EncodeToBytes(uint64(i + 1))
+		gethTrie.MustUpdate(key, val)
+		builder.Put(key, val)
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+	verifyRoot(t, root, cid.EthStorageTrie, gethTrie.Hash())
+	verifyBlocks(t, store, root, cid.EthStorageTrie)
+}
+
+func testBuildEmptyTrie(t *testing.T) {
+	lsys, store := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthStateTrie)
+	if err != nil {
+		t.Fatalf("unable to create state trie builder: %v", err)
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit empty state trie: %v", err)
+	}
+	verifyRoot(t, root, cid.EthStateTrie, types.EmptyRootHash)
+	if len(store.Bag) != 0 {
+		t.Errorf("empty trie should not produce any blocks; got %d", len(store.Bag))
+	}
+	if _, err := trie.NewBuilder(lsys, cid.EthTx); err == nil {
+		t.Errorf("expected an error creating a trie builder for a non-trie multicodec type")
+	}
+}
+
+func verifyRoot(t *testing.T, root cid.Cid, codec uint64, expectedRoot common.Hash) {
+	if root.Prefix().Codec != codec {
+		t.Errorf("trie root codec (%d) does not match expected codec (%d)", root.Prefix().Codec, codec)
+	}
+	decodedMh, err := multihash.Decode(root.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode trie root multihash: %v", err)
+	}
+	if !bytes.Equal(decodedMh.Digest, expectedRoot.Bytes()) {
+		t.Errorf("trie root hash (%x) does not match expected root hash (%x)", decodedMh.Digest, expectedRoot.Bytes())
+	}
+}
+
+func verifyBlocks(t *testing.T, store *memstore.Store, root cid.Cid, codec uint64) {
+	if len(store.Bag) < 2 {
+		t.Fatalf("expected the trie to produce multiple blocks; got %d", len(store.Bag))
+	}
+	if _, ok := store.Bag[string(root.Bytes())]; !ok {
+		t.Fatalf("trie root block was not written")
+	}
+	for key, blk := range store.Bag {
+		c, err := cid.Cast([]byte(key))
+		if err != nil {
+			t.Fatalf("unable to cast storage key to CID: %v", err)
+		}
+		if c.Prefix().Codec != codec {
+			t.Errorf("trie block codec (%d) does not match expected codec (%d)", c.Prefix().Codec, codec)
+		}
+		decodedMh, err := multihash.Decode(c.Hash())
+		if err != nil {
+			t.Fatalf("unable to decode trie block multihash: %v", err)
+		}
+		if !bytes.Equal(decodedMh.Digest, crypto.Keccak256(blk)) {
+			t.Errorf("trie block CID (%s) does not match the keccak256 hash of its RLP", c.String())
+		}
+		builder := dageth.Type.TrieNode.NewBuilder()
+		if err := trie.DecodeTrieNodeBytes(builder, blk, codec); err != nil {
+			t.Fatalf("unable to decode trie block (%s): %v", c.String(), err)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+// DiffFunc is called by Diff for every key whose value differs between the two tries.
+// The values are decoded into their DAG-ETH type (Transaction, Receipt, Account, Log or the storage Bytes);
+// oldVal is nil for keys that were created and newVal is nil for keys that were deleted.
+type DiffFunc func(key []byte, oldVal, newVal ipld.Node) error
+
+// Diff walks the tries rooted at the two CIDs in parallel, loading nodes from the LinkSystem, and calls fn for every
+// key whose value differs between them. Subtries referenced by the same CID in both tries are skipped without being
+// loaded. Keys are visited in ascending order.
+// Both roots must be of the same trie multicodec type; a root CID for the empty root hash is treated as an empty trie.
+func Diff(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, oldRoot, newRoot cid.Cid, fn DiffFunc) error {
+	codec := oldRoot.Prefix().Codec
+	if !isTrieCodec(codec) {
+		return ErrUnsupportedCodec{Codec: codec}
+	}
+	if newRoot.Prefix().Codec != codec {
+		return dageth.ErrWrongLinkTarget{Codec: codec, Link: newRoot}
+	}
+	d := &differ{
+		load: func(c cid.Cid) (ipld.Node, error) {
+			if lnkCtx.Ctx != nil {
+				if err := lnkCtx.Ctx.Err(); err != nil {
+					return nil, err
+				}
+			}
+			node, _, err := loadTrieNode(lnkCtx, lsys, c)
+			return node, err
+		},
+		fn: fn,
+	}
+	oldTrie, err := rootSubtrie(oldRoot)
+	if err != nil {
+		return err
+	}
+	newTrie, err := rootSubtrie(newRoot)
+	if err != nil {
+		return err
+	}
+	return d.diff(oldTrie, newTrie, shared.Nibbles{})
+}
+
+// subtrie is the view of a trie at a position along a path.
+// Extension and leaf nodes span several positions, skip records how many nibbles of their partial path
+// have already been consumed by the parent positions.
+type subtrie struct {
+	// cid is defined if the node was referenced by a link, it is nil for embedded nodes and empty positions
+	cid cid.Cid
+	// node is the TrieNode union, it is nil for empty positions and for linked nodes that have not yet been loaded
+	node ipld.Node
+	skip int
+}
+
+func (s subtrie) empty() bool {
+	return s.node == nil && !s.cid.Defined()
+}
+
+// rootSubtrie returns the subtrie for a root CID, with the empty root hash resolving to an empty subtrie
+func rootSubtrie(root cid.Cid) (subtrie, error) {
+	decodedMh, err := multihash.Decode(root.Hash())
+	if err != nil {
+		return subtrie{}, dageth.ErrWrongLinkTarget{Codec: root.Prefix().Codec, Link: root}
+	}
+	if bytes.Equal(decodedMh.Digest, types.EmptyRootHash.Bytes()) {
+		return subtrie{}, nil
+	}
+	return subtrie{cid: root}, nil
+}
+
+type differ struct {
+	load func(cid.Cid) (ipld.Node, error)
+	fn   DiffFunc
+}
+
+// diff compares the two subtries found at the absolute nibble path
+func (d *differ) diff(a, b subtrie, path shared.Nibbles) error {
+	if a.empty() && b.empty() {
+		return nil
+	}
+	if a.cid.Defined() && b.cid.Defined() && a.cid.Equals(b.cid) && a.skip == b.skip {
+		return nil
+	}
+	aChildren, aVal, err := d.expand(a)
+	if err != nil {
+		return err
+	}
+	bChildren, bVal, err := d.expand(b)
+	if err != nil {
+		return err
+	}
+	if aVal != nil || bVal != nil {
+		if aVal == nil || bVal == nil || !ipld.DeepEqual(aVal, bVal) {
+			key, err := path.Key()
+			if err != nil {
+				return dageth.ErrInvalidField{Type: "TrieNode", Field: "Value", Reason: fmt.Sprintf("found at invalid path %s", path.String()), Err: err}
+			}
+			if err := d.fn(key, aVal, bVal); err != nil {
+				return err
+			}
+		}
+	}
+	for i := 0; i < 16; i++ {
+		if err := d.diff(aChildren[i], bChildren[i], path.Append(shared.Nibbles{byte(i)})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expand resolves the subtrie into the subtries at each of the 16 following positions and the value stored at the
+// current position, if any. Extension and leaf nodes expand into a single child at the next nibble of their path.
+func (d *differ) expand(s subtrie) ([16]subtrie, ipld.Node, error) {
+	var children [16]subtrie
+	if s.empty() {
+		return children, nil, nil
+	}
+	if s.node == nil {
+		node, err := d.load(s.cid)
+		if err != nil {
+			return children, nil, err
+		}
+		s.node = node
+	}
+	n, kind, err := NodeAndKind(s.node)
+	if err != nil {
+		return children, nil, err
+	}
+	switch kind {
+	case BRANCH_NODE:
+		for i := 0; i < 16; i++ {
+			childNode, err := n.LookupByString(BranchChildKey(i))
+			if err != nil {
+				return children, nil, err
+			}
+			if childNode.IsNull() {
+				continue
+			}
+			if children[i], err = childSubtrie(childNode); err != nil {
+				return children, nil, err
+			}
+		}
+		val, err := nullableValue(n)
+		if err != nil {
+			return children, nil, err
+		}
+		val, err = unwrapValue(val)
+		return children, val, err
+	case EXTENSION_NODE:
+		pp, err := partialPath(n)
+		if err != nil {
+			return children, nil, err
+		}
+		remaining := pp[s.skip:]
+		if len(remaining) == 0 {
+			return children, nil, dageth.ErrInvalidField{Type: "TrieNode", Field: EXTENSION_NODE.String() + "/PartialPath", Reason: "is empty"}
+		}
+		if len(remaining) > 1 {
+			children[remaining[0]] = subtrie{cid: s.cid, node: s.node, skip: s.skip + 1}
+			return children, nil, nil
+		}
+		childNode, err := n.LookupByString("Child")
+		if err != nil {
+			return children, nil, err
+		}
+		children[remaining[0]], err = childSubtrie(childNode)
+		return children, nil, err
+	case LEAF_NODE:
+		pp, err := partialPath(n)
+		if err != nil {
+			return children, nil, err
+		}
+		// leaf partial paths retain the hex terminator flag
+		remaining := pp.TrimTerm()[s.skip:]
+		if len(remaining) > 0 {
+			children[remaining[0]] = subtrie{cid: s.cid, node: s.node, skip: s.skip + 1}
+			return children, nil, nil
+		}
+		val, err := nullableValue(n)
+		if err != nil {
+			return children, nil, err
+		}
+		val, err = unwrapValue(val)
+		return children, val, err
+	default:
+		return children, nil, dageth.ErrInvalidField{Type: "TrieNode", Reason: fmt.Sprintf("is of unrecognized kind %s", kind.String())}
+	}
+}
+
+// childSubtrie returns the subtrie for a branch or extension node child
+func childSubtrie(childNode ipld.Node) (subtrie, error) {
+	childCID, embedded, err := resolveChild(childNode)
+	if err != nil {
+		return subtrie{}, err
+	}
+	if embedded != nil {
+		return subtrie{node: embedded}, nil
+	}
+	return subtrie{cid: childCID}, nil
+}
+
+// unwrapValue returns the typed value held by a Value union, or nil if there is none
+func unwrapValue(valUnionNode ipld.Node) (ipld.Node, error) {
+	if valUnionNode == nil {
+		return nil, nil
+	}
+	val, _, err := ValueAndKind(valUnionNode)
+	return val, err
+}
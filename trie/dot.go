@@ -0,0 +1,184 @@
+package trie
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+)
+
+// WriteDOT renders the trie rooted at the provided CID, loading nodes from the LinkSystem, as a Graphviz DOT graph.
+// Nodes are labeled by kind, abbreviated CID and partial path, and edges by the nibble, or for extension nodes the
+// partial path, they consume. Embedded nodes are drawn dashed. Instead of failing the export, blocks missing from
+// storage are drawn in red, and blocks that do not match their CID or cannot be decoded are drawn in orange and
+// labeled with the error. Nodes deeper than maxDepth, linked or embedded, are drawn as grey placeholders; a negative
+// maxDepth renders the whole trie. A subtrie linked from several positions is drawn once.
+func WriteDOT(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, root cid.Cid, maxDepth int, w io.Writer) error {
+	if !isTrieCodec(root.Prefix().Codec) {
+		return ErrUnsupportedCodec{Codec: root.Prefix().Codec}
+	}
+	rootTrie, err := rootSubtrie(root)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	d := &dotWriter{
+		lnkCtx:   lnkCtx,
+		lsys:     lsys,
+		maxDepth: maxDepth,
+		w:        bw,
+		ids:      make(map[cid.Cid]string),
+	}
+	fmt.Fprintf(bw, "digraph trie {\n\tnode [shape=box, fontname=monospace];\n")
+	if rootTrie.empty() {
+		fmt.Fprintf(bw, "\tn0 [label=\"empty trie\\n%s\"];\n", abbreviateCID(root))
+	} else if _, err := d.linked(root, 0); err != nil {
+		return err
+	}
+	fmt.Fprintf(bw, "}\n")
+	return bw.Flush()
+}
+
+type dotWriter struct {
+	lnkCtx   ipld.LinkContext
+	lsys     ipld.LinkSystem
+	maxDepth int
+	w        io.Writer
+	ids      map[cid.Cid]string
+	next     int
+}
+
+func (d *dotWriter) newID() string {
+	id := fmt.Sprintf("n%d", d.next)
+	d.next++
+	return id
+}
+
+// elided returns whether nodes at the depth are beyond maxDepth and drawn as placeholders
+func (d *dotWriter) elided(depth int) bool {
+	return d.maxDepth >= 0 && depth > d.maxDepth
+}
+
+// linked draws the node linked by the CID, and its children, and returns its DOT ID
+func (d *dotWriter) linked(c cid.Cid, depth int) (string, error) {
+	if id, ok := d.ids[c]; ok {
+		return id, nil
+	}
+	id := d.newID()
+	d.ids[c] = id
+	if d.elided(depth) {
+		fmt.Fprintf(d.w, "\t%s [label=\"…\\n%s\", color=grey, fontcolor=grey];\n", id, abbreviateCID(c))
+		return id, nil
+	}
+	if d.lnkCtx.Ctx != nil {
+		if err := d.lnkCtx.Ctx.Err(); err != nil {
+			return "", err
+		}
+	}
+	node, _, err := loadTrieNode(d.lnkCtx, d.lsys, c)
+	if err != nil {
+		var missing ErrMissingNode
+		if errors.As(err, &missing) {
+			fmt.Fprintf(d.w, "\t%s [label=\"missing\\n%s\", color=red, fontcolor=red, style=bold];\n", id, abbreviateCID(c))
+			return id, nil
+		}
+		if d.lnkCtx.Ctx != nil && d.lnkCtx.Ctx.Err() != nil {
+			return "", err
+		}
+		fmt.Fprintf(d.w, "\t%s [label=%q, color=orange, fontcolor=orange, style=bold];\n", id, "corrupt\n"+abbreviateCID(c)+"\n"+err.Error())
+		return id, nil
+	}
+	return id, d.node(id, abbreviateCID(c), node, "", depth)
+}
+
+// node draws the TrieNode under the DOT ID and then its children
+func (d *dotWriter) node(id, cidLabel string, node ipld.Node, style string, depth int) error {
+	n, kind, err := NodeAndKind(node)
+	if err != nil {
+		return err
+	}
+	label := []string{strings.TrimPrefix(kind.String(), "Trie"), cidLabel}
+	var pp string
+	if kind == EXTENSION_NODE || kind == LEAF_NODE {
+		path, err := partialPath(n)
+		if err != nil {
+			return err
+		}
+		pp = path.String()
+		label = append(label, "path: "+pp)
+	}
+	if kind != EXTENSION_NODE {
+		val, err := nullableValue(n)
+		if err != nil {
+			return err
+		}
+		if val != nil {
+			_, valKind, err := ValueAndKind(val)
+			if err != nil {
+				return err
+			}
+			label = append(label, "value: "+valKind.String())
+		}
+	}
+	fmt.Fprintf(d.w, "\t%s [label=%q%s];\n", id, strings.Join(label, "\n"), style)
+	switch kind {
+	case BRANCH_NODE:
+		for i := 0; i < 16; i++ {
+			childNode, err := n.LookupByString(BranchChildKey(i))
+			if err != nil {
+				return err
+			}
+			if childNode.IsNull() {
+				continue
+			}
+			if err := d.child(id, fmt.Sprintf("%x", i), childNode, depth+1); err != nil {
+				return err
+			}
+		}
+	case EXTENSION_NODE:
+		childNode, err := n.LookupByString("Child")
+		if err != nil {
+			return err
+		}
+		return d.child(id, pp, childNode, depth+1)
+	}
+	return nil
+}
+
+// child draws a branch or extension node child and the edge to it from its parent
+func (d *dotWriter) child(parentID, edgeLabel string, childNode ipld.Node, depth int) error {
+	childCID, embedded, err := resolveChild(childNode)
+	if err != nil {
+		return err
+	}
+	var childID string
+	if embedded != nil {
+		childID = d.newID()
+		if d.elided(depth) {
+			fmt.Fprintf(d.w, "\t%s [label=\"…\\nembedded\", color=grey, fontcolor=grey, style=dashed];\n", childID)
+		} else {
+			err = d.node(childID, "embedded", embedded, ", style=dashed", depth)
+		}
+	} else {
+		childID, err = d.linked(childCID, depth)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(d.w, "\t%s -> %s [label=%q];\n", parentID, childID, edgeLabel)
+	return nil
+}
+
+// abbreviateCID returns the last 8 characters of the CID string, the leading characters are shared by every CID
+// of the same multicodec type
+func abbreviateCID(c cid.Cid) string {
+	s := c.String()
+	if len(s) <= 8 {
+		return s
+	}
+	return "…" + s[len(s)-8:]
+}
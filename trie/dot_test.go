@@ -0,0 +1,98 @@
+package trie_test
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestWriteDOT(t *testing.T) {
+	lsys, store := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create storage trie builder: %v", err)
+	}
+	for i := 0; i < 50; i++ {
+		builder.Put(crypto.Keccak256(common.BigToHash(big.NewInt(int64(i))).Bytes()), []byte{byte(i + 1)})
+	}
+	// short keys and values produce leaves embedded in their parent
+	builder.Put([]byte{0x00, 0x01}, []byte{1})
+	builder.Put([]byte{0x00, 0x02}, []byte{2})
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+
+	var nodes int
+	if err := trie.Walk(ipld.LinkContext{}, lsys, root, func(trie.WalkNode) error {
+		nodes++
+		return nil
+	}); err != nil {
+		t.Fatalf("unable to walk storage trie: %v", err)
+	}
+	dot := writeDOT(t, lsys, root, -1)
+	if !strings.HasPrefix(dot, "digraph trie {") || !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("DOT output is not a digraph:\n%s", dot)
+	}
+	if count := strings.Count(dot, "[label=\"") - strings.Count(dot, "-> "); count != nodes {
+		t.Errorf("DOT output has %d nodes; expected %d", count, nodes)
+	}
+	if strings.Count(dot, "-> ") != nodes-1 {
+		t.Errorf("DOT output has %d edges; expected %d", strings.Count(dot, "-> "), nodes-1)
+	}
+	if !strings.Contains(dot, "style=dashed") {
+		t.Errorf("DOT output should highlight embedded nodes")
+	}
+	if strings.Contains(dot, "missing") || strings.Contains(dot, "color=grey") {
+		t.Errorf("DOT output of a complete trie should have no missing or elided nodes")
+	}
+
+	dot = writeDOT(t, lsys, root, 0)
+	if strings.Count(dot, "color=grey") == 0 || strings.Count(dot, "-> ") >= nodes-1 {
+		t.Errorf("depth limited DOT output should elide the children of the root:\n%s", dot)
+	}
+
+	var children []cid.Cid
+	embeddedDepth := -1
+	if err := trie.Walk(ipld.LinkContext{}, lsys, root, func(n trie.WalkNode) error {
+		if n.Depth == 1 && n.CID.Defined() {
+			children = append(children, n.CID)
+		}
+		if !n.CID.Defined() && embeddedDepth < 0 {
+			embeddedDepth = n.Depth
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unable to walk storage trie: %v", err)
+	}
+	dot = writeDOT(t, lsys, root, embeddedDepth-1)
+	if !strings.Contains(dot, `…\nembedded`) || strings.Contains(dot, `Leaf\nembedded`) {
+		t.Errorf("depth limited DOT output should elide embedded nodes:\n%s", dot)
+	}
+
+	delete(store.Bag, string(children[0].Bytes()))
+	store.Bag[string(children[1].Bytes())] = []byte{0xc0}
+	dot = writeDOT(t, lsys, root, -1)
+	if strings.Count(dot, "missing") != 1 {
+		t.Errorf("DOT output should highlight the missing block:\n%s", dot)
+	}
+	if strings.Count(dot, ", color=orange") != 1 || !strings.Contains(dot, "corrupt") {
+		t.Errorf("DOT output should highlight the corrupt block:\n%s", dot)
+	}
+}
+
+func writeDOT(t *testing.T, lsys ipld.LinkSystem, root cid.Cid, maxDepth int) string {
+	buf := new(bytes.Buffer)
+	if err := trie.WriteDOT(ipld.LinkContext{}, lsys, root, maxDepth, buf); err != nil {
+		t.Fatalf("unable to write DOT: %v", err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,159 @@
+package trie_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestEmbeddedChildren(t *testing.T) {
+	embeddedLeaf := func(val byte) []interface{} {
+		// leaf with an empty remaining path (compact encoding 0x20)
+		return []interface{}{[]byte{0x20}, []byte{val}}
+	}
+	embeddedBranch := make([]interface{}, 17)
+	for i := range embeddedBranch {
+		embeddedBranch[i] = []byte{}
+	}
+	embeddedBranch[1] = embeddedLeaf(1)
+	embeddedBranch[2] = embeddedLeaf(2)
+	// extension over the single nibble 0 (compact encoding 0x10) with its branch child embedded
+	extWithEmbeddedBranch := []interface{}{[]byte{0x10}, embeddedBranch}
+
+	branchWithEmbeddedExt := make([]interface{}, 17)
+	for i := range branchWithEmbeddedExt {
+		branchWithEmbeddedExt[i] = []byte{}
+	}
+	branchWithEmbeddedExt[3] = extWithEmbeddedBranch
+	branchWithEmbeddedExt[7] = embeddedBranch
+	branchWithEmbeddedExt[9] = bytes.Repeat([]byte{0xaa}, 32)
+
+	for name, nodeFields := range map[string][]interface{}{
+		"extension with embedded branch":            extWithEmbeddedBranch,
+		"branch with embedded extension and branch": branchWithEmbeddedExt,
+	} {
+		enc, err := rlp.EncodeToBytes(nodeFields)
+		if err != nil {
+			t.Fatalf("%s: unable to RLP encode node: %v", name, err)
+		}
+		builder := dageth.Type.TrieNode.NewBuilder()
+		if err := trie.DecodeTrieNodeBytes(builder, enc, cid.EthStorageTrie); err != nil {
+			t.Fatalf("%s: unable to decode node: %v", name, err)
+		}
+		node := builder.Build()
+		buf := new(bytes.Buffer)
+		if err := trie.Encode(node, buf); err != nil {
+			t.Fatalf("%s: unable to encode node: %v", name, err)
+		}
+		if !bytes.Equal(buf.Bytes(), enc) {
+			t.Errorf("%s: encoding (%x) does not match original encoding (%x)", name, buf.Bytes(), enc)
+		}
+	}
+
+	builder := dageth.Type.TrieNode.NewBuilder()
+	enc, err := rlp.EncodeToBytes(branchWithEmbeddedExt)
+	if err != nil {
+		t.Fatalf("unable to RLP encode node: %v", err)
+	}
+	if err := trie.DecodeTrieNodeBytes(builder, enc, cid.EthStorageTrie); err != nil {
+		t.Fatalf("unable to decode node: %v", err)
+	}
+	branch, err := builder.Build().LookupByString(trie.BRANCH_NODE.String())
+	if err != nil {
+		t.Fatalf("branch node missing enum key: %v", err)
+	}
+	child3, err := branch.LookupByString("Child3")
+	if err != nil {
+		t.Fatalf("branch node missing Child3: %v", err)
+	}
+	ext := embeddedTrieNode(t, child3, trie.EXTENSION_NODE)
+	extChild, err := ext.LookupByString("Child")
+	if err != nil {
+		t.Fatalf("extension node missing Child: %v", err)
+	}
+	embeddedTrieNode(t, extChild, trie.BRANCH_NODE)
+	child7, err := branch.LookupByString("Child7")
+	if err != nil {
+		t.Fatalf("branch node missing Child7: %v", err)
+	}
+	embeddedTrieNode(t, child7, trie.BRANCH_NODE)
+}
+
+func embeddedTrieNode(t *testing.T, childNode ipld.Node, expectedKind trie.NodeKind) ipld.Node {
+	trieNode, err := childNode.LookupByString("TrieNode")
+	if err != nil {
+		t.Fatalf("child should be an embedded TrieNode: %v", err)
+	}
+	node, kind, err := trie.NodeAndKind(trieNode)
+	if err != nil {
+		t.Fatalf("unable to resolve embedded TrieNode kind: %v", err)
+	}
+	if kind != expectedKind {
+		t.Fatalf("embedded TrieNode kind (%s) does not match expected kind (%s)", kind, expectedKind)
+	}
+	return node
+}
+
+func TestOversizedEmbeddedChildren(t *testing.T) {
+	// leaf with an empty remaining path and a 40 byte value, which makes its RLP encoding more than 32 bytes
+	oversizedLeaf := []interface{}{[]byte{0x20}, bytes.Repeat([]byte{0xbb}, 40)}
+	branch := make([]interface{}, 17)
+	for i := range branch {
+		branch[i] = []byte{}
+	}
+	branch[4] = oversizedLeaf
+	enc, err := rlp.EncodeToBytes(branch)
+	if err != nil {
+		t.Fatalf("unable to RLP encode node: %v", err)
+	}
+	var sizeErr trie.ErrEmbeddedNodeSize
+	err = trie.DecodeTrieNodeBytes(dageth.Type.TrieNode.NewBuilder(), enc, cid.EthStorageTrie)
+	if !errors.As(err, &sizeErr) {
+		t.Errorf("expected decoding to fail with an ErrEmbeddedNodeSize; got %v", err)
+	}
+
+	leaf := buildMap(t, map[string]ipld.Node{
+		"PartialPath": basicnode.NewBytes([]byte{16}),
+		"Value":       buildMap(t, map[string]ipld.Node{"Bytes": basicnode.NewBytes(bytes.Repeat([]byte{0xbb}, 40))}),
+	})
+	ext := buildMap(t, map[string]ipld.Node{
+		trie.EXTENSION_NODE.String(): buildMap(t, map[string]ipld.Node{
+			"PartialPath": basicnode.NewBytes([]byte{0}),
+			"Child": buildMap(t, map[string]ipld.Node{
+				"TrieNode": buildMap(t, map[string]ipld.Node{trie.LEAF_NODE.String(): leaf}),
+			}),
+		}),
+	})
+	err = trie.EncodeTrieNode(ext, new(bytes.Buffer), cid.EthStorageTrie)
+	if !errors.As(err, &sizeErr) {
+		t.Errorf("expected encoding to fail with an ErrEmbeddedNodeSize; got %v", err)
+	}
+}
+
+func buildMap(t *testing.T, entries map[string]ipld.Node) ipld.Node {
+	builder := basicnode.Prototype.Map.NewBuilder()
+	ma, err := builder.BeginMap(int64(len(entries)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range entries {
+		if err := ma.AssembleKey().AssignString(k); err != nil {
+			t.Fatal(err)
+		}
+		if err := ma.AssembleValue().AssignNode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ma.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	return builder.Build()
+}
@@ -0,0 +1,97 @@
+package trie
+
+import (
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+)
+
+// ErrWrongArity is returned when a trie node is not an RLP list of 2 (extension or leaf) or 17 (branch) members
+type ErrWrongArity struct {
+	Arity int
+}
+
+func (e ErrWrongArity) Error() string {
+	return fmt.Sprintf("trie node RLP list has %d members; expected 2 or 17", e.Arity)
+}
+
+// ErrBadHexPrefix is returned when the compact (hex-prefix) encoded partial path of an extension or leaf node has an
+// invalid flag nibble, a non-zero padding nibble, or is empty
+type ErrBadHexPrefix struct {
+	Path []byte
+}
+
+func (e ErrBadHexPrefix) Error() string {
+	if len(e.Path) == 0 {
+		return "trie node partial path is empty"
+	}
+	return fmt.Sprintf("trie node partial path (%x) has an invalid hex-prefix flag byte (%#x)", e.Path, e.Path[0])
+}
+
+// ErrBadHashRef is returned when a branch or extension node child is referenced by a byte string
+// that is not a 32-byte keccak256 hash
+type ErrBadHashRef struct {
+	Length int
+}
+
+func (e ErrBadHashRef) Error() string {
+	return fmt.Sprintf("trie node child hash reference is %d bytes; expected 32", e.Length)
+}
+
+// ErrEmbeddedNodeSize is returned when a branch or extension node child is embedded directly in its parent even though
+// its RLP encoding is 32 bytes or more, in which case it must be referenced by its hash
+type ErrEmbeddedNodeSize struct {
+	Size int
+}
+
+func (e ErrEmbeddedNodeSize) Error() string {
+	return fmt.Sprintf("embedded trie node RLP is %d bytes; expected less than 32", e.Size)
+}
+
+// ErrTrailingBytes is returned when there are bytes left over after the RLP list of a trie node
+type ErrTrailingBytes struct {
+	Count int
+}
+
+func (e ErrTrailingBytes) Error() string {
+	return fmt.Sprintf("trie node has %d trailing bytes after its RLP list", e.Count)
+}
+
+// ErrUnexpectedMember is returned when a member of a trie node RLP list is a list where a byte string is expected,
+// or vice versa
+type ErrUnexpectedMember struct {
+	Member string
+	Reason string
+}
+
+func (e ErrUnexpectedMember) Error() string {
+	return fmt.Sprintf("trie node %s %s", e.Member, e.Reason)
+}
+
+// ErrMissingNode is returned when a trie node linked from the trie cannot be found: the storage of the LinkSystem
+// cannot open it, or it is not among the nodes of a proof. Err holds the storage error, if any.
+type ErrMissingNode struct {
+	CID cid.Cid
+	Err error
+}
+
+func (e ErrMissingNode) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("trie node %s is missing", e.CID.String())
+	}
+	return fmt.Sprintf("trie node %s is missing: %v", e.CID.String(), e.Err)
+}
+
+func (e ErrMissingNode) Unwrap() error {
+	return e.Err
+}
+
+// ErrUnsupportedCodec is returned when a trie node is encoded or decoded as a multicodec type that is not one of the
+// trie multicodec types
+type ErrUnsupportedCodec struct {
+	Codec uint64
+}
+
+func (e ErrUnsupportedCodec) Error() string {
+	return fmt.Sprintf("unsupported multicodec type (%d) for eth TrieNode", e.Codec)
+}
@@ -1,7 +1,6 @@
 package trie
 
 import (
-	"bytes"
 	"fmt"
 	"io"
 	"strconv"
@@ -10,7 +9,6 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
-	"github.com/multiformats/go-multihash"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
 	"github.com/vulcanize/go-codec-dageth/log"
@@ -37,6 +35,9 @@ const (
 	LOG_VALUE     ValueKind = "Log"
 )
 
+// anyTrieCodec stands in for the multicodec type of the trie when encoding a node without knowing which trie it is in
+const anyTrieCodec = uint64(0)
+
 func (n NodeKind) String() string {
 	return string(n)
 }
@@ -64,228 +65,190 @@ func Encode(node ipld.Node, w io.Writer) error {
 // AppendEncode is like Encode, but it uses a destination buffer directly.
 // This means less copying of bytes, and if the destination has enough capacity,
 // fewer allocations.
+// The trie the node is in is inferred from its child links, which must all be CIDs of the same trie multicodec type,
+// use AppendEncodeTrieNode to require the multicodec type of a specific trie.
 func AppendEncode(enc []byte, inNode ipld.Node) ([]byte, error) {
-	// Wrap in a typed node for some basic schema form checking
-	builder := dageth.Type.TrieNode.NewBuilder()
-	if err := builder.AssignNode(inNode); err != nil {
-		return nil, err
-	}
-	n := builder.Build()
-	node, kind, err := NodeAndKind(n)
+	return AppendEncodeTrieNode(enc, inNode, anyTrieCodec)
+}
+
+// EncodeTrieNode is like Encode, but it requires the child links of the node to be CIDs of the trie multicodec type.
+// This is used by the Encode functions for each trie type, which are the ones registered to their corresponding
+// multicodec.
+func EncodeTrieNode(node ipld.Node, w io.Writer, codec uint64) error {
+	enc, err := AppendEncodeTrieNode(make([]byte, 0, 1024), node, codec)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var nodeFields []interface{}
-	switch kind {
-	case BRANCH_NODE:
-		nodeFields, err = packBranchNode(node)
-		if err != nil {
-			return nil, err
-		}
-	case EXTENSION_NODE:
-		nodeFields, err = packExtensionNode(node)
-		if err != nil {
-			return nil, err
-		}
-	case LEAF_NODE:
-		nodeFields, err = packLeafNode(node)
-		if err != nil {
-			return nil, err
+	_, err = w.Write(enc)
+	return err
+}
+
+// AppendEncodeTrieNode is like EncodeTrieNode, but it uses a destination buffer directly.
+func AppendEncodeTrieNode(enc []byte, inNode ipld.Node, codec uint64) ([]byte, error) {
+	if codec != anyTrieCodec && !isTrieCodec(codec) {
+		return nil, dageth.ErrInvalidForm{Type: "TrieNode", Err: ErrUnsupportedCodec{Codec: codec}}
+	}
+	// Wrap in a typed node for some basic schema form checking, unless it is one already
+	node, ok := inNode.(dageth.TrieNode)
+	if !ok {
+		builder := dageth.Type.TrieNode.NewBuilder()
+		if err := builder.AssignNode(inNode); err != nil {
+			return nil, dageth.ErrInvalidForm{Type: "TrieNode", Err: err}
 		}
-	default:
-		return nil, fmt.Errorf("IPLD node is missing the expected Union keys")
+		node = builder.Build().(dageth.TrieNode)
+	}
+	if codec == anyTrieCodec {
+		codec = inferTrieCodec(node)
+	}
+	nodeFields, err := packTrieNode(node, codec)
+	if err != nil {
+		return nil, dageth.ErrInvalidForm{Type: "TrieNode", Err: err}
 	}
 	wbs := shared.NewWriteableByteSlice(&enc)
 	if err := rlp.Encode(wbs, nodeFields); err != nil {
-		return enc, fmt.Errorf("invalid DAG-ETH TrieNode form (%v)", err)
+		return enc, dageth.ErrInvalidForm{Type: "TrieNode", Err: err}
 	}
 	return enc, nil
 }
 
-func packBranchNode(node ipld.Node) ([]interface{}, error) {
-	nodeFields := make([]interface{}, 17)
-	for i := 0; i < 16; i++ {
-		key := fmt.Sprintf("Child%s", strings.ToUpper(strconv.FormatInt(int64(i), 16)))
-		childNode, err := node.LookupByString(key)
-		if err != nil {
-			return nil, err
-		}
-		if childNode.IsNull() {
-			nodeFields[i] = []byte{}
-			continue
-		}
-		childLinkNode, err := childNode.LookupByString("Link")
-		if err == nil {
-			childLink, err := childLinkNode.AsLink()
-			if err != nil {
-				return nil, err
-			}
-			childCIDLink, ok := childLink.(cidlink.Link)
-			if !ok {
-				return nil, fmt.Errorf("branch node child link needs to be a CID")
-			}
-			childMh := childCIDLink.Hash()
-			decodedChildMh, err := multihash.Decode(childMh)
-			if err != nil {
-				return nil, fmt.Errorf("unable to decode Child multihash: %v", err)
+// inferTrieCodec returns the multicodec type of the first child link of the node, looking into embedded nodes,
+// so that the remaining links are required to be of the same trie. If that link is not to a trie node, or there are
+// no links at all, anyTrieCodec is returned and packChild rejects any link that is not to a trie node.
+func inferTrieCodec(node dageth.TrieNode) uint64 {
+	var children []dageth.Child
+	switch n := node.AsInterface().(type) {
+	case dageth.TrieBranchNode:
+		for _, child := range branchChildren(n) {
+			if child.Exists() {
+				children = append(children, child.Must())
 			}
-			nodeFields[i] = decodedChildMh.Digest
-			continue
 		}
-		childTrieNodeNode, err := childNode.LookupByString("TrieNode")
-		if err == nil {
-			// it must be a leaf node as only RLP encodings of storage leaf nodes can be less than 32 bytes in length and stored direclty in a parent node
-			childLeafNode, err := childTrieNodeNode.LookupByString(LEAF_NODE.String())
-			if err != nil {
-				return nil, fmt.Errorf("only leaf nodes can be less than 32 bytes and stored direclty in a parent node")
-			}
-			childLeafNodeFields, err := packLeafNode(childLeafNode)
-			if err != nil {
-				return nil, err
-			}
-			nodeFields[i] = childLeafNodeFields
-			continue
-		}
-		return nil, fmt.Errorf("branch node child needs to be of kind bytes, link, or null: %v", err)
-		/* Child should be a kinded Union, in which case we could do the below type switch instead of map key checking
-		switch childNode.Kind() {
-		case ipld.Kind_Null:
-			nodeFields[i] = []byte{}
-		case ipld.Kind_Link:
-			childLink, err := childNode.AsLink()
-			if err != nil {
-				return nil, err
-			}
-			childCIDLink, ok := childLink.(cidlink.Link)
-			if !ok {
-				return nil, fmt.Errorf("branch node child link needs to be a CID")
-			}
-			childMh := childCIDLink.Hash()
-			decodedChildMh, err := multihash.Decode(childMh)
-			if err != nil {
-				return nil, fmt.Errorf("unable to decode Child multihash: %v", err)
-			}
-			nodeFields[i] = decodedChildMh.Digest
-		case ipld.Kind_Map:
-			// it must be a leaf node as only RLP encodings of storage leaf nodes can be less than 32 bytes in length and stored direclty in a parent node
-			childLeafNode, err := childNode.LookupByString(LEAF_NODE.String())
-			if err != nil {
-				return nil, fmt.Errorf("only leaf nodes can be less than 32 bytes and stored direclty in a parent node")
-			}
-			childLeafNodeFields, err := packLeafNode(childLeafNode)
-			if err != nil {
-				return nil, err
+	case dageth.TrieExtensionNode:
+		children = append(children, n.FieldChild())
+	}
+	for _, childNode := range children {
+		switch child := childNode.AsInterface().(type) {
+		case dageth.Link:
+			if childCIDLink, ok := child.Link().(cidlink.Link); ok && isTrieCodec(childCIDLink.Prefix().Codec) {
+				return childCIDLink.Prefix().Codec
 			}
-			childLeafNodeRLP, err := rlp.EncodeToBytes(childLeafNodeFields)
-			if err != nil {
-				return nil, err
+			return anyTrieCodec
+		case dageth.TrieNode:
+			if codec := inferTrieCodec(child); codec != anyTrieCodec {
+				return codec
 			}
-			nodeFields[i] = childLeafNodeRLP
-		default:
-			return nil, fmt.Errorf("branch node child needs to be of kind bytes, link, or null")
 		}
-		*/
 	}
-	valueBytes, err := packValue(node)
-	if err != nil {
-		return nil, err
-	}
-	nodeFields[16] = valueBytes
-	return nodeFields, nil
+	return anyTrieCodec
 }
 
-func packExtensionNode(node ipld.Node) ([]interface{}, error) {
-	nodeFields := make([]interface{}, 2)
-	ppNode, err := node.LookupByString("PartialPath")
-	if err != nil {
-		return nil, err
-	}
-	pp, err := ppNode.AsBytes()
-	if err != nil {
-		return nil, err
-	}
-	nodeFields[0] = shared.HexToCompact(pp)
-	childNode, err := node.LookupByString("Child")
-	if err != nil {
-		return nil, err
-	}
-	childLink, err := childNode.AsLink()
-	if err != nil {
-		return nil, err
-	}
-	childCIDLink, ok := childLink.(cidlink.Link)
-	if !ok {
-		return nil, fmt.Errorf("extension node child link needs to be a CID")
-	}
-	childMh := childCIDLink.Hash()
-	decodedChildMh, err := multihash.Decode(childMh)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode Child multihash: %v", err)
+// packTrieNode packs the TrieNode union into the list of fields to RLP encode
+// this is used for both top-level nodes and nodes embedded directly in their parent
+func packTrieNode(node dageth.TrieNode, codec uint64) ([]interface{}, error) {
+	switch n := node.AsInterface().(type) {
+	case dageth.TrieBranchNode:
+		return packBranchNode(n, codec)
+	case dageth.TrieExtensionNode:
+		return packExtensionNode(n, codec)
+	case dageth.TrieLeafNode:
+		return packLeafNode(n)
+	default:
+		return nil, dageth.ErrInvalidField{Type: "TrieNode", Reason: "is missing the expected keyed Union keys"}
 	}
-	nodeFields[1] = decodedChildMh.Digest
-	return nodeFields, nil
 }
 
-func packLeafNode(node ipld.Node) ([]interface{}, error) {
-	nodeFields := make([]interface{}, 2)
-	ppNode, err := node.LookupByString("PartialPath")
-	if err != nil {
-		return nil, err
-	}
-	pp, err := ppNode.AsBytes()
-	if err != nil {
-		return nil, err
+func packBranchNode(node dageth.TrieBranchNode, codec uint64) ([]interface{}, error) {
+	nodeFields := make([]interface{}, 17)
+	for i, childNode := range branchChildren(node) {
+		if !childNode.Exists() {
+			nodeFields[i] = []byte{}
+			continue
+		}
+		var err error
+		nodeFields[i], err = packChild(childNode.Must(), codec)
+		if err != nil {
+			return nil, dageth.ErrInvalidField{Type: "TrieNode", Field: BRANCH_NODE.String() + "/" + BranchChildKey(i), Reason: "is not a valid Child", Err: err}
+		}
 	}
-	nodeFields[0] = shared.HexToCompact(pp)
-	valueBytes, err := packValue(node)
-	if err != nil {
-		return nil, err
+	nodeFields[16] = []byte{}
+	if val := node.FieldValue(); val.Exists() {
+		valueBytes, err := packValue(val.Must())
+		if err != nil {
+			return nil, dageth.ErrInvalidField{Type: "TrieNode", Field: BRANCH_NODE.String() + "/Value", Reason: "is not a valid Value", Err: err}
+		}
+		nodeFields[16] = valueBytes
 	}
-	nodeFields[1] = valueBytes
 	return nodeFields, nil
 }
 
-func packValue(node ipld.Node) ([]byte, error) {
-	valUnionNode, err := node.LookupByString("Value")
-	if err != nil {
-		return nil, err
+// branchChildren returns the children of the branch node, indexed by their nibble
+func branchChildren(node dageth.TrieBranchNode) [16]dageth.MaybeChild {
+	return [16]dageth.MaybeChild{
+		node.FieldChild0(), node.FieldChild1(), node.FieldChild2(), node.FieldChild3(),
+		node.FieldChild4(), node.FieldChild5(), node.FieldChild6(), node.FieldChild7(),
+		node.FieldChild8(), node.FieldChild9(), node.FieldChildA(), node.FieldChildB(),
+		node.FieldChildC(), node.FieldChildD(), node.FieldChildE(), node.FieldChildF(),
 	}
-	if valUnionNode.IsNull() {
-		return []byte{}, nil
-	}
-	valNode, valKind, err := ValueAndKind(valUnionNode)
+}
+
+func packExtensionNode(node dageth.TrieExtensionNode, codec uint64) ([]interface{}, error) {
+	child, err := packChild(node.FieldChild(), codec)
 	if err != nil {
-		return nil, err
+		return nil, dageth.ErrInvalidField{Type: "TrieNode", Field: EXTENSION_NODE.String() + "/Child", Reason: "is not a valid Child", Err: err}
 	}
-	switch valKind {
-	case TX_VALUE:
-		buf := new(bytes.Buffer)
-		if err := tx.Encode(valNode, buf); err != nil {
-			return nil, err
-		}
-		return buf.Bytes(), nil
-	case RCT_VALUE:
-		buf := new(bytes.Buffer)
-		if err := rct.Encode(valNode, buf); err != nil {
-			return nil, err
+	return []interface{}{shared.HexToCompact(node.FieldPartialPath().Bytes()), child}, nil
+}
+
+// packChild packs the Child union of a branch or extension node
+// a Link is packed as the keccak256 hash of the referenced node, an embedded TrieNode is packed as its list of fields
+func packChild(childNode dageth.Child, codec uint64) (interface{}, error) {
+	switch child := childNode.AsInterface().(type) {
+	case dageth.Link:
+		if codec == anyTrieCodec {
+			// the trie could not be inferred from the first link of the node, so this link is not to a trie node
+			childCIDLink, ok := child.Link().(cidlink.Link)
+			if !ok || !isTrieCodec(childCIDLink.Prefix().Codec) {
+				return nil, dageth.ErrInvalidField{Type: "TrieNode", Field: "Link", Reason: fmt.Sprintf("(%s) needs to be a trie node CID", child.Link().String())}
+			}
+			codec = childCIDLink.Prefix().Codec
 		}
-		return buf.Bytes(), nil
-	case STATE_VALUE:
-		buf := new(bytes.Buffer)
-		if err := account.Encode(valNode, buf); err != nil {
+		return dageth.LinkDigest(child.Link(), codec)
+	case dageth.TrieNode:
+		nodeFields, err := packTrieNode(child, codec)
+		if err != nil {
 			return nil, err
 		}
-		return buf.Bytes(), nil
-	case STORAGE_VALUE:
-		return valNode.AsBytes()
-	case LOG_VALUE:
-		buf := new(bytes.Buffer)
-		if err := log.Encode(valNode, buf); err != nil {
+		if err := checkEmbeddedSize(nodeFields); err != nil {
 			return nil, err
 		}
-		return buf.Bytes(), nil
+		return nodeFields, nil
 	default:
-		return nil, fmt.Errorf("eth trie value of unexpected kind %s", valKind.String())
+		return nil, dageth.ErrInvalidField{Type: "TrieNode", Field: "Child", Reason: "needs to be a Link or an embedded TrieNode"}
+	}
+}
+
+func packLeafNode(node dageth.TrieLeafNode) ([]interface{}, error) {
+	valueBytes, err := packValue(node.FieldValue())
+	if err != nil {
+		return nil, dageth.ErrInvalidField{Type: "TrieNode", Field: LEAF_NODE.String() + "/Value", Reason: "is not a valid Value", Err: err}
+	}
+	return []interface{}{shared.HexToCompact(node.FieldPartialPath().Bytes()), valueBytes}, nil
+}
+
+func packValue(valUnionNode dageth.Value) ([]byte, error) {
+	switch val := valUnionNode.AsInterface().(type) {
+	case dageth.Transaction:
+		return tx.AppendEncode(nil, val)
+	case dageth.Receipt:
+		return rct.AppendEncode(nil, val)
+	case dageth.Account:
+		return account.AppendEncode(nil, val)
+	case dageth.Bytes:
+		return val.Bytes(), nil
+	case dageth.Log:
+		return log.AppendEncode(nil, val)
+	default:
+		return nil, dageth.ErrInvalidField{Type: "TrieNode", Field: "Value", Reason: fmt.Sprintf("is of unexpected kind %T", val)}
 	}
 }
 
@@ -310,7 +273,12 @@ func ValueAndKind(node ipld.Node) (ipld.Node, ValueKind, error) {
 	if err == nil {
 		return n, LOG_VALUE, nil
 	}
-	return nil, "", fmt.Errorf("eth trie value IPLD node is missing the expected keyed Union keys")
+	return nil, "", dageth.ErrInvalidField{Type: "TrieNode", Field: "Value", Reason: "is missing the expected keyed Union keys"}
+}
+
+// BranchChildKey returns the TrieBranchNode field name for the child at the provided nibble (e.g. "ChildA")
+func BranchChildKey(nibble int) string {
+	return fmt.Sprintf("Child%s", strings.ToUpper(strconv.FormatInt(int64(nibble), 16)))
 }
 
 func NodeAndKind(node ipld.Node) (ipld.Node, NodeKind, error) {
@@ -326,5 +294,5 @@ func NodeAndKind(node ipld.Node) (ipld.Node, NodeKind, error) {
 	if err == nil {
 		return n, EXTENSION_NODE, nil
 	}
-	return nil, "", fmt.Errorf("eth trie node IPLD node is missing the expected keyed Union keys")
+	return nil, "", dageth.ErrInvalidField{Type: "TrieNode", Reason: "is missing the expected keyed Union keys"}
 }
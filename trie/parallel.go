@@ -0,0 +1,106 @@
+package trie
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+// ParallelWalk walks the trie rooted at the provided CID like Walk, but loads and visits nodes from up to parallelism
+// goroutines at once. A parallelism below 1 uses GOMAXPROCS.
+// fn is called concurrently and must be safe for concurrent use; nodes are not visited in any particular order.
+// Every linked node is visited at most once, even if the same subtrie appears at several paths in the trie, in which
+// case it is visited at only one of its paths. Nodes embedded in their parent are visited with their parent's subtrie.
+// The walk stops at the first error returned by fn or encountered loading a node, or when ctx is cancelled, and
+// returns that error.
+func ParallelWalk(ctx context.Context, lsys ipld.LinkSystem, root cid.Cid, parallelism int, fn WalkFunc) error {
+	if !isTrieCodec(root.Prefix().Codec) {
+		return ErrUnsupportedCodec{Codec: root.Prefix().Codec}
+	}
+	rootTrie, err := rootSubtrie(root)
+	if err != nil {
+		return err
+	}
+	if rootTrie.empty() {
+		return nil
+	}
+	if parallelism < 1 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	pool := &walkPool{
+		// the calling goroutine is one of the workers
+		sem:     make(chan struct{}, parallelism-1),
+		visited: map[cid.Cid]struct{}{root: {}},
+		cancel:  cancel,
+	}
+	w := &walker{
+		lnkCtx: ipld.LinkContext{Ctx: ctx},
+		lsys:   lsys,
+		codec:  root.Prefix().Codec,
+		fn:     fn,
+		pool:   pool,
+	}
+	if err := w.walk(root, nil, shared.Nibbles{}, 0); err != nil {
+		pool.fail(err)
+	}
+	pool.wg.Wait()
+	return pool.err
+}
+
+// walkPool bounds the goroutines of a parallel walk and tracks the nodes it has visited and its first error
+type walkPool struct {
+	sem chan struct{}
+	wg  sync.WaitGroup
+
+	mu      sync.Mutex
+	visited map[cid.Cid]struct{}
+	err     error
+	cancel  context.CancelFunc
+}
+
+// visit marks the CID as visited, and returns false if it was already visited
+func (p *walkPool) visit(c cid.Cid) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.visited[c]; ok {
+		return false
+	}
+	p.visited[c] = struct{}{}
+	return true
+}
+
+// spawn runs the walk of a subtrie on a new goroutine if the parallelism limit allows it, and returns false otherwise
+// so that the caller walks the subtrie itself
+func (p *walkPool) spawn(walk func() error) bool {
+	select {
+	case p.sem <- struct{}{}:
+	default:
+		return false
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer func() { <-p.sem }()
+		if err := walk(); err != nil {
+			p.fail(err)
+		}
+	}()
+	return true
+}
+
+// fail records the first error of the walk and cancels the rest of the walk
+func (p *walkPool) fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == nil {
+		p.err = err
+	}
+	p.cancel()
+}
@@ -0,0 +1,108 @@
+package trie_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestParallelWalk(t *testing.T) {
+	lsys, store := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create storage trie builder: %v", err)
+	}
+	for i := 0; i < 1000; i++ {
+		builder.Put(crypto.Keccak256(common.BigToHash(big.NewInt(int64(i))).Bytes()), []byte{byte(i + 1)})
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+
+	var mu sync.Mutex
+	linked := make(map[cid.Cid]int)
+	leaves := make(map[string]string)
+	err = trie.ParallelWalk(context.Background(), lsys, root, 4, func(n trie.WalkNode) error {
+		if n.Codec != cid.EthStorageTrie {
+			t.Errorf("walk node codec (%d) should be the storage trie codec", n.Codec)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if n.CID.Defined() {
+			linked[n.CID]++
+		}
+		if n.Kind == trie.LEAF_NODE {
+			leaves[string(n.Key)] = n.Path.String()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to walk storage trie: %v", err)
+	}
+	if len(linked) != len(store.Bag) {
+		t.Errorf("parallel walk visited %d linked nodes; expected %d", len(linked), len(store.Bag))
+	}
+	for c, visits := range linked {
+		if visits != 1 {
+			t.Errorf("node %s visited %d times", c.String(), visits)
+		}
+	}
+	expected := make(map[string]string)
+	err = trie.Walk(ipld.LinkContext{}, lsys, root, func(n trie.WalkNode) error {
+		if n.Kind == trie.LEAF_NODE {
+			expected[string(n.Key)] = n.Path.String()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to walk storage trie: %v", err)
+	}
+	if len(leaves) != len(expected) {
+		t.Fatalf("parallel walk visited %d leaves; expected %d", len(leaves), len(expected))
+	}
+	for key, path := range expected {
+		if leaves[key] != path {
+			t.Errorf("leaf %x visited at path %s; expected %s", key, leaves[key], path)
+		}
+	}
+
+	errStop := errors.New("stop")
+	err = trie.ParallelWalk(context.Background(), lsys, root, 4, func(n trie.WalkNode) error {
+		if n.Kind == trie.LEAF_NODE {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("expected the visitor error to stop the walk; got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = trie.ParallelWalk(ctx, lsys, root, 4, func(trie.WalkNode) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected a cancelled context to stop the walk; got %v", err)
+	}
+
+	visited := 0
+	err = trie.ParallelWalk(context.Background(), lsys, root, 0, func(trie.WalkNode) error {
+		visited++
+		return trie.SkipChildren
+	})
+	if err != nil {
+		t.Fatalf("unable to walk storage trie: %v", err)
+	}
+	if visited != 1 {
+		t.Errorf("skipping the children of the root should visit a single node; visited %d", visited)
+	}
+}
@@ -0,0 +1,295 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+// ProofNode is a single trie node in a merkle proof, referenced by its CID and holding its raw RLP encoding
+type ProofNode struct {
+	CID cid.Cid
+	RLP []byte
+}
+
+// Proof is the ordered list of trie nodes on the path from the root to a key.
+// Nodes embedded in their parent are contained in the parent's RLP and are not listed separately.
+// Proof satisfies the ethdb.KeyValueReader interface, keyed by node hash, so that it can be passed
+// directly to go-ethereum's trie.VerifyProof.
+type Proof []ProofNode
+
+// Has satisfies the ethdb.KeyValueReader interface
+func (p Proof) Has(key []byte) (bool, error) {
+	_, err := p.Get(key)
+	return err == nil, nil
+}
+
+// Get satisfies the ethdb.KeyValueReader interface, returning the RLP of the proof node with the provided keccak256 hash
+func (p Proof) Get(key []byte) ([]byte, error) {
+	for _, n := range p {
+		decodedMh, err := multihash.Decode(n.CID.Hash())
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(decodedMh.Digest, key) {
+			return n.RLP, nil
+		}
+	}
+	return nil, errProofNodeNotFound
+}
+
+var errProofNodeNotFound = errors.New("proof node not found")
+
+// Prove walks the trie rooted at the provided CID along the path for the provided key, loading each node from the
+// LinkSystem, and returns the proof for the key. If the key is not present in the trie the returned proof is a proof
+// of absence: the nodes on the path up to the point where the key diverges from the trie.
+// A root CID for the empty root hash is treated as an empty trie, for which the proof of absence holds no nodes.
+// The trie node type of every node on the path is taken from the multicodec of the root CID.
+// Keys are used as is, so for the state and storage tries the caller must provide the keccak256 hash of the address
+// or slot.
+func Prove(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, root cid.Cid, key []byte) (Proof, error) {
+	var proof Proof
+	_, err := walkPath(root, key, func(c cid.Cid) (ipld.Node, error) {
+		node, raw, err := loadTrieNode(lnkCtx, lsys, c)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, ProofNode{CID: c, RLP: raw})
+		return node, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// Get walks the trie rooted at the provided CID along the path for the provided key, loading each node from the
+// LinkSystem, and returns the value stored at the key decoded into its DAG-ETH type, or nil if the key is not present
+// in the trie. A root CID for the empty root hash is treated as an empty trie.
+// Keys are used as is, so for the state and storage tries the caller must provide the keccak256 hash of the address
+// or slot.
+func Get(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, root cid.Cid, key []byte) (ipld.Node, error) {
+	valUnionNode, err := walkPath(root, key, func(c cid.Cid) (ipld.Node, error) {
+		node, _, err := loadTrieNode(lnkCtx, lsys, c)
+		return node, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return unwrapValue(valUnionNode)
+}
+
+// VerifyProof checks the proof for the key against the root CID and returns the value stored at the key, decoded
+// into its DAG-ETH type (Transaction, Receipt, Account, Log or the storage Bytes) according to the trie multicodec
+// of the root. If the proof proves the absence of the key, the returned value is nil.
+// Every proof node must hash to its CID, and every node on the path must be present in the proof.
+// A root CID for the empty root hash is treated as an empty trie, which proves the absence of every key without any
+// proof nodes.
+func VerifyProof(root cid.Cid, key []byte, proof Proof) (ipld.Node, error) {
+	codec := root.Prefix().Codec
+	blocks := make(map[cid.Cid][]byte, len(proof))
+	for _, n := range proof {
+		if n.CID.Prefix().Codec != codec {
+			return nil, dageth.ErrWrongLinkTarget{Codec: codec, Link: n.CID}
+		}
+		decodedMh, err := multihash.Decode(n.CID.Hash())
+		if err != nil || decodedMh.Code != multihash.KECCAK_256 {
+			return nil, dageth.ErrWrongLinkTarget{Codec: codec, Link: n.CID}
+		}
+		if hash := crypto.Keccak256(n.RLP); !bytes.Equal(decodedMh.Digest, hash) {
+			return nil, dageth.ErrHashMismatch{Expected: n.CID, Actual: shared.Keccak256ToCid(codec, hash)}
+		}
+		blocks[n.CID] = n.RLP
+	}
+	valUnionNode, err := walkPath(root, key, func(c cid.Cid) (ipld.Node, error) {
+		raw, ok := blocks[c]
+		if !ok {
+			return nil, ErrMissingNode{CID: c}
+		}
+		builder := dageth.Type.TrieNode.NewBuilder()
+		if err := DecodeTrieNodeBytes(builder, raw, codec); err != nil {
+			return nil, fmt.Errorf("unable to decode trie node %s: %w", c.String(), err)
+		}
+		return builder.Build(), nil
+	})
+	if err != nil || valUnionNode == nil {
+		return nil, err
+	}
+	val, _, err := ValueAndKind(valUnionNode)
+	return val, err
+}
+
+// walkPath walks the trie rooted at the provided CID along the path for the provided key, resolving linked nodes with
+// the load function, and returns the Value union stored at the key or nil if the key is not present in the trie.
+// The empty root hash has no node to load, it resolves to an empty trie without calling the load function.
+func walkPath(root cid.Cid, key []byte, load func(cid.Cid) (ipld.Node, error)) (ipld.Node, error) {
+	if !isTrieCodec(root.Prefix().Codec) {
+		return nil, ErrUnsupportedCodec{Codec: root.Prefix().Codec}
+	}
+	rootTrie, err := rootSubtrie(root)
+	if err != nil {
+		return nil, err
+	}
+	if rootTrie.empty() {
+		return nil, nil
+	}
+	node, err := load(root)
+	if err != nil {
+		return nil, err
+	}
+	path := shared.KeyToNibbles(key)
+	for {
+		n, kind, err := NodeAndKind(node)
+		if err != nil {
+			return nil, err
+		}
+		var childNode ipld.Node
+		switch kind {
+		case BRANCH_NODE:
+			if len(path) == 0 {
+				return nullableValue(n)
+			}
+			childNode, err = n.LookupByString(BranchChildKey(int(path[0])))
+			if err != nil {
+				return nil, err
+			}
+			path = path[1:]
+			if childNode.IsNull() {
+				return nil, nil
+			}
+		case EXTENSION_NODE:
+			pp, err := partialPath(n)
+			if err != nil {
+				return nil, err
+			}
+			if !path.HasPrefix(pp) {
+				return nil, nil
+			}
+			path = path[len(pp):]
+			childNode, err = n.LookupByString("Child")
+			if err != nil {
+				return nil, err
+			}
+		case LEAF_NODE:
+			pp, err := partialPath(n)
+			if err != nil {
+				return nil, err
+			}
+			// leaf partial paths retain the hex terminator flag
+			if !bytes.Equal(path, pp.TrimTerm()) {
+				return nil, nil
+			}
+			return nullableValue(n)
+		default:
+			return nil, dageth.ErrInvalidField{Type: "TrieNode", Reason: fmt.Sprintf("is of unrecognized kind %s", kind.String())}
+		}
+		childCID, embedded, err := resolveChild(childNode)
+		if err != nil {
+			return nil, err
+		}
+		if embedded != nil {
+			node = embedded
+			continue
+		}
+		node, err = load(childCID)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// nullableValue returns the Value union of a branch or leaf node, or nil if the node holds no value
+func nullableValue(node ipld.Node) (ipld.Node, error) {
+	valUnionNode, err := node.LookupByString("Value")
+	if err != nil {
+		return nil, err
+	}
+	if valUnionNode.IsNull() {
+		return nil, nil
+	}
+	return valUnionNode, nil
+}
+
+// loadTrieNode loads the raw block for the CID from the LinkSystem and decodes it into a TrieNode.
+// A block the storage cannot open is reported as an ErrMissingNode, while a block that can be read but does not hash
+// to its CID is an ErrHashMismatch and one that cannot be decoded is wrapped in a plain error.
+func loadTrieNode(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, c cid.Cid) (ipld.Node, []byte, error) {
+	decodedMh, err := multihash.Decode(c.Hash())
+	if err != nil || decodedMh.Code != multihash.KECCAK_256 {
+		return nil, nil, dageth.ErrWrongLinkTarget{Codec: c.Prefix().Codec, Link: c}
+	}
+	if lsys.StorageReadOpener == nil {
+		return nil, nil, fmt.Errorf("trie node loading requires a LinkSystem with a StorageReadOpener")
+	}
+	r, err := lsys.StorageReadOpener(lnkCtx, cidlink.Link{Cid: c})
+	if err != nil {
+		if lnkCtx.Ctx != nil && lnkCtx.Ctx.Err() != nil {
+			return nil, nil, lnkCtx.Ctx.Err()
+		}
+		return nil, nil, ErrMissingNode{CID: c, Err: err}
+	}
+	if closer, ok := r.(io.Closer); ok {
+		defer closer.Close()
+	}
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read trie node %s: %w", c.String(), err)
+	}
+	if hash := crypto.Keccak256(raw); !lsys.TrustedStorage && !bytes.Equal(decodedMh.Digest, hash) {
+		return nil, nil, dageth.ErrHashMismatch{Expected: c, Actual: shared.Keccak256ToCid(c.Prefix().Codec, hash)}
+	}
+	builder := dageth.Type.TrieNode.NewBuilder()
+	if err := DecodeTrieNodeBytes(builder, raw, c.Prefix().Codec); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode trie node %s: %w", c.String(), err)
+	}
+	return builder.Build(), raw, nil
+}
+
+// resolveChild takes a trie node child and returns either the CID it links to or the TrieNode embedded directly in it
+func resolveChild(childNode ipld.Node) (cid.Cid, ipld.Node, error) {
+	if childNode.Kind() == ipld.Kind_Link {
+		c, err := linkToCID(childNode)
+		return c, nil, err
+	}
+	childLinkNode, err := childNode.LookupByString("Link")
+	if err == nil {
+		c, err := linkToCID(childLinkNode)
+		return c, nil, err
+	}
+	childTrieNode, err := childNode.LookupByString("TrieNode")
+	if err == nil {
+		return cid.Cid{}, childTrieNode, nil
+	}
+	return cid.Cid{}, nil, dageth.ErrInvalidField{Type: "TrieNode", Field: "Child", Reason: "needs to be a Link or an embedded TrieNode", Err: err}
+}
+
+func linkToCID(linkNode ipld.Node) (cid.Cid, error) {
+	lnk, err := linkNode.AsLink()
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	cidLink, ok := lnk.(cidlink.Link)
+	if !ok {
+		return cid.Cid{}, dageth.ErrInvalidField{Type: "TrieNode", Field: "Link", Reason: "needs to be a CID"}
+	}
+	return cidLink.Cid, nil
+}
+
+// partialPath returns the PartialPath of an extension or leaf node as a nibble path
+func partialPath(node ipld.Node) (shared.Nibbles, error) {
+	ppNode, err := node.LookupByString("PartialPath")
+	if err != nil {
+		return nil, err
+	}
+	return ppNode.AsBytes()
+}
@@ -0,0 +1,246 @@
+package trie_test
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethtrie "github.com/ethereum/go-ethereum/trie"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	account "github.com/vulcanize/go-codec-dageth/state_account"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestProve(t *testing.T) {
+	lsys, _ := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthStateTrie)
+	if err != nil {
+		t.Fatalf("unable to create state trie builder: %v", err)
+	}
+	accounts := make(map[common.Hash][]byte)
+	for i := 0; i < 200; i++ {
+		account := &types.StateAccount{
+			Nonce:    uint64(i),
+			Balance:  big.NewInt(int64(i) * 1000),
+			Root:     types.EmptyRootHash,
+			CodeHash: crypto.Keccak256(nil),
+		}
+		accountRLP, err := rlp.EncodeToBytes(account)
+		if err != nil {
+			t.Fatalf("unable to RLP encode account: %v", err)
+		}
+		key := crypto.Keccak256Hash(common.BigToAddress(big.NewInt(int64(i))).Bytes())
+		accounts[key] = accountRLP
+		builder.Put(key.Bytes(), accountRLP)
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit state trie: %v", err)
+	}
+	decodedRootMh, err := multihash.Decode(root.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode state root multihash: %v", err)
+	}
+	rootHash := common.BytesToHash(decodedRootMh.Digest)
+
+	for key, accountRLP := range accounts {
+		proof, err := trie.Prove(ipld.LinkContext{}, lsys, root, key.Bytes())
+		if err != nil {
+			t.Fatalf("unable to prove key %x: %v", key, err)
+		}
+		if !proof[0].CID.Equals(root) {
+			t.Errorf("proof should start at the root (%s); got %s", root.String(), proof[0].CID.String())
+		}
+		val, err := gethtrie.VerifyProof(rootHash, key.Bytes(), proof)
+		if err != nil {
+			t.Fatalf("proof for key %x failed verification: %v", key, err)
+		}
+		if !bytes.Equal(val, accountRLP) {
+			t.Errorf("proven value (%x) does not match expected value (%x)", val, accountRLP)
+		}
+
+		accountNode, err := trie.VerifyProof(root, key.Bytes(), proof)
+		if err != nil {
+			t.Fatalf("proof for key %x failed IPLD verification: %v", key, err)
+		}
+		if accountNode == nil {
+			t.Fatalf("proof for key %x should prove an account", key)
+		}
+		accountBuf := new(bytes.Buffer)
+		if err := account.Encode(accountNode, accountBuf); err != nil {
+			t.Fatalf("unable to encode proven account: %v", err)
+		}
+		if !bytes.Equal(accountBuf.Bytes(), accountRLP) {
+			t.Errorf("proven account (%x) does not match expected account (%x)", accountBuf.Bytes(), accountRLP)
+		}
+
+		gotNode, err := trie.Get(ipld.LinkContext{}, lsys, root, key.Bytes())
+		if err != nil {
+			t.Fatalf("unable to get key %x: %v", key, err)
+		}
+		gotBuf := new(bytes.Buffer)
+		if err := account.Encode(gotNode, gotBuf); err != nil {
+			t.Fatalf("unable to encode account: %v", err)
+		}
+		if !bytes.Equal(gotBuf.Bytes(), accountRLP) {
+			t.Errorf("account (%x) does not match expected account (%x)", gotBuf.Bytes(), accountRLP)
+		}
+	}
+
+	missingKey := crypto.Keccak256([]byte("missing"))
+	proof, err := trie.Prove(ipld.LinkContext{}, lsys, root, missingKey)
+	if err != nil {
+		t.Fatalf("unable to prove absence of key %x: %v", missingKey, err)
+	}
+	val, err := gethtrie.VerifyProof(rootHash, missingKey, proof)
+	if err != nil {
+		t.Fatalf("proof of absence for key %x failed verification: %v", missingKey, err)
+	}
+	if val != nil {
+		t.Errorf("proof of absence should not prove a value; got %x", val)
+	}
+	absentNode, err := trie.VerifyProof(root, missingKey, proof)
+	if err != nil {
+		t.Fatalf("proof of absence for key %x failed IPLD verification: %v", missingKey, err)
+	}
+	if absentNode != nil {
+		t.Errorf("proof of absence should not prove a value")
+	}
+	absentNode, err = trie.Get(ipld.LinkContext{}, lsys, root, missingKey)
+	if err != nil {
+		t.Fatalf("unable to get key %x: %v", missingKey, err)
+	}
+	if absentNode != nil {
+		t.Errorf("getting a missing key should return no value")
+	}
+}
+
+func TestEmptyRoot(t *testing.T) {
+	lsys, _ := newTestLinkSystem()
+	root := shared.Keccak256ToCid(cid.EthStateTrie, types.EmptyRootHash.Bytes())
+	key := crypto.Keccak256([]byte("missing"))
+	proof, err := trie.Prove(ipld.LinkContext{}, lsys, root, key)
+	if err != nil {
+		t.Fatalf("unable to prove absence of key from the empty trie: %v", err)
+	}
+	if len(proof) != 0 {
+		t.Errorf("proof of absence from the empty trie should hold no nodes; got %d", len(proof))
+	}
+	val, err := trie.VerifyProof(root, key, proof)
+	if err != nil {
+		t.Fatalf("proof of absence from the empty trie failed verification: %v", err)
+	}
+	if val != nil {
+		t.Errorf("proof of absence from the empty trie should not prove a value")
+	}
+	val, err = trie.Get(ipld.LinkContext{}, lsys, root, key)
+	if err != nil {
+		t.Fatalf("unable to get key from the empty trie: %v", err)
+	}
+	if val != nil {
+		t.Errorf("getting a key from the empty trie should return no value")
+	}
+}
+
+func TestVerifyProofRejectsInvalidProofs(t *testing.T) {
+	lsys, _ := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create storage trie builder: %v", err)
+	}
+	var key []byte
+	for i := 0; i < 50; i++ {
+		key = crypto.Keccak256(common.BigToHash(big.NewInt(int64(i))).Bytes())
+		builder.Put(key, crypto.Keccak256(key))
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+	proof, err := trie.Prove(ipld.LinkContext{}, lsys, root, key)
+	if err != nil {
+		t.Fatalf("unable to prove key %x: %v", key, err)
+	}
+	if _, err := trie.VerifyProof(root, key, proof); err != nil {
+		t.Fatalf("valid proof failed verification: %v", err)
+	}
+
+	tampered := make(trie.Proof, len(proof))
+	copy(tampered, proof)
+	last := len(tampered) - 1
+	tamperedRLP := common.CopyBytes(tampered[last].RLP)
+	tamperedRLP[len(tamperedRLP)-1] ^= 0xff
+	tampered[last] = trie.ProofNode{CID: tampered[last].CID, RLP: tamperedRLP}
+	var mismatch dageth.ErrHashMismatch
+	if _, err := trie.VerifyProof(root, key, tampered); !errors.As(err, &mismatch) || mismatch.Expected != tampered[last].CID {
+		t.Errorf("expected proof with a node that does not match its CID to be rejected with ErrHashMismatch; got %v", err)
+	}
+
+	var missing trie.ErrMissingNode
+	if _, err := trie.VerifyProof(root, key, proof[:last]); !errors.As(err, &missing) || missing.CID != proof[last].CID {
+		t.Errorf("expected proof with a missing node to be rejected with ErrMissingNode; got %v", err)
+	}
+
+	wrongCodec := make(trie.Proof, len(proof))
+	copy(wrongCodec, proof)
+	wrongCodec[last] = trie.ProofNode{CID: cid.NewCidV1(cid.EthStateTrie, proof[last].CID.Hash()), RLP: proof[last].RLP}
+	var wrongTarget dageth.ErrWrongLinkTarget
+	if _, err := trie.VerifyProof(root, key, wrongCodec); !errors.As(err, &wrongTarget) || wrongTarget.Codec != cid.EthStorageTrie {
+		t.Errorf("expected proof with a node of another trie to be rejected with ErrWrongLinkTarget; got %v", err)
+	}
+}
+
+func TestTrieErrors(t *testing.T) {
+	lsys, store := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create storage trie builder: %v", err)
+	}
+	var key []byte
+	for i := 0; i < 50; i++ {
+		key = crypto.Keccak256(common.BigToHash(big.NewInt(int64(i))).Bytes())
+		builder.Put(key, crypto.Keccak256(key))
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+	proof, err := trie.Prove(ipld.LinkContext{}, lsys, root, key)
+	if err != nil {
+		t.Fatalf("unable to prove key %x: %v", key, err)
+	}
+
+	var unsupported trie.ErrUnsupportedCodec
+	notTrie := cid.NewCidV1(cid.EthTx, root.Hash())
+	if _, err := trie.Get(ipld.LinkContext{}, lsys, notTrie, key); !errors.As(err, &unsupported) {
+		t.Errorf("expected Get of a non-trie root to fail with ErrUnsupportedCodec; got %v", err)
+	}
+	if err := trie.Walk(ipld.LinkContext{}, lsys, notTrie, func(trie.WalkNode) error { return nil }); !errors.As(err, &unsupported) {
+		t.Errorf("expected Walk of a non-trie root to fail with ErrUnsupportedCodec; got %v", err)
+	}
+	var wrongTarget dageth.ErrWrongLinkTarget
+	otherTrie := cid.NewCidV1(cid.EthStateTrie, root.Hash())
+	if err := trie.Diff(ipld.LinkContext{}, lsys, root, otherTrie, func([]byte, ipld.Node, ipld.Node) error { return nil }); !errors.As(err, &wrongTarget) {
+		t.Errorf("expected Diff of roots of different tries to fail with ErrWrongLinkTarget; got %v", err)
+	}
+
+	last := proof[len(proof)-1].CID
+	delete(store.Bag, last.KeyString())
+	var missing trie.ErrMissingNode
+	if _, err := trie.Get(ipld.LinkContext{}, lsys, root, key); !errors.As(err, &missing) || missing.CID != last {
+		t.Errorf("expected Get through a node missing from storage to fail with ErrMissingNode; got %v", err)
+	}
+	if err := trie.Walk(ipld.LinkContext{}, lsys, root, func(trie.WalkNode) error { return nil }); !errors.As(err, &missing) {
+		t.Errorf("expected Walk through a node missing from storage to fail with ErrMissingNode; got %v", err)
+	}
+}
@@ -0,0 +1,190 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+)
+
+// LargestStorageTries is the number of storage tries Stats reports in TrieStats.LargestStorageTries
+const LargestStorageTries = 10
+
+// TrieStats describes the shape of a trie.
+// Subtries linked from several positions in the trie are counted at every position they appear at.
+type TrieStats struct {
+	// Nodes counts the nodes of the trie by kind, including embedded nodes
+	Nodes map[NodeKind]int
+	// Embedded counts the nodes embedded directly in their parent
+	Embedded int
+	// Depths counts the nodes of the trie by their depth, the number of nodes above them
+	Depths map[int]int
+	// FanOut counts the branch nodes of the trie by their number of children
+	FanOut map[int]int
+	// Blocks counts the linked nodes of the trie, the nodes stored as separate blocks
+	Blocks int
+	// Size is the total length of the RLP encoding of the linked nodes of the trie
+	Size int
+	// UniqueCIDs counts the distinct CIDs of the linked nodes of the trie
+	UniqueCIDs int
+	// SharedCIDs counts the CIDs linked from more than one position in the trie
+	SharedCIDs int
+
+	// Accounts counts the accounts of a state trie
+	Accounts int
+	// AccountsWithStorage counts the accounts of a state trie with a non-empty storage trie
+	AccountsWithStorage int
+	// LargestStorageTries holds the largest storage tries of a state trie by Size, largest first
+	LargestStorageTries []StorageTrieStats
+}
+
+// StorageTrieStats describes the storage trie of an account in a state trie
+type StorageTrieStats struct {
+	// Key is the state trie key of the account, the keccak256 hash of its address
+	Key []byte
+	// Root is the StorageRootCID of the account
+	Root cid.Cid
+	// Nodes is the number of nodes of the storage trie, including embedded nodes
+	Nodes int
+	// Size is the total length of the RLP encoding of the linked nodes of the storage trie
+	Size int
+}
+
+// AverageSize returns the average length of the RLP encoding of the linked nodes of the trie
+func (s *TrieStats) AverageSize() float64 {
+	if s.Blocks == 0 {
+		return 0
+	}
+	return float64(s.Size) / float64(s.Blocks)
+}
+
+// Stats walks the trie rooted at the provided CID, loading nodes from the LinkSystem, and returns statistics on its
+// shape. For a state trie root it also walks the storage trie of every account, reporting the accounts with storage
+// and the LargestStorageTries storage tries; a storage trie shared by several accounts is walked once.
+func Stats(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, root cid.Cid) (*TrieStats, error) {
+	stats := &TrieStats{
+		Nodes:  make(map[NodeKind]int),
+		Depths: make(map[int]int),
+		FanOut: make(map[int]int),
+	}
+	cids := make(map[cid.Cid]int)
+	storageTries := make(map[cid.Cid]*TrieStats)
+	err := Walk(lnkCtx, lsys, root, func(n WalkNode) error {
+		stats.Nodes[n.Kind]++
+		stats.Depths[n.Depth]++
+		if n.CID.Defined() {
+			stats.Blocks++
+			stats.Size += n.Size
+			cids[n.CID]++
+		} else {
+			stats.Embedded++
+		}
+		node, _, err := NodeAndKind(n.Node)
+		if err != nil {
+			return err
+		}
+		if n.Kind == BRANCH_NODE {
+			fanOut, err := branchFanOut(node)
+			if err != nil {
+				return err
+			}
+			stats.FanOut[fanOut]++
+		}
+		if n.Codec != cid.EthStateTrie || n.Key == nil {
+			return nil
+		}
+		stats.Accounts++
+		storageRoot, err := accountStorageRoot(node)
+		if err != nil {
+			return fmt.Errorf("account %x: %v", n.Key, err)
+		}
+		storageTrie, err := rootSubtrie(storageRoot)
+		if err != nil {
+			return err
+		}
+		if storageTrie.empty() {
+			return nil
+		}
+		stats.AccountsWithStorage++
+		storageStats, ok := storageTries[storageRoot]
+		if !ok {
+			if storageStats, err = Stats(lnkCtx, lsys, storageRoot); err != nil {
+				return fmt.Errorf("storage trie of account %x: %v", n.Key, err)
+			}
+			storageTries[storageRoot] = storageStats
+		}
+		var nodes int
+		for _, count := range storageStats.Nodes {
+			nodes += count
+		}
+		stats.LargestStorageTries = appendLargest(stats.LargestStorageTries, StorageTrieStats{
+			Key:   n.Key,
+			Root:  storageRoot,
+			Nodes: nodes,
+			Size:  storageStats.Size,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats.UniqueCIDs = len(cids)
+	for _, count := range cids {
+		if count > 1 {
+			stats.SharedCIDs++
+		}
+	}
+	return stats, nil
+}
+
+// branchFanOut returns the number of children of a branch node
+func branchFanOut(node ipld.Node) (int, error) {
+	var fanOut int
+	for i := 0; i < 16; i++ {
+		childNode, err := node.LookupByString(BranchChildKey(i))
+		if err != nil {
+			return 0, err
+		}
+		if !childNode.IsNull() {
+			fanOut++
+		}
+	}
+	return fanOut, nil
+}
+
+// accountStorageRoot returns the StorageRootCID of the Account held by a state trie leaf or branch node
+func accountStorageRoot(node ipld.Node) (cid.Cid, error) {
+	valUnionNode, err := nullableValue(node)
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	acct, err := unwrapValue(valUnionNode)
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	if acct == nil {
+		return cid.Cid{}, fmt.Errorf("state trie node holds no account")
+	}
+	storageRootNode, err := acct.LookupByString("StorageRootCID")
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	return linkToCID(storageRootNode)
+}
+
+// appendLargest adds the storage trie to the list, keeping the list sorted by size and at most LargestStorageTries long
+func appendLargest(largest []StorageTrieStats, s StorageTrieStats) []StorageTrieStats {
+	largest = append(largest, s)
+	sort.SliceStable(largest, func(i, j int) bool {
+		if largest[i].Size != largest[j].Size {
+			return largest[i].Size > largest[j].Size
+		}
+		return bytes.Compare(largest[i].Key, largest[j].Key) < 0
+	})
+	if len(largest) > LargestStorageTries {
+		largest = largest[:LargestStorageTries]
+	}
+	return largest
+}
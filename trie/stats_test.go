@@ -0,0 +1,129 @@
+package trie_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/multiformats/go-multihash"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestStats(t *testing.T) {
+	lsys, store := newTestLinkSystem()
+	storageRoots := make([]cid.Cid, 15)
+	for i := range storageRoots {
+		builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+		if err != nil {
+			t.Fatalf("unable to create storage trie builder: %v", err)
+		}
+		for j := 0; j <= i*5; j++ {
+			builder.Put(crypto.Keccak256(common.BigToHash(big.NewInt(int64(j))).Bytes()), []byte{byte(j + 1)})
+		}
+		if storageRoots[i], err = builder.Commit(ipld.LinkContext{}); err != nil {
+			t.Fatalf("unable to commit storage trie: %v", err)
+		}
+	}
+	storageBlocks := len(store.Bag)
+
+	stats, err := trie.Stats(ipld.LinkContext{}, lsys, storageRoots[len(storageRoots)-1])
+	if err != nil {
+		t.Fatalf("unable to compute storage trie stats: %v", err)
+	}
+	if stats.Nodes[trie.LEAF_NODE] != 71 {
+		t.Errorf("storage trie stats counted %d leaves; expected 71", stats.Nodes[trie.LEAF_NODE])
+	}
+	if stats.Depths[0] != 1 {
+		t.Errorf("storage trie stats should count a single root; got %d", stats.Depths[0])
+	}
+	var branches int
+	for fanOut, count := range stats.FanOut {
+		if fanOut < 2 || fanOut > 16 {
+			t.Errorf("branch fan-out %d out of range", fanOut)
+		}
+		branches += count
+	}
+	if branches != stats.Nodes[trie.BRANCH_NODE] {
+		t.Errorf("fan-out distribution counts %d branches; expected %d", branches, stats.Nodes[trie.BRANCH_NODE])
+	}
+	if stats.UniqueCIDs != stats.Blocks || stats.SharedCIDs != 0 {
+		t.Errorf("storage trie should have no shared CIDs; got %d unique CIDs for %d blocks", stats.UniqueCIDs, stats.Blocks)
+	}
+	if stats.AverageSize() <= 0 || stats.AverageSize() > 532 {
+		t.Errorf("average node size %f out of range", stats.AverageSize())
+	}
+
+	// accounts 0 and 1 share the largest storage trie, account 2 has no storage
+	stateBuilder, err := trie.NewBuilder(lsys, cid.EthStateTrie)
+	if err != nil {
+		t.Fatalf("unable to create state trie builder: %v", err)
+	}
+	for i := 0; i < 20; i++ {
+		root := types.EmptyRootHash
+		switch {
+		case i < 2:
+			root = cidDigest(t, storageRoots[len(storageRoots)-1])
+		case i > 2 && i-3 < len(storageRoots)-1:
+			root = cidDigest(t, storageRoots[i-3])
+		}
+		acctRLP, err := rlp.EncodeToBytes(&types.StateAccount{
+			Nonce:    uint64(i),
+			Balance:  big.NewInt(int64(i)),
+			Root:     root,
+			CodeHash: crypto.Keccak256(nil),
+		})
+		if err != nil {
+			t.Fatalf("unable to RLP encode account: %v", err)
+		}
+		stateBuilder.Put(shared.AddressToLeafKey(common.BigToAddress(big.NewInt(int64(i)))), acctRLP)
+	}
+	stateRoot, err := stateBuilder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit state trie: %v", err)
+	}
+	stats, err = trie.Stats(ipld.LinkContext{}, lsys, stateRoot)
+	if err != nil {
+		t.Fatalf("unable to compute state trie stats: %v", err)
+	}
+	if stats.Accounts != 20 {
+		t.Errorf("state trie stats counted %d accounts; expected 20", stats.Accounts)
+	}
+	if stats.AccountsWithStorage != 16 {
+		t.Errorf("state trie stats counted %d accounts with storage; expected 16", stats.AccountsWithStorage)
+	}
+	if stats.Blocks != len(store.Bag)-storageBlocks {
+		t.Errorf("state trie stats counted %d blocks; expected %d", stats.Blocks, len(store.Bag)-storageBlocks)
+	}
+	if len(stats.LargestStorageTries) != trie.LargestStorageTries {
+		t.Fatalf("state trie stats reported %d storage tries; expected %d", len(stats.LargestStorageTries), trie.LargestStorageTries)
+	}
+	for i, s := range stats.LargestStorageTries[:2] {
+		if s.Root != storageRoots[len(storageRoots)-1] {
+			t.Errorf("largest storage trie %d should be the shared storage trie; got %s", i, s.Root.String())
+		}
+	}
+	for i := 1; i < len(stats.LargestStorageTries); i++ {
+		if stats.LargestStorageTries[i].Size > stats.LargestStorageTries[i-1].Size {
+			t.Errorf("largest storage tries are not sorted by size")
+		}
+	}
+	if !bytes.Equal(stats.LargestStorageTries[2].Key, shared.AddressToLeafKey(common.BigToAddress(big.NewInt(16)))) {
+		t.Errorf("third largest storage trie should belong to account 16")
+	}
+}
+
+func cidDigest(t *testing.T, c cid.Cid) common.Hash {
+	decodedMh, err := multihash.Decode(c.Hash())
+	if err != nil {
+		t.Fatalf("unable to decode multihash: %v", err)
+	}
+	return common.BytesToHash(decodedMh.Digest)
+}
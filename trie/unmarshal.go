@@ -3,49 +3,91 @@ package trie
 import (
 	"fmt"
 	"io"
-	"io/ioutil"
-	"strconv"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
-	"github.com/vulcanize/go-codec-dageth/log"
-	"github.com/vulcanize/go-codec-dageth/rct"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/log"
+	"github.com/vulcanize/go-codec-dageth/rct"
 	"github.com/vulcanize/go-codec-dageth/shared"
 	account "github.com/vulcanize/go-codec-dageth/state_account"
 	"github.com/vulcanize/go-codec-dageth/tx"
 )
 
-const logTrieMulticodec = uint64(0x99) // Proposed
-
 // DecodeTrieNode provides an IPLD codec decode interface for eth merkle patricia trie nodes
 // It's not possible to meet the Decode(na ipld.NodeAssembler, in io.Reader) interface
 // for a function that supports all trie types (multicodec types), unlike with encoding.
 // this is used by Decode functions for each trie type, which are the ones registered to their
 // corresponding multicodec
 func DecodeTrieNode(na ipld.NodeAssembler, in io.Reader, codec uint64) error {
-	var src []byte
-	if buf, ok := in.(interface{ Bytes() []byte }); ok {
-		src = buf.Bytes()
-	} else {
-		var err error
-		src, err = ioutil.ReadAll(in)
-		if err != nil {
-			return err
-		}
-	}
-	return DecodeTrieNodeBytes(na, src, codec)
+	return DecodeOptions{}.DecodeTrieNode(na, in, codec)
 }
 
 // DecodeTrieNodeBytes is like DecodeTrieNode, but it uses an input buffer directly.
+// DecodeTrieNode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
+// Malformed input is rejected with ErrWrongArity, ErrBadHexPrefix, ErrBadHashRef, ErrTrailingBytes or
+// ErrUnexpectedMember, wrapped in a dageth.ErrInvalidBinary, rather than being assembled into a partial node.
 func DecodeTrieNodeBytes(na ipld.NodeAssembler, src []byte, codec uint64) error {
+	return DecodeOptions{}.DecodeTrieNodeBytes(na, src, codec)
+}
+
+// DecodeOptions can be used to customize the behavior of the decoding functions
+type DecodeOptions struct {
+	// Receipt is used to decode the receipts held in the leaves and branches of receipt trie nodes
+	Receipt rct.DecodeOptions
+}
+
+// DecodeTrieNode is like the package level DecodeTrieNode, but it uses the options
+func (opts DecodeOptions) DecodeTrieNode(na ipld.NodeAssembler, in io.Reader, codec uint64) error {
+	if buf, ok := in.(interface{ Bytes() []byte }); ok {
+		return opts.DecodeTrieNodeBytes(na, buf.Bytes(), codec)
+	}
+	// the kind of the node, which is assembled first, depends on the number of its members, so the node's list is
+	// read whole before it is assembled
+	var nodeFields []interface{}
+	trailing, err := shared.StreamDecode(in, func(s *rlp.Stream) error {
+		return s.Decode(&nodeFields)
+	})
+	if err != nil {
+		return dageth.ErrInvalidBinary{Type: "TrieNode", Err: err}
+	}
+	if trailing > 0 {
+		return dageth.ErrInvalidBinary{Type: "TrieNode", Err: ErrTrailingBytes{Count: int(trailing)}}
+	}
+	if err := opts.unpackTrieNode(na, nodeFields, codec); err != nil {
+		return dageth.ErrInvalidBinary{Type: "TrieNode", Err: err}
+	}
+	return nil
+}
+
+// DecodeTrieNodeBytes is like the package level DecodeTrieNodeBytes, but it uses the options
+func (opts DecodeOptions) DecodeTrieNodeBytes(na ipld.NodeAssembler, src []byte, codec uint64) error {
+	_, rest, err := rlp.SplitList(src)
+	if err != nil {
+		return dageth.ErrInvalidBinary{Type: "TrieNode", Err: err}
+	}
+	if len(rest) > 0 {
+		return dageth.ErrInvalidBinary{Type: "TrieNode", Err: ErrTrailingBytes{Count: len(rest)}}
+	}
 	var nodeFields []interface{}
 	if err := rlp.DecodeBytes(src, &nodeFields); err != nil {
-		return err
+		return dageth.ErrInvalidBinary{Type: "TrieNode", Err: err}
+	}
+	if err := opts.unpackTrieNode(na, nodeFields, codec); err != nil {
+		return dageth.ErrInvalidBinary{Type: "TrieNode", Err: err}
+	}
+	return nil
+}
+
+// unpackTrieNode assembles the TrieNode union from the decoded RLP list of a trie node
+// this is used for both top-level nodes and nodes embedded directly in their parent
+func (opts DecodeOptions) unpackTrieNode(na ipld.NodeAssembler, nodeFields []interface{}, codec uint64) error {
+	if len(nodeFields) != 2 && len(nodeFields) != 17 {
+		return ErrWrongArity{Arity: len(nodeFields)}
 	}
 	ma, err := na.BeginMap(1)
 	if err != nil {
@@ -66,7 +108,7 @@ func DecodeTrieNodeBytes(na ipld.NodeAssembler, src []byte, codec uint64) error
 			if err != nil {
 				return err
 			}
-			if err := unpackExtensionNode(extNodeMA, decoded, codec); err != nil {
+			if err := opts.unpackExtensionNode(extNodeMA, decoded, codec); err != nil {
 				return err
 			}
 			if err := extNodeMA.Finish(); err != nil {
@@ -80,14 +122,14 @@ func DecodeTrieNodeBytes(na ipld.NodeAssembler, src []byte, codec uint64) error
 			if err != nil {
 				return err
 			}
-			if err := unpackLeafNode(leafNodeMA, decoded, codec); err != nil {
+			if err := opts.unpackLeafNode(leafNodeMA, decoded, codec); err != nil {
 				return err
 			}
 			if err := leafNodeMA.Finish(); err != nil {
 				return err
 			}
 		default:
-			return fmt.Errorf("unrecognized trie node type %s", nodeKind.String())
+			return dageth.ErrInvalidField{Type: "TrieNode", Field: "PartialPath", Reason: fmt.Sprintf("flags unrecognized trie node type %s", nodeKind.String())}
 		}
 	case 17:
 		if err := ma.AssembleKey().AssignString(BRANCH_NODE.String()); err != nil {
@@ -97,7 +139,7 @@ func DecodeTrieNodeBytes(na ipld.NodeAssembler, src []byte, codec uint64) error
 		if err != nil {
 			return err
 		}
-		if err := unpackBranchNode(branchNodeMA, nodeFields, codec); err != nil {
+		if err := opts.unpackBranchNode(branchNodeMA, nodeFields, codec); err != nil {
 			return err
 		}
 		if err := branchNodeMA.Finish(); err != nil {
@@ -107,10 +149,10 @@ func DecodeTrieNodeBytes(na ipld.NodeAssembler, src []byte, codec uint64) error
 	return ma.Finish()
 }
 
-func unpackExtensionNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint64) error {
+func (opts DecodeOptions) unpackExtensionNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint64) error {
 	partialPath, ok := nodeFields[0].([]byte)
 	if !ok {
-		return fmt.Errorf("extension node requires partial path byte slice")
+		return ErrUnexpectedMember{Member: "partial path", Reason: "should be a byte string"}
 	}
 	if err := ma.AssembleKey().AssignString("PartialPath"); err != nil {
 		return err
@@ -121,99 +163,31 @@ func unpackExtensionNode(ma ipld.MapAssembler, nodeFields []interface{}, codec u
 	if err := ma.AssembleKey().AssignString("Child"); err != nil {
 		return err
 	}
-	childLink, ok := nodeFields[1].([]byte)
-	if !ok {
-		return fmt.Errorf("unable to assert second member of extension node to type `[]byte`")
+	if childLink, ok := nodeFields[1].([]byte); ok && len(childLink) != 32 {
+		return ErrBadHashRef{Length: len(childLink)}
 	}
-	childCID := shared.Keccak256ToCid(codec, childLink)
-	childCIDLink := cidlink.Link{Cid: childCID}
-	return ma.AssembleValue().AssignLink(childCIDLink)
+	return opts.unpackChild(ma.AssembleValue(), nodeFields[1], codec)
 }
 
-func unpackBranchNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint64) error {
+func (opts DecodeOptions) unpackBranchNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint64) error {
 	for i := 0; i < 16; i++ {
-		key := fmt.Sprintf("Child%s", strings.ToUpper(strconv.FormatInt(int64(i), 16)))
+		key := BranchChildKey(i)
 		if err := ma.AssembleKey().AssignString(key); err != nil {
 			return err
 		}
-		childNodeBuilder := dageth.Type.Child.NewBuilder()
-		childNodeMA, err := childNodeBuilder.BeginMap(1)
-		if err != nil {
-			return err
-		}
-		childLink, ok := nodeFields[i].([]byte)
-		if ok {
+		if childLink, ok := nodeFields[i].([]byte); ok {
 			switch len(childLink) {
 			case 0:
 				if err := ma.AssembleValue().AssignNull(); err != nil {
 					return err
 				}
+				continue
 			case 32:
-				// it's a hash referencing the child node
-				// make CID link from the bytes
-				// assign the link value to the MA
-				childCID := shared.Keccak256ToCid(codec, childLink)
-				childCIDLink := cidlink.Link{Cid: childCID}
-				if err := childNodeMA.AssembleKey().AssignString("Link"); err != nil {
-					return err
-				}
-				if err := childNodeMA.AssembleValue().AssignLink(childCIDLink); err != nil {
-					return err
-				}
-				if err := childNodeMA.Finish(); err != nil {
-					return err
-				}
-				if err := ma.AssembleValue().AssignNode(childNodeBuilder.Build()); err != nil {
-					return err
-				}
 			default:
-				return fmt.Errorf("branch node child (%d) of unexpected length %d", i, len(childLink))
+				return ErrBadHashRef{Length: len(childLink)}
 			}
-			continue
-		}
-		// the child node is included directly
-		// it must be a leaf node, branch and extension will never be less than 32 bytes
-		childLeaf, ok := nodeFields[i].([]interface{})
-		if !ok {
-			return fmt.Errorf("unable to decode branch node entry into []byte or []interface{}")
-		}
-		if len(childLeaf) != 2 {
-			return fmt.Errorf("unexpected number of entries for leaf node; got %d want 2", len(childLeaf))
-		}
-		nodeKind, decodedChildLeaf, err := decodeTwoMemberNode(childLeaf)
-		if err != nil {
-			return err
 		}
-		if nodeKind != LEAF_NODE {
-			return fmt.Errorf("child node included directly in branch must be a leaf; got %s", nodeKind.String())
-		}
-		if err := childNodeMA.AssembleKey().AssignString("TrieNode"); err != nil {
-			return err
-		}
-		childTrieNodeMA, err := childNodeMA.AssembleValue().BeginMap(1)
-		if err != nil {
-			return err
-		}
-		if err := childTrieNodeMA.AssembleKey().AssignString(LEAF_NODE.String()); err != nil {
-			return err
-		}
-		leafNodeMA, err := childTrieNodeMA.AssembleValue().BeginMap(2)
-		if err != nil {
-			return err
-		}
-		if err := unpackLeafNode(leafNodeMA, decodedChildLeaf, codec); err != nil {
-			return err
-		}
-		if err := leafNodeMA.Finish(); err != nil {
-			return err
-		}
-		if err := childTrieNodeMA.Finish(); err != nil {
-			return err
-		}
-		if err := childNodeMA.Finish(); err != nil {
-			return err
-		}
-		if err := ma.AssembleValue().AssignNode(childNodeBuilder.Build()); err != nil {
+		if err := opts.unpackChild(ma.AssembleValue(), nodeFields[i], codec); err != nil {
 			return err
 		}
 	}
@@ -222,7 +196,7 @@ func unpackBranchNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint
 	}
 	valBytes, ok := nodeFields[16].([]byte)
 	if !ok {
-		return fmt.Errorf("branch node 17th member should be a byte array (val)")
+		return ErrUnexpectedMember{Member: "branch node value", Reason: "should be a byte string"}
 	}
 	if len(valBytes) == 0 {
 		return ma.AssembleValue().AssignNull()
@@ -231,20 +205,70 @@ func unpackBranchNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint
 	if err != nil {
 		return err
 	}
-	if err := unpackValue(valUnionNodeMA, valBytes, codec); err != nil {
+	if err := opts.unpackValue(valUnionNodeMA, valBytes, codec); err != nil {
 		return err
 	}
 	return valUnionNodeMA.Finish()
 }
 
-func unpackLeafNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint64) error {
+// unpackChild assembles the Child union for a branch or extension node child
+// the child is either the hash referencing the child node, or the child node itself when its RLP encoding is
+// less than 32 bytes, in which case it is included directly in the parent and can be a leaf, extension or branch node
+func (opts DecodeOptions) unpackChild(na ipld.NodeAssembler, child interface{}, codec uint64) error {
+	childMA, err := na.BeginMap(1)
+	if err != nil {
+		return err
+	}
+	switch child := child.(type) {
+	case []byte:
+		// it's a hash referencing the child node
+		// make CID link from the bytes
+		// assign the link value to the MA
+		childCID := shared.Keccak256ToCid(codec, child)
+		childCIDLink := cidlink.Link{Cid: childCID}
+		if err := childMA.AssembleKey().AssignString("Link"); err != nil {
+			return err
+		}
+		if err := childMA.AssembleValue().AssignLink(childCIDLink); err != nil {
+			return err
+		}
+	case []interface{}:
+		// the child node is included directly
+		if err := checkEmbeddedSize(child); err != nil {
+			return err
+		}
+		if err := childMA.AssembleKey().AssignString("TrieNode"); err != nil {
+			return err
+		}
+		if err := opts.unpackTrieNode(childMA.AssembleValue(), child, codec); err != nil {
+			return err
+		}
+	default:
+		return ErrUnexpectedMember{Member: "child", Reason: "should be a byte string or a list"}
+	}
+	return childMA.Finish()
+}
+
+// checkEmbeddedSize checks that the RLP encoding of a child node included directly in its parent is less than 32 bytes
+func checkEmbeddedSize(nodeFields []interface{}) error {
+	enc, err := rlp.EncodeToBytes(nodeFields)
+	if err != nil {
+		return err
+	}
+	if len(enc) >= 32 {
+		return ErrEmbeddedNodeSize{Size: len(enc)}
+	}
+	return nil
+}
+
+func (opts DecodeOptions) unpackLeafNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint64) error {
 	partialPath, ok := nodeFields[0].([]byte)
 	if !ok {
-		return fmt.Errorf("leaf node requires partial path byte slice")
+		return ErrUnexpectedMember{Member: "partial path", Reason: "should be a byte string"}
 	}
 	valBytes, ok := nodeFields[1].([]byte)
 	if !ok {
-		return fmt.Errorf("leaf node requires value byte slice")
+		return ErrUnexpectedMember{Member: "leaf node value", Reason: "should be a byte string"}
 	}
 	if err := ma.AssembleKey().AssignString("PartialPath"); err != nil {
 		return err
@@ -259,13 +283,13 @@ func unpackLeafNode(ma ipld.MapAssembler, nodeFields []interface{}, codec uint64
 	if err != nil {
 		return err
 	}
-	if err := unpackValue(valUnionNodeMA, valBytes, codec); err != nil {
+	if err := opts.unpackValue(valUnionNodeMA, valBytes, codec); err != nil {
 		return err
 	}
 	return valUnionNodeMA.Finish()
 }
 
-func unpackValue(ma ipld.MapAssembler, val []byte, codec uint64) error {
+func (opts DecodeOptions) unpackValue(ma ipld.MapAssembler, val []byte, codec uint64) error {
 	switch codec {
 	case cid.EthTxTrie:
 		if err := ma.AssembleKey().AssignString(TX_VALUE.String()); err != nil {
@@ -276,7 +300,7 @@ func unpackValue(ma ipld.MapAssembler, val []byte, codec uint64) error {
 		if err := ma.AssembleKey().AssignString(RCT_VALUE.String()); err != nil {
 			return err
 		}
-		return rct.DecodeBytes(ma.AssembleValue(), val)
+		return opts.Receipt.DecodeBytes(ma.AssembleValue(), val)
 	case cid.EthStateTrie:
 		if err := ma.AssembleKey().AssignString(STATE_VALUE.String()); err != nil {
 			return err
@@ -287,30 +311,40 @@ func unpackValue(ma ipld.MapAssembler, val []byte, codec uint64) error {
 			return err
 		}
 		return ma.AssembleValue().AssignBytes(val)
-	case logTrieMulticodec:
+	case dageth.EthLogTrie:
 		if err := ma.AssembleKey().AssignString(LOG_VALUE.String()); err != nil {
 			return err
 		}
 		return log.DecodeBytes(ma.AssembleValue(), val)
 	default:
-		return fmt.Errorf("unsupported multicodec type (%d) for eth TrieNode unmarshaller", codec)
+		return ErrUnsupportedCodec{Codec: codec}
 	}
 }
 
 // decodeTwoMemberNode takes a two-member node, discerns its type and decodes its partial path before returning it
 func decodeTwoMemberNode(i []interface{}) (NodeKind, []interface{}, error) {
-	first := i[0].([]byte)
-	decodedPartialPath := shared.CompactToHex(i[0].([]byte))
+	compactPath, ok := i[0].([]byte)
+	if !ok {
+		return UNKNOWN_NODE, nil, ErrUnexpectedMember{Member: "partial path", Reason: "should be a byte string"}
+	}
+	if len(compactPath) == 0 {
+		return UNKNOWN_NODE, nil, ErrBadHexPrefix{Path: compactPath}
+	}
+	flag := compactPath[0] >> 4
+	// the odd flag is the lowest bit of the flag nibble, for even length paths the low nibble is padding
+	if flag > 3 || (flag&1 == 0 && compactPath[0]&0x0f != 0) {
+		return UNKNOWN_NODE, nil, ErrBadHexPrefix{Path: compactPath}
+	}
 	decodedNode := []interface{}{
-		decodedPartialPath,
+		shared.CompactToHex(compactPath),
 		i[1],
 	}
-	switch first[0] / 16 {
-	case '\x00', '\x01':
+	if flag < 2 {
+		// extension nodes cannot have an empty partial path
+		if len(compactPath) == 1 && flag == 0 {
+			return UNKNOWN_NODE, nil, ErrBadHexPrefix{Path: compactPath}
+		}
 		return EXTENSION_NODE, decodedNode, nil
-	case '\x02', '\x03':
-		return LEAF_NODE, decodedNode, nil
-	default:
-		return UNKNOWN_NODE, nil, fmt.Errorf("unknown hex prefix")
 	}
+	return LEAF_NODE, decodedNode, nil
 }
@@ -0,0 +1,190 @@
+package trie_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	_ "github.com/vulcanize/go-codec-dageth/log_trie"
+	_ "github.com/vulcanize/go-codec-dageth/rct_trie"
+	"github.com/vulcanize/go-codec-dageth/shared/fuzztest"
+	_ "github.com/vulcanize/go-codec-dageth/state_trie"
+	_ "github.com/vulcanize/go-codec-dageth/storage_trie"
+	"github.com/vulcanize/go-codec-dageth/trie"
+	_ "github.com/vulcanize/go-codec-dageth/tx_trie"
+)
+
+var trieCodecs = []uint64{cid.EthTxTrie, cid.EthTxReceiptTrie, cid.EthStateTrie, cid.EthStorageTrie, dageth.EthLogTrie}
+
+func mustEncode(t testing.TB, val interface{}) []byte {
+	enc, err := rlp.EncodeToBytes(val)
+	if err != nil {
+		t.Fatalf("unable to RLP encode: %v", err)
+	}
+	return enc
+}
+
+func emptyBranch() []interface{} {
+	branch := make([]interface{}, 17)
+	for i := range branch {
+		branch[i] = []byte{}
+	}
+	return branch
+}
+
+func TestDecodeTrieNodeErrors(t *testing.T) {
+	hash := common.HexToHash("0xaa").Bytes()
+	shortRefBranch := emptyBranch()
+	shortRefBranch[3] = []byte{1, 2, 3}
+	listValueBranch := emptyBranch()
+	listValueBranch[16] = []interface{}{}
+	validLeaf := mustEncode(t, []interface{}{[]byte{0x20, 0x01}, []byte{0x01}})
+
+	testCases := []struct {
+		name  string
+		input []byte
+		check func(err error) bool
+	}{
+		{"empty list", mustEncode(t, []interface{}{}), func(err error) bool {
+			var e trie.ErrWrongArity
+			return errors.As(err, &e) && e.Arity == 0
+		}},
+		{"three members", mustEncode(t, []interface{}{[]byte{}, []byte{}, []byte{}}), func(err error) bool {
+			var e trie.ErrWrongArity
+			return errors.As(err, &e) && e.Arity == 3
+		}},
+		{"embedded node of wrong arity", mustEncode(t, []interface{}{[]byte{0x11}, []interface{}{[]byte{}}}), func(err error) bool {
+			var e trie.ErrWrongArity
+			return errors.As(err, &e) && e.Arity == 1
+		}},
+		{"empty partial path", mustEncode(t, []interface{}{[]byte{}, hash}), func(err error) bool {
+			var e trie.ErrBadHexPrefix
+			return errors.As(err, &e)
+		}},
+		{"bad flag nibble", mustEncode(t, []interface{}{[]byte{0x41}, hash}), func(err error) bool {
+			var e trie.ErrBadHexPrefix
+			return errors.As(err, &e)
+		}},
+		{"non-zero padding nibble", mustEncode(t, []interface{}{[]byte{0x05, 0x12}, hash}), func(err error) bool {
+			var e trie.ErrBadHexPrefix
+			return errors.As(err, &e)
+		}},
+		{"extension with empty path", mustEncode(t, []interface{}{[]byte{0x00}, hash}), func(err error) bool {
+			var e trie.ErrBadHexPrefix
+			return errors.As(err, &e)
+		}},
+		{"short extension child ref", mustEncode(t, []interface{}{[]byte{0x11}, hash[:31]}), func(err error) bool {
+			var e trie.ErrBadHashRef
+			return errors.As(err, &e) && e.Length == 31
+		}},
+		{"short branch child ref", mustEncode(t, shortRefBranch), func(err error) bool {
+			var e trie.ErrBadHashRef
+			return errors.As(err, &e) && e.Length == 3
+		}},
+		{"list branch value", mustEncode(t, listValueBranch), func(err error) bool {
+			var e trie.ErrUnexpectedMember
+			return errors.As(err, &e)
+		}},
+		{"list partial path", mustEncode(t, []interface{}{[]interface{}{}, hash}), func(err error) bool {
+			var e trie.ErrUnexpectedMember
+			return errors.As(err, &e)
+		}},
+		{"trailing bytes", append(common.CopyBytes(validLeaf), 0x80, 0x80), func(err error) bool {
+			var e trie.ErrTrailingBytes
+			return errors.As(err, &e) && e.Count == 2
+		}},
+	}
+	for _, tc := range testCases {
+		// both the incremental decoding of readers and the decoding of buffers reject the input
+		for _, decode := range []func() error{
+			func() error {
+				return trie.DecodeTrieNode(dageth.Type.TrieNode.NewBuilder(), bytes.NewReader(tc.input), cid.EthStorageTrie)
+			},
+			func() error {
+				return trie.DecodeTrieNode(dageth.Type.TrieNode.NewBuilder(), bytes.NewBuffer(tc.input), cid.EthStorageTrie)
+			},
+		} {
+			err := decode()
+			if err == nil {
+				t.Errorf("%s: expected decoding to fail", tc.name)
+				continue
+			}
+			if !tc.check(err) {
+				t.Errorf("%s: unexpected error type (%T): %v", tc.name, err, err)
+			}
+			var invalid dageth.ErrInvalidBinary
+			if !errors.As(err, &invalid) || invalid.Type != "TrieNode" {
+				t.Errorf("%s: expected error to be a TrieNode ErrInvalidBinary: %v", tc.name, err)
+			}
+		}
+	}
+
+	builder := dageth.Type.TrieNode.NewBuilder()
+	if err := trie.DecodeTrieNode(builder, bytes.NewReader(validLeaf), cid.EthStorageTrie); err != nil {
+		t.Errorf("unable to decode valid leaf node: %v", err)
+	}
+}
+
+func TestDecodeTrieNodeOversizedReader(t *testing.T) {
+	// a list declaring 0x10000000000 bytes of content, holding a string declaring 0x800000000 bytes
+	oversized := []byte{0xfc, 0x10, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x08, 0x00, 0x00, 0x00, 0x00}
+	for name, in := range map[string]io.Reader{
+		"reader":         struct{ io.Reader }{bytes.NewReader(oversized)},
+		"bytes.Reader":   bytes.NewReader(oversized),
+		"io.LimitReader": io.LimitReader(bytes.NewReader(oversized), int64(len(oversized))),
+	} {
+		// decoding must be rejected without allocating for the declared sizes
+		err := trie.DecodeTrieNode(dageth.Type.TrieNode.NewBuilder(), in, cid.EthStateTrie)
+		var invalid dageth.ErrInvalidBinary
+		if !errors.As(err, &invalid) || invalid.Type != "TrieNode" {
+			t.Errorf("%s: expected a TrieNode ErrInvalidBinary; got %v", name, err)
+		}
+	}
+}
+
+func FuzzDecodeTrieNode(f *testing.F) {
+	hash := common.HexToHash("0xaa").Bytes()
+	branch := emptyBranch()
+	branch[0] = hash
+	branch[5] = []interface{}{[]byte{0x20}, []byte{0x01}}
+	f.Add(mustEncode(f, branch))
+	f.Add(mustEncode(f, []interface{}{[]byte{0x00, 0x12}, hash}))
+	f.Add(mustEncode(f, []interface{}{[]byte{0x11}, branch}))
+	f.Add(mustEncode(f, []interface{}{[]byte{0x3a, 0xbc}, []byte{0xc0}}))
+	f.Add([]byte{0xc0})
+	// leaves holding a value of each trie's type
+	txEnc, err := types.NewTransaction(1, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil).MarshalBinary()
+	if err != nil {
+		f.Fatal(err)
+	}
+	rctEnc, err := (&types.Receipt{Type: types.DynamicFeeTxType, Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}}).MarshalBinary()
+	if err != nil {
+		f.Fatal(err)
+	}
+	acctEnc := mustEncode(f, &types.StateAccount{Nonce: 1, Balance: big.NewInt(1), Root: types.EmptyRootHash, CodeHash: types.EmptyCodeHash.Bytes()})
+	logEnc := mustEncode(f, &types.Log{Topics: []common.Hash{common.HexToHash("0x01")}, Data: []byte{0x01}})
+	for _, val := range [][]byte{txEnc, rctEnc, acctEnc, logEnc} {
+		f.Add(mustEncode(f, []interface{}{[]byte{0x20, 0x80}, val}))
+	}
+	codecs := make([]*dageth.Codec, len(trieCodecs))
+	for i, code := range trieCodecs {
+		codec, ok := dageth.LookupCodec(code)
+		if !ok {
+			f.Fatalf("trie codec %d is not in the dageth.Codecs table", code)
+		}
+		codecs[i] = codec
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, codec := range codecs {
+			fuzztest.FuzzRoundTrip(t, codec, data)
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package trie
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
+)
+
+// WalkNode is a trie node visited during a walk
+type WalkNode struct {
+	// Path is the absolute nibble path from the root to the node
+	Path shared.Nibbles
+	// CID is the CID of the node, it is undefined for nodes embedded directly in their parent
+	CID cid.Cid
+	// Codec is the trie multicodec type of the node, that of the root of the trie
+	Codec uint64
+	// Depth is the number of nodes above the node, 0 for the root
+	Depth int
+	// Size is the length of the RLP encoding of the node, it is 0 for nodes embedded directly in their parent
+	Size int
+	// Kind is the kind of the node (branch, extension or leaf)
+	Kind NodeKind
+	// Node is the TrieNode union
+	Node ipld.Node
+	// Key is the full key of the value held by the node, for leaf nodes and branch nodes that hold a value.
+	// It is nil for all other nodes. For the state and storage tries this is the 32-byte keccak256 hash of the
+	// address or slot.
+	Key []byte
+}
+
+// WalkFunc is called by Walk for every node in the trie
+// Returning SkipChildren from a WalkFunc prevents the children of the node from being visited
+type WalkFunc func(n WalkNode) error
+
+// SkipChildren is returned by a WalkFunc to skip the children of the node being visited
+var SkipChildren = errors.New("skip children")
+
+// Walk walks the trie rooted at the provided CID depth-first, loading nodes from the LinkSystem, and calls fn for every
+// node, including nodes embedded in their parent. Children are visited in ascending nibble order, so leaf nodes are
+// visited in ascending key order. A root CID for the empty root hash is treated as an empty trie.
+func Walk(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, root cid.Cid, fn WalkFunc) error {
+	if !isTrieCodec(root.Prefix().Codec) {
+		return ErrUnsupportedCodec{Codec: root.Prefix().Codec}
+	}
+	rootTrie, err := rootSubtrie(root)
+	if err != nil {
+		return err
+	}
+	if rootTrie.empty() {
+		return nil
+	}
+	w := &walker{
+		lnkCtx: lnkCtx,
+		lsys:   lsys,
+		codec:  root.Prefix().Codec,
+		fn:     fn,
+	}
+	return w.walk(root, nil, shared.Nibbles{}, 0)
+}
+
+type walker struct {
+	lnkCtx ipld.LinkContext
+	lsys   ipld.LinkSystem
+	codec  uint64
+	fn     WalkFunc
+	// pool is set for parallel walks, to hand linked children off to other goroutines
+	pool *walkPool
+}
+
+// walk visits the node, loading it from its CID if it is not embedded, and then its children
+func (w *walker) walk(c cid.Cid, node ipld.Node, path shared.Nibbles, depth int) error {
+	var size int
+	if node == nil {
+		if w.lnkCtx.Ctx != nil {
+			if err := w.lnkCtx.Ctx.Err(); err != nil {
+				return err
+			}
+		}
+		var raw []byte
+		var err error
+		node, raw, err = loadTrieNode(w.lnkCtx, w.lsys, c)
+		if err != nil {
+			return err
+		}
+		size = len(raw)
+	}
+	n, kind, err := NodeAndKind(node)
+	if err != nil {
+		return err
+	}
+	wn := WalkNode{
+		Path:  path,
+		CID:   c,
+		Codec: w.codec,
+		Depth: depth,
+		Size:  size,
+		Kind:  kind,
+		Node:  node,
+	}
+	switch kind {
+	case BRANCH_NODE:
+		val, err := nullableValue(n)
+		if err != nil {
+			return err
+		}
+		if val != nil {
+			if wn.Key, err = path.Key(); err != nil {
+				return dageth.ErrInvalidField{Type: "TrieNode", Field: BRANCH_NODE.String() + "/Value", Reason: fmt.Sprintf("found at invalid path %s", path.String()), Err: err}
+			}
+		}
+		if err := w.fn(wn); err != nil {
+			if err == SkipChildren {
+				return nil
+			}
+			return err
+		}
+		for i := 0; i < 16; i++ {
+			childNode, err := n.LookupByString(BranchChildKey(i))
+			if err != nil {
+				return err
+			}
+			if childNode.IsNull() {
+				continue
+			}
+			if err := w.walkChild(childNode, path.Append(shared.Nibbles{byte(i)}), depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	case EXTENSION_NODE:
+		pp, err := partialPath(n)
+		if err != nil {
+			return err
+		}
+		if err := w.fn(wn); err != nil {
+			if err == SkipChildren {
+				return nil
+			}
+			return err
+		}
+		childNode, err := n.LookupByString("Child")
+		if err != nil {
+			return err
+		}
+		return w.walkChild(childNode, path.Append(pp), depth+1)
+	case LEAF_NODE:
+		pp, err := partialPath(n)
+		if err != nil {
+			return err
+		}
+		fullPath := path.Append(pp)
+		if wn.Key, err = fullPath.Key(); err != nil {
+			return dageth.ErrInvalidField{Type: "TrieNode", Field: LEAF_NODE.String() + "/Value", Reason: fmt.Sprintf("found at invalid path %s", fullPath.String()), Err: err}
+		}
+		if err := w.fn(wn); err != nil && err != SkipChildren {
+			return err
+		}
+		return nil
+	default:
+		return dageth.ErrInvalidField{Type: "TrieNode", Reason: fmt.Sprintf("is of unrecognized kind %s", kind.String())}
+	}
+}
+
+func (w *walker) walkChild(childNode ipld.Node, path shared.Nibbles, depth int) error {
+	childCID, embedded, err := resolveChild(childNode)
+	if err != nil {
+		return err
+	}
+	if embedded == nil && w.pool != nil {
+		if !w.pool.visit(childCID) {
+			return nil
+		}
+		if w.pool.spawn(func() error { return w.walk(childCID, nil, path, depth) }) {
+			return nil
+		}
+	}
+	return w.walk(childCID, embedded, path, depth)
+}
@@ -0,0 +1,79 @@
+package trie_test
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+
+	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/trie"
+)
+
+func TestWalk(t *testing.T) {
+	lsys, store := newTestLinkSystem()
+	builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create storage trie builder: %v", err)
+	}
+	var keys [][]byte
+	for i := 0; i < 100; i++ {
+		key := crypto.Keccak256(common.BigToHash(big.NewInt(int64(i))).Bytes())
+		keys = append(keys, key)
+		builder.Put(key, []byte{byte(i + 1)})
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+
+	var leafKeys [][]byte
+	linked := 0
+	err = trie.Walk(ipld.LinkContext{}, lsys, root, func(n trie.WalkNode) error {
+		if n.CID.Defined() {
+			linked++
+		}
+		if n.Kind == trie.LEAF_NODE {
+			if len(n.Key) != 32 {
+				t.Errorf("leaf key should be 32 bytes; got %d", len(n.Key))
+			}
+			if !shared.KeyToNibbles(n.Key).HasPrefix(n.Path) {
+				t.Errorf("leaf path (%s) should be a prefix of its key (%x)", n.Path.String(), n.Key)
+			}
+			leafKeys = append(leafKeys, n.Key)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to walk storage trie: %v", err)
+	}
+	if linked != len(store.Bag) {
+		t.Errorf("walk visited %d linked nodes; expected %d", linked, len(store.Bag))
+	}
+	if len(leafKeys) != len(keys) {
+		t.Fatalf("walk visited %d leaf nodes; expected %d", len(leafKeys), len(keys))
+	}
+	for i, key := range keys {
+		if !bytes.Equal(leafKeys[i], key) {
+			t.Errorf("leaf key %d (%x) does not match expected key (%x)", i, leafKeys[i], key)
+		}
+	}
+
+	visited := 0
+	err = trie.Walk(ipld.LinkContext{}, lsys, root, func(n trie.WalkNode) error {
+		visited++
+		return trie.SkipChildren
+	})
+	if err != nil {
+		t.Fatalf("unable to walk storage trie: %v", err)
+	}
+	if visited != 1 {
+		t.Errorf("skipping the children of the root should visit a single node; visited %d", visited)
+	}
+}
@@ -2,8 +2,6 @@ package tx
 
 import (
 	"bytes"
-	"encoding/binary"
-	"fmt"
 	"io"
 	"math/big"
 
@@ -36,49 +34,53 @@ func Encode(node ipld.Node, w io.Writer) error {
 // This means less copying of bytes, and if the destination has enough capacity,
 // fewer allocations.
 func AppendEncode(enc []byte, inNode ipld.Node) ([]byte, error) {
-	// Wrap in a typed node for some basic schema form checking
-	builder := dageth.Type.Transaction.NewBuilder()
-	if err := builder.AssignNode(inNode); err != nil {
-		return enc, err
+	// Wrap in a typed node for some basic schema form checking, unless it is one already
+	node, ok := inNode.(dageth.Transaction)
+	if !ok {
+		builder := dageth.Type.Transaction.NewBuilder()
+		if err := builder.AssignNode(inNode); err != nil {
+			return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: err}
+		}
+		node = builder.Build().(dageth.Transaction)
 	}
-	node := builder.Build()
-	txType, err := shared.GetTxType(node)
+	txType, err := shared.TxTypeFromBytes(node.FieldTxType().Bytes())
 	if err != nil {
-		return enc, fmt.Errorf("invalid DAG-ETH Transaction form (%v)", err)
+		return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: dageth.ErrInvalidField{
+			Type: "Transaction", Field: "TxType", Reason: "must be a single byte"}}
 	}
 	wbs := shared.NewWriteableByteSlice(&enc)
 	switch txType {
 	case types.LegacyTxType:
 		tx, err := packLegacyTx(node)
 		if err != nil {
-			return enc, fmt.Errorf("invalid DAG-ETH Transaction form (%v)", err)
+			return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: err}
 		}
 		if err := rlp.Encode(wbs, tx); err != nil {
-			return enc, fmt.Errorf("invalid DAG-ETH Transaction form (%v)", err)
+			return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: err}
 		}
 		return enc, nil
 	case types.AccessListTxType:
 		tx, err := packAccessListTx(node)
 		if err != nil {
-			return enc, fmt.Errorf("invalid DAG-ETH Transaction form (%v)", err)
+			return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: err}
 		}
 		enc = append(enc, txType)
 		if err := rlp.Encode(wbs, tx); err != nil {
-			return enc, fmt.Errorf("invalid DAG-ETH Transaction form (%v)", err)
+			return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: err}
 		}
 		return enc, nil
 	case types.DynamicFeeTxType:
 		tx, err := packDynamicFeeTx(node)
 		if err != nil {
-			return enc, fmt.Errorf("invalid DAG-ETH Transaction form (%v)", err)
+			return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: err}
 		}
 		enc = append(enc, txType)
 		if err := rlp.Encode(wbs, tx); err != nil {
-			return enc, fmt.Errorf("invalid DAG-ETH Transaction form (%v)", err)
+			return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: err}
 		}
 		return enc, nil
 	default:
-		return enc, fmt.Errorf("invalid DAG-ETH Transaction form (unrecognized TxType %d)", txType)
+		return enc, dageth.ErrInvalidForm{Type: "Transaction", Err: dageth.ErrUnsupportedTxType{TxType: txType}}
 	}
 }
 
@@ -91,495 +93,148 @@ func EncodeTx(tx *types.Transaction, inNode ipld.Node) error {
 	return tx.UnmarshalBinary(buf.Bytes())
 }
 
-func packLegacyTx(node ipld.Node) (*types.LegacyTx, error) {
-	lTx := &types.LegacyTx{}
-	for _, pFunc := range requiredPackLegacyTxFuncs {
-		if err := pFunc(lTx, node); err != nil {
-			return nil, err
-		}
-	}
-	return lTx, nil
-}
-
-func packAccessListTx(node ipld.Node) (*types.AccessListTx, error) {
-	alTx := &types.AccessListTx{}
-	for _, pFunc := range requiredPackAccessListTxFuncs {
-		if err := pFunc(alTx, node); err != nil {
-			return nil, err
-		}
-	}
-	return alTx, nil
-}
-
-func packDynamicFeeTx(node ipld.Node) (*types.DynamicFeeTx, error) {
-	alTx := &types.DynamicFeeTx{}
-	for _, pFunc := range requiredPackDynamicFeeTxFuncs {
-		if err := pFunc(alTx, node); err != nil {
-			return nil, err
-		}
-	}
-	return alTx, nil
-}
-
-var requiredPackLegacyTxFuncs = []func(*types.LegacyTx, ipld.Node) error{
-	packAccountNonce,
-	packGasPrice,
-	packGasLimit,
-	packRecipient,
-	packAmount,
-	packData,
-	packSignatureValues,
-}
-
-var requiredPackAccessListTxFuncs = []func(*types.AccessListTx, ipld.Node) error{
-	packChainIDAL,
-	packAccountNonceAL,
-	packGasPriceAL,
-	packGasLimitAL,
-	packRecipientAL,
-	packAmountAL,
-	packDataAL,
-	packAccessListAL,
-	packSignatureValuesAL,
-}
-
-var requiredPackDynamicFeeTxFuncs = []func(*types.DynamicFeeTx, ipld.Node) error{
-	packChainIDDF,
-	packAccountNonceDF,
-	packGasTipCap,
-	packGasFeeCap,
-	packGasLimitDF,
-	packRecipientDF,
-	packAmountDF,
-	packDataDF,
-	packAccessListDF,
-	packSignatureValuesDF,
-}
-
-func packChainIDAL(tx *types.AccessListTx, node ipld.Node) error {
-	chainIDNode, err := node.LookupByString("ChainID")
-	if err != nil {
-		return err
-	}
-	chainIDBytes, err := chainIDNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	tx.ChainID = new(big.Int).SetBytes(chainIDBytes)
-	return nil
-}
-
-func packChainIDDF(tx *types.DynamicFeeTx, node ipld.Node) error {
-	chainIDNode, err := node.LookupByString("ChainID")
+func packLegacyTx(node dageth.Transaction) (*types.LegacyTx, error) {
+	nonce, gas, err := nonceAndGas(node)
 	if err != nil {
-		return err
-	}
-	chainIDBytes, err := chainIDNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	tx.ChainID = new(big.Int).SetBytes(chainIDBytes)
-	return nil
-}
-
-func packAccountNonce(tx *types.LegacyTx, node ipld.Node) error {
-	nonceNode, err := node.LookupByString("AccountNonce")
-	if err != nil {
-		return err
-	}
-	nonceBytes, err := nonceNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	nonce := binary.BigEndian.Uint64(nonceBytes)
-	tx.Nonce = nonce
-	return nil
-}
-
-func packAccountNonceAL(tx *types.AccessListTx, node ipld.Node) error {
-	nonceNode, err := node.LookupByString("AccountNonce")
-	if err != nil {
-		return err
-	}
-	nonceBytes, err := nonceNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	nonce := binary.BigEndian.Uint64(nonceBytes)
-	tx.Nonce = nonce
-	return nil
-}
-
-func packAccountNonceDF(tx *types.DynamicFeeTx, node ipld.Node) error {
-	nonceNode, err := node.LookupByString("AccountNonce")
-	if err != nil {
-		return err
-	}
-	nonceBytes, err := nonceNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	nonce := binary.BigEndian.Uint64(nonceBytes)
-	tx.Nonce = nonce
-	return nil
-}
-
-func packGasPrice(tx *types.LegacyTx, node ipld.Node) error {
-	gpNode, err := node.LookupByString("GasPrice")
-	if err != nil {
-		return err
+		return nil, err
 	}
-	gpBytes, err := gpNode.AsBytes()
+	gasPrice, err := requiredBigInt("GasPrice", node.FieldGasPrice())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gp := new(big.Int).SetBytes(gpBytes)
-	tx.GasPrice = gp
-	return nil
+	return &types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		To:       recipient(node),
+		Value:    new(big.Int).SetBytes(node.FieldAmount().Bytes()),
+		Data:     node.FieldData().Bytes(),
+		V:        new(big.Int).SetBytes(node.FieldV().Bytes()),
+		R:        new(big.Int).SetBytes(node.FieldR().Bytes()),
+		S:        new(big.Int).SetBytes(node.FieldS().Bytes()),
+	}, nil
 }
 
-func packGasPriceAL(tx *types.AccessListTx, node ipld.Node) error {
-	gpNode, err := node.LookupByString("GasPrice")
+func packAccessListTx(node dageth.Transaction) (*types.AccessListTx, error) {
+	nonce, gas, err := nonceAndGas(node)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gpBytes, err := gpNode.AsBytes()
+	chainID, err := requiredBigInt("ChainID", node.FieldChainID())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gp := new(big.Int).SetBytes(gpBytes)
-	tx.GasPrice = gp
-	return nil
-}
-
-func packGasTipCap(tx *types.DynamicFeeTx, node ipld.Node) error {
-	gtcNode, err := node.LookupByString("GasTipCap")
+	gasPrice, err := requiredBigInt("GasPrice", node.FieldGasPrice())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gtcBytes, err := gtcNode.AsBytes()
+	accessList, err := createAccessList(node)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gtc := new(big.Int).SetBytes(gtcBytes)
-	tx.GasTipCap = gtc
-	return nil
+	return &types.AccessListTx{
+		ChainID:    chainID,
+		Nonce:      nonce,
+		GasPrice:   gasPrice,
+		Gas:        gas,
+		To:         recipient(node),
+		Value:      new(big.Int).SetBytes(node.FieldAmount().Bytes()),
+		Data:       node.FieldData().Bytes(),
+		AccessList: accessList,
+		V:          new(big.Int).SetBytes(node.FieldV().Bytes()),
+		R:          new(big.Int).SetBytes(node.FieldR().Bytes()),
+		S:          new(big.Int).SetBytes(node.FieldS().Bytes()),
+	}, nil
 }
 
-func packGasFeeCap(tx *types.DynamicFeeTx, node ipld.Node) error {
-	gfcNode, err := node.LookupByString("GasFeeCap")
+func packDynamicFeeTx(node dageth.Transaction) (*types.DynamicFeeTx, error) {
+	nonce, gas, err := nonceAndGas(node)
 	if err != nil {
-		return err
-	}
-	gfcBytes, err := gfcNode.AsBytes()
-	if err != nil {
-		return err
+		return nil, err
 	}
-	gfc := new(big.Int).SetBytes(gfcBytes)
-	tx.GasFeeCap = gfc
-	return nil
-}
-
-func packGasLimit(tx *types.LegacyTx, node ipld.Node) error {
-	glNode, err := node.LookupByString("GasLimit")
+	chainID, err := requiredBigInt("ChainID", node.FieldChainID())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	glBytes, err := glNode.AsBytes()
+	gasTipCap, err := requiredBigInt("GasTipCap", node.FieldGasTipCap())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gl := binary.BigEndian.Uint64(glBytes)
-	tx.Gas = gl
-	return nil
-}
-
-func packGasLimitAL(tx *types.AccessListTx, node ipld.Node) error {
-	glNode, err := node.LookupByString("GasLimit")
+	gasFeeCap, err := requiredBigInt("GasFeeCap", node.FieldGasFeeCap())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	glBytes, err := glNode.AsBytes()
+	accessList, err := createAccessList(node)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	gl := binary.BigEndian.Uint64(glBytes)
-	tx.Gas = gl
-	return nil
+	return &types.DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      nonce,
+		GasTipCap:  gasTipCap,
+		GasFeeCap:  gasFeeCap,
+		Gas:        gas,
+		To:         recipient(node),
+		Value:      new(big.Int).SetBytes(node.FieldAmount().Bytes()),
+		Data:       node.FieldData().Bytes(),
+		AccessList: accessList,
+		V:          new(big.Int).SetBytes(node.FieldV().Bytes()),
+		R:          new(big.Int).SetBytes(node.FieldR().Bytes()),
+		S:          new(big.Int).SetBytes(node.FieldS().Bytes()),
+	}, nil
 }
 
-func packGasLimitDF(tx *types.DynamicFeeTx, node ipld.Node) error {
-	glNode, err := node.LookupByString("GasLimit")
+// nonceAndGas returns the AccountNonce and GasLimit of the transaction
+func nonceAndGas(node dageth.Transaction) (uint64, uint64, error) {
+	nonce, err := shared.Uint64FromBytes(node.FieldAccountNonce().Bytes())
 	if err != nil {
-		return err
+		return 0, 0, dageth.ErrInvalidField{Type: "Transaction", Field: "AccountNonce", Reason: "must be 8 bytes"}
 	}
-	glBytes, err := glNode.AsBytes()
+	gas, err := shared.Uint64FromBytes(node.FieldGasLimit().Bytes())
 	if err != nil {
-		return err
+		return 0, 0, dageth.ErrInvalidField{Type: "Transaction", Field: "GasLimit", Reason: "must be 8 bytes"}
 	}
-	gl := binary.BigEndian.Uint64(glBytes)
-	tx.Gas = gl
-	return nil
+	return nonce, gas, nil
 }
 
-func packRecipient(tx *types.LegacyTx, node ipld.Node) error {
-	rNode, err := node.LookupByString("Recipient")
-	if err != nil {
-		return err
+// requiredBigInt returns the value of a nullable BigInt field which the tx type requires
+func requiredBigInt(field string, m dageth.MaybeBigInt) (*big.Int, error) {
+	if !m.Exists() {
+		return nil, dageth.ErrInvalidField{Type: "Transaction", Field: field, Reason: "is required for this TxType"}
 	}
-	if rNode.IsNull() {
-		return nil
-	}
-	rBytes, err := rNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	recipient := common.BytesToAddress(rBytes)
-	tx.To = &recipient
-	return nil
+	return new(big.Int).SetBytes(m.Must().Bytes()), nil
 }
 
-func packRecipientAL(tx *types.AccessListTx, node ipld.Node) error {
-	rNode, err := node.LookupByString("Recipient")
-	if err != nil {
-		return err
-	}
-	if rNode.IsNull() {
+// recipient returns the recipient of the transaction, or nil for contract creations
+func recipient(node dageth.Transaction) *common.Address {
+	r := node.FieldRecipient()
+	if !r.Exists() {
 		return nil
 	}
-	rBytes, err := rNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	recipient := common.BytesToAddress(rBytes)
-	tx.To = &recipient
-	return nil
+	addr := common.BytesToAddress(r.Must().Bytes())
+	return &addr
 }
 
-func packRecipientDF(tx *types.DynamicFeeTx, node ipld.Node) error {
-	rNode, err := node.LookupByString("Recipient")
-	if err != nil {
-		return err
+func createAccessList(node dageth.Transaction) (types.AccessList, error) {
+	alNode := node.FieldAccessList()
+	if !alNode.Exists() {
+		return nil, dageth.ErrInvalidField{Type: "Transaction", Field: "AccessList", Reason: "is required for this TxType"}
 	}
-	if rNode.IsNull() {
-		return nil
-	}
-	rBytes, err := rNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	recipient := common.BytesToAddress(rBytes)
-	tx.To = &recipient
-	return nil
-}
-
-func packAmount(tx *types.LegacyTx, node ipld.Node) error {
-	aNode, err := node.LookupByString("Amount")
-	if err != nil {
-		return err
-	}
-	aBytes, err := aNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	amount := new(big.Int).SetBytes(aBytes)
-	tx.Value = amount
-	return nil
-}
-
-func packAmountAL(tx *types.AccessListTx, node ipld.Node) error {
-	aNode, err := node.LookupByString("Amount")
-	if err != nil {
-		return err
-	}
-	aBytes, err := aNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	amount := new(big.Int).SetBytes(aBytes)
-	tx.Value = amount
-	return nil
-}
-
-func packAmountDF(tx *types.DynamicFeeTx, node ipld.Node) error {
-	aNode, err := node.LookupByString("Amount")
-	if err != nil {
-		return err
-	}
-	aBytes, err := aNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	amount := new(big.Int).SetBytes(aBytes)
-	tx.Value = amount
-	return nil
-}
-
-func packData(tx *types.LegacyTx, node ipld.Node) error {
-	dNode, err := node.LookupByString("Data")
-	if err != nil {
-		return err
-	}
-	dBytes, err := dNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	tx.Data = dBytes
-	return nil
-}
-
-func packDataAL(tx *types.AccessListTx, node ipld.Node) error {
-	dNode, err := node.LookupByString("Data")
-	if err != nil {
-		return err
-	}
-	dBytes, err := dNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	tx.Data = dBytes
-	return nil
-}
-
-func packDataDF(tx *types.DynamicFeeTx, node ipld.Node) error {
-	dNode, err := node.LookupByString("Data")
-	if err != nil {
-		return err
-	}
-	dBytes, err := dNode.AsBytes()
-	if err != nil {
-		return err
-	}
-	tx.Data = dBytes
-	return nil
-}
-
-func packAccessListAL(tx *types.AccessListTx, node ipld.Node) error {
-	accessList, err := createAccessList(node)
-	if err != nil {
-		return err
-	}
-	tx.AccessList = accessList
-	return nil
-}
-
-func packAccessListDF(tx *types.DynamicFeeTx, node ipld.Node) error {
-	accessList, err := createAccessList(node)
-	if err != nil {
-		return err
-	}
-	tx.AccessList = accessList
-	return nil
-}
-
-func createAccessList(node ipld.Node) (types.AccessList, error) {
-	alNode, err := node.LookupByString("AccessList")
-	if err != nil {
-		return nil, err
-	}
-	accessList := make(types.AccessList, alNode.Length())
-	accessListIt := alNode.ListIterator()
+	accessList := make(types.AccessList, alNode.Must().Length())
+	accessListIt := alNode.Must().Iterator()
 	for !accessListIt.Done() {
-		index, accessElementNode, err := accessListIt.Next()
-		if err != nil {
-			return nil, err
-		}
-		addrNode, err := accessElementNode.LookupByString("Address")
-		if err != nil {
-			return nil, err
-		}
-		addrBytes, err := addrNode.AsBytes()
-		if err != nil {
-			return nil, err
-		}
-		addr := common.BytesToAddress(addrBytes)
-
-		storageKeysNode, err := accessElementNode.LookupByString("StorageKeys")
-		if err != nil {
-			return nil, err
-		}
+		index, accessElementNode := accessListIt.Next()
+		storageKeysNode := accessElementNode.FieldStorageKeys()
 		storageKeys := make([]common.Hash, storageKeysNode.Length())
-		storageKeysIt := storageKeysNode.ListIterator()
+		storageKeysIt := storageKeysNode.Iterator()
 		for !storageKeysIt.Done() {
-			index, storageKeyNode, err := storageKeysIt.Next()
-			if err != nil {
-				return nil, err
-			}
-			storageKeyBytes, err := storageKeyNode.AsBytes()
-			if err != nil {
-				return nil, err
-			}
-			storageKeys[index] = common.BytesToHash(storageKeyBytes)
+			index, storageKeyNode := storageKeysIt.Next()
+			storageKeys[index] = common.BytesToHash(storageKeyNode.Bytes())
 		}
-		accessElement := types.AccessTuple{
-			Address:     addr,
+		accessList[index] = types.AccessTuple{
+			Address:     common.BytesToAddress(accessElementNode.FieldAddress().Bytes()),
 			StorageKeys: storageKeys,
 		}
-		accessList[index] = accessElement
 	}
 	return accessList, nil
 }
-
-func createVRS(node ipld.Node) (*big.Int, *big.Int, *big.Int, error) {
-	vNode, err := node.LookupByString("V")
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	vBytes, err := vNode.AsBytes()
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	v := new(big.Int).SetBytes(vBytes)
-	rNode, err := node.LookupByString("R")
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	rBytes, err := rNode.AsBytes()
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	r := new(big.Int).SetBytes(rBytes)
-	sNode, err := node.LookupByString("S")
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	sBytes, err := sNode.AsBytes()
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	s := new(big.Int).SetBytes(sBytes)
-	return v, r, s, nil
-}
-
-func packSignatureValues(tx *types.LegacyTx, node ipld.Node) error {
-	v, r, s, err := createVRS(node)
-	if err != nil {
-		return err
-	}
-	tx.V = v
-	tx.R = r
-	tx.S = s
-	return nil
-}
-
-func packSignatureValuesAL(tx *types.AccessListTx, node ipld.Node) error {
-	v, r, s, err := createVRS(node)
-	if err != nil {
-		return err
-	}
-	tx.V = v
-	tx.R = r
-	tx.S = s
-	return nil
-}
-
-func packSignatureValuesDF(tx *types.DynamicFeeTx, node ipld.Node) error {
-	v, r, s, err := createVRS(node)
-	if err != nil {
-		return err
-	}
-	tx.V = v
-	tx.R = r
-	tx.S = s
-	return nil
-}
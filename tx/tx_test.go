@@ -2,6 +2,7 @@ package tx_test
 
 import (
 	"bytes"
+	"io"
 	"math/big"
 	"testing"
 
@@ -9,9 +10,12 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
 	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/shared/fuzztest"
 	"github.com/vulcanize/go-codec-dageth/tx"
 )
 
@@ -191,3 +195,96 @@ func testTransactionEncoding(t *testing.T) {
 		t.Errorf("dynamic fee transaction encoding (%x) does not match the expected consensus encoding (%x)", dfTxBytes, dfTxConsensusEnc)
 	}
 }
+
+func TestTransactionStreamDecoding(t *testing.T) {
+	// a contract creation with a large init code
+	creation, err := types.NewContractCreation(0, big.NewInt(0), 5000000, big.NewInt(1), shared.RandomBytes(1<<20)).WithSignature(
+		types.HomesteadSigner{},
+		common.Hex2Bytes("9bea4c4daac7c7c52e093e6a4c35dbbcf8856f1af7b059ba20253e70848d094f8a8fae537ce25ed8cb5af9adac3f141af69bd515bd2ba031522df09b97dd72b100"),
+	)
+	if err != nil {
+		t.Fatalf("unable to sign contract creation: %v", err)
+	}
+	for _, trx := range []*types.Transaction{legacyTx, accessListTx, dynamicFeeTx, creation} {
+		enc, err := trx.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unable to marshal transaction binary: %v", err)
+		}
+		// a bytes.Reader does not expose its buffer, so it is decoded incrementally
+		streamBuilder := dageth.Type.Transaction.NewBuilder()
+		if err := tx.Decode(streamBuilder, bytes.NewReader(enc)); err != nil {
+			t.Fatalf("unable to decode type %d transaction from a stream: %v", trx.Type(), err)
+		}
+		bufBuilder := dageth.Type.Transaction.NewBuilder()
+		if err := tx.Decode(bufBuilder, bytes.NewBuffer(enc)); err != nil {
+			t.Fatalf("unable to decode type %d transaction from a buffer: %v", trx.Type(), err)
+		}
+		if !ipld.DeepEqual(streamBuilder.Build(), bufBuilder.Build()) {
+			t.Errorf("type %d transaction decoded from a stream does not match the one decoded from a buffer", trx.Type())
+		}
+
+		if err := tx.Decode(dageth.Type.Transaction.NewBuilder(), bytes.NewReader(append(enc, 0x80))); err == nil {
+			t.Errorf("expected decoding a type %d transaction with trailing bytes to fail", trx.Type())
+		}
+		limited := &io.LimitedReader{R: bytes.NewReader(enc), N: int64(len(enc) - 1)}
+		if err := tx.Decode(dageth.Type.Transaction.NewBuilder(), limited); err == nil {
+			t.Errorf("expected decoding a type %d transaction past the reader limit to fail", trx.Type())
+		}
+	}
+}
+
+func TestTypedTransactionEncoding(t *testing.T) {
+	for _, trx := range []*types.Transaction{legacyTx, accessListTx, dynamicFeeTx} {
+		enc, err := trx.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unable to marshal transaction binary: %v", err)
+		}
+		builder := dageth.Type.Transaction.NewBuilder()
+		if err := tx.DecodeBytes(builder, enc); err != nil {
+			t.Fatalf("unable to decode type %d transaction: %v", trx.Type(), err)
+		}
+		typed := builder.Build()
+		// the same node as an untyped node, which the encoder has to copy into a typed node first
+		untypedBuilder := basicnode.Prototype.Any.NewBuilder()
+		if err := datamodel.Copy(typed, untypedBuilder); err != nil {
+			t.Fatalf("unable to copy type %d transaction into an untyped node: %v", trx.Type(), err)
+		}
+		untyped := untypedBuilder.Build()
+
+		typedEnc, err := tx.AppendEncode(nil, typed)
+		if err != nil {
+			t.Fatalf("unable to encode typed type %d transaction: %v", trx.Type(), err)
+		}
+		untypedEnc, err := tx.AppendEncode(nil, untyped)
+		if err != nil {
+			t.Fatalf("unable to encode untyped type %d transaction: %v", trx.Type(), err)
+		}
+		if !bytes.Equal(typedEnc, enc) || !bytes.Equal(untypedEnc, enc) {
+			t.Errorf("type %d transaction encodings do not match the consensus encoding", trx.Type())
+		}
+
+		buf := make([]byte, 0, 1024)
+		typedAllocs := testing.AllocsPerRun(10, func() { tx.AppendEncode(buf, typed) })
+		untypedAllocs := testing.AllocsPerRun(10, func() { tx.AppendEncode(buf, untyped) })
+		if typedAllocs >= untypedAllocs {
+			t.Errorf("encoding a typed type %d transaction should allocate less than copying an untyped one (%v >= %v)", trx.Type(), typedAllocs, untypedAllocs)
+		}
+	}
+}
+
+func FuzzDecodeBytes(f *testing.F) {
+	for _, seed := range []*types.Transaction{legacyTx, accessListTx, dynamicFeeTx} {
+		enc, err := seed.MarshalBinary()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(enc)
+	}
+	codec, ok := dageth.LookupCodec(tx.MultiCodecType)
+	if !ok {
+		f.Fatal("codec is not in the dageth.Codecs table")
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzztest.FuzzRoundTrip(t, codec, data)
+	})
+}
@@ -1,41 +1,215 @@
 package tx
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
-	"io/ioutil"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ipld/go-ipld-prime"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
 )
 
 // Decode provides an IPLD codec decode interface for eth transaction IPLDs.
 // This function is registered via the go-ipld-prime link loader for multicodec
 // code 0x93 when this package is invoked via init.
 func Decode(na ipld.NodeAssembler, in io.Reader) error {
-	var src []byte
 	if buf, ok := in.(interface{ Bytes() []byte }); ok {
-		src = buf.Bytes()
-	} else {
-		var err error
-		src, err = ioutil.ReadAll(in)
+		return DecodeBytes(na, buf.Bytes())
+	}
+	trailing, err := shared.StreamDecode(in, func(s *rlp.Stream) error {
+		return decodeTxStream(na, s)
+	})
+	if err != nil {
+		return dageth.ErrInvalidBinary{Type: "Transaction", Err: err}
+	}
+	if trailing > 0 {
+		return dageth.ErrInvalidBinary{Type: "Transaction", Err: rlp.ErrMoreThanOneValue}
+	}
+	return nil
+}
+
+// DecodeBytes is like Decode, but it uses an input buffer directly.
+// Decode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
+func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
+	return Decode(na, bytes.NewReader(src))
+}
+
+// decodeTxStream reads a transaction in its binary encoding from the stream, assembling each field as it is read:
+// a legacy transaction is an RLP list, a typed transaction is its type byte followed by the RLP list of its fields
+func decodeTxStream(na ipld.NodeAssembler, s *rlp.Stream) error {
+	kind, _, err := s.Kind()
+	if err != nil {
+		return err
+	}
+	txType, fields := byte(types.LegacyTxType), legacyStreamFields
+	if kind != rlp.List {
+		if kind != rlp.Byte {
+			return dageth.ErrInvalidField{Type: "Transaction", Field: "TxType", Reason: "should be the leading byte of a typed transaction"}
+		}
+		b, err := s.Bytes()
 		if err != nil {
 			return err
 		}
+		switch txType = b[0]; txType {
+		case types.AccessListTxType:
+			fields = accessListStreamFields
+		case types.DynamicFeeTxType:
+			fields = dynamicFeeStreamFields
+		default:
+			return dageth.ErrUnsupportedTxType{TxType: txType}
+		}
+	}
+	if _, err := s.List(); err != nil {
+		return err
 	}
-	return DecodeBytes(na, src)
+	ma, err := na.BeginMap(14)
+	if err != nil {
+		return err
+	}
+	if err := ma.AssembleKey().AssignString("TxType"); err != nil {
+		return err
+	}
+	if err := ma.AssembleValue().AssignBytes([]byte{txType}); err != nil {
+		return err
+	}
+	if field, err := shared.UnpackStreamFields(ma, s, fields); err != nil {
+		return dageth.ErrInvalidField{Type: "Transaction", Field: field, Reason: "is malformed", Err: err}
+	}
+	if err := s.ListEnd(); err != nil {
+		return err
+	}
+	return ma.Finish()
 }
 
-// DecodeBytes is like Decode, but it uses an input buffer directly.
-// Decode will grab or read all the bytes from an io.Reader anyway, so this can
-// save having to copy the bytes or create a bytes.Buffer.
-func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
-	var tx types.Transaction
-	if err := tx.UnmarshalBinary(src); err != nil {
+// legacyStreamFields are the fields of a legacy transaction, in the order of its RLP encoding, with the fields it
+// does not have assigned null where they first could be
+var legacyStreamFields = []shared.StreamField{
+	{Name: "ChainID", Unpack: shared.StreamNull},
+	{Name: "AccountNonce", Unpack: shared.StreamUint},
+	{Name: "GasPrice", Unpack: shared.StreamBigInt},
+	{Name: "GasTipCap", Unpack: shared.StreamNull},
+	{Name: "GasFeeCap", Unpack: shared.StreamNull},
+	{Name: "GasLimit", Unpack: shared.StreamUint},
+	{Name: "Recipient", Unpack: unpackStreamRecipient},
+	{Name: "Amount", Unpack: shared.StreamBigInt},
+	{Name: "Data", Unpack: shared.StreamBytes(-1)},
+	{Name: "AccessList", Unpack: shared.StreamNull},
+	{Name: "V", Unpack: shared.StreamBigInt},
+	{Name: "R", Unpack: shared.StreamBigInt},
+	{Name: "S", Unpack: shared.StreamBigInt},
+}
+
+// accessListStreamFields are the fields of an EIP-2930 transaction, in the order of its RLP encoding
+var accessListStreamFields = []shared.StreamField{
+	{Name: "ChainID", Unpack: shared.StreamBigInt},
+	{Name: "AccountNonce", Unpack: shared.StreamUint},
+	{Name: "GasPrice", Unpack: shared.StreamBigInt},
+	{Name: "GasTipCap", Unpack: shared.StreamNull},
+	{Name: "GasFeeCap", Unpack: shared.StreamNull},
+	{Name: "GasLimit", Unpack: shared.StreamUint},
+	{Name: "Recipient", Unpack: unpackStreamRecipient},
+	{Name: "Amount", Unpack: shared.StreamBigInt},
+	{Name: "Data", Unpack: shared.StreamBytes(-1)},
+	{Name: "AccessList", Unpack: unpackStreamAccessList},
+	{Name: "V", Unpack: shared.StreamBigInt},
+	{Name: "R", Unpack: shared.StreamBigInt},
+	{Name: "S", Unpack: shared.StreamBigInt},
+}
+
+// dynamicFeeStreamFields are the fields of an EIP-1559 transaction, in the order of its RLP encoding
+var dynamicFeeStreamFields = []shared.StreamField{
+	{Name: "ChainID", Unpack: shared.StreamBigInt},
+	{Name: "AccountNonce", Unpack: shared.StreamUint},
+	{Name: "GasPrice", Unpack: shared.StreamNull},
+	{Name: "GasTipCap", Unpack: shared.StreamBigInt},
+	{Name: "GasFeeCap", Unpack: shared.StreamBigInt},
+	{Name: "GasLimit", Unpack: shared.StreamUint},
+	{Name: "Recipient", Unpack: unpackStreamRecipient},
+	{Name: "Amount", Unpack: shared.StreamBigInt},
+	{Name: "Data", Unpack: shared.StreamBytes(-1)},
+	{Name: "AccessList", Unpack: unpackStreamAccessList},
+	{Name: "V", Unpack: shared.StreamBigInt},
+	{Name: "R", Unpack: shared.StreamBigInt},
+	{Name: "S", Unpack: shared.StreamBigInt},
+}
+
+// unpackStreamRecipient assigns the recipient address, or null for the empty string of a contract creation
+func unpackStreamRecipient(na ipld.NodeAssembler, s *rlp.Stream) error {
+	to, err := s.Bytes()
+	if err != nil {
 		return err
 	}
-	return DecodeTx(na, tx)
+	switch len(to) {
+	case 0:
+		return na.AssignNull()
+	case common.AddressLength:
+		return na.AssignBytes(to)
+	default:
+		return fmt.Errorf("must be empty or %d bytes, got %d", common.AddressLength, len(to))
+	}
+}
+
+func unpackStreamAccessList(na ipld.NodeAssembler, s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	la, err := na.BeginList(-1)
+	if err != nil {
+		return err
+	}
+	for s.MoreDataInList() {
+		if _, err := s.List(); err != nil {
+			return err
+		}
+		accessElementMap, err := la.AssembleValue().BeginMap(2)
+		if err != nil {
+			return err
+		}
+		if _, err := shared.UnpackStreamFields(accessElementMap, s, accessElementStreamFields); err != nil {
+			return err
+		}
+		if err := s.ListEnd(); err != nil {
+			return err
+		}
+		if err := accessElementMap.Finish(); err != nil {
+			return err
+		}
+	}
+	if err := s.ListEnd(); err != nil {
+		return err
+	}
+	return la.Finish()
+}
+
+var accessElementStreamFields = []shared.StreamField{
+	{Name: "Address", Unpack: shared.StreamBytes(common.AddressLength)},
+	{Name: "StorageKeys", Unpack: unpackStreamStorageKeys},
+}
+
+func unpackStreamStorageKeys(na ipld.NodeAssembler, s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	la, err := na.BeginList(-1)
+	if err != nil {
+		return err
+	}
+	for s.MoreDataInList() {
+		if err := shared.StreamBytes(common.HashLength)(la.AssembleValue(), s); err != nil {
+			return err
+		}
+	}
+	if err := s.ListEnd(); err != nil {
+		return err
+	}
+	return la.Finish()
 }
 
 // DecodeTx unpacks a go-ethereum Transaction into a NodeAssembler
@@ -46,7 +220,7 @@ func DecodeTx(na ipld.NodeAssembler, tx types.Transaction) error {
 	}
 	for _, upFunc := range requiredUnpackFuncs {
 		if err := upFunc(ma, tx); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Transaction binary (%v)", err)
+			return dageth.ErrInvalidBinary{Type: "Transaction", Err: err}
 		}
 	}
 	return ma.Finish()
@@ -104,7 +278,7 @@ func unpackGasPrice(ma ipld.MapAssembler, tx types.Transaction) error {
 	return ma.AssembleValue().AssignBytes(tx.GasPrice().Bytes())
 }
 
-func unpackGasFeeCap(ma ipld.MapAssembler, tx types.Transaction) error {
+func unpackGasTipCap(ma ipld.MapAssembler, tx types.Transaction) error {
 	if err := ma.AssembleKey().AssignString("GasTipCap"); err != nil {
 		return err
 	}
@@ -114,7 +288,7 @@ func unpackGasFeeCap(ma ipld.MapAssembler, tx types.Transaction) error {
 	return ma.AssembleValue().AssignBytes(tx.GasTipCap().Bytes())
 }
 
-func unpackGasTipCap(ma ipld.MapAssembler, tx types.Transaction) error {
+func unpackGasFeeCap(ma ipld.MapAssembler, tx types.Transaction) error {
 	if err := ma.AssembleKey().AssignString("GasFeeCap"); err != nil {
 		return err
 	}
@@ -204,20 +378,20 @@ func unpackAccessList(ma ipld.MapAssembler, tx types.Transaction) error {
 
 func unpackSignatureValues(ma ipld.MapAssembler, tx types.Transaction) error {
 	v, r, s := tx.RawSignatureValues()
-	if err := ma.AssembleKey().AssignString("R"); err != nil {
+	if err := ma.AssembleKey().AssignString("V"); err != nil {
 		return err
 	}
-	if err := ma.AssembleValue().AssignBytes(r.Bytes()); err != nil {
+	if err := ma.AssembleValue().AssignBytes(v.Bytes()); err != nil {
 		return err
 	}
-	if err := ma.AssembleKey().AssignString("S"); err != nil {
+	if err := ma.AssembleKey().AssignString("R"); err != nil {
 		return err
 	}
-	if err := ma.AssembleValue().AssignBytes(s.Bytes()); err != nil {
+	if err := ma.AssembleValue().AssignBytes(r.Bytes()); err != nil {
 		return err
 	}
-	if err := ma.AssembleKey().AssignString("V"); err != nil {
+	if err := ma.AssembleKey().AssignString("S"); err != nil {
 		return err
 	}
-	return ma.AssembleValue().AssignBytes(v.Bytes())
+	return ma.AssembleValue().AssignBytes(s.Bytes())
 }
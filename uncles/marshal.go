@@ -1,8 +1,8 @@
 package uncles
 
 import (
-	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -39,28 +39,29 @@ func AppendEncode(enc []byte, inNode ipld.Node) ([]byte, error) {
 	}
 	wbs := shared.NewWriteableByteSlice(&enc)
 	if err := rlp.Encode(wbs, uncles); err != nil {
-		return enc, fmt.Errorf("invalid DAG-ETH Uncles form (unable to RLP encode uncles: %v)", err)
+		return enc, dageth.ErrInvalidForm{Type: "Uncles", Err: err}
 	}
 	return enc, nil
 }
 
 // EncodeUncles packs the node into a list of go-ethereum headers
 func EncodeUncles(uncles *[]*types.Header, inNode ipld.Node) error {
-	// Wrap in a typed node for some basic schema form checking
-	builder := dageth.Type.Uncles.NewBuilder()
-	if err := builder.AssignNode(inNode); err != nil {
-		return err
+	// Wrap in a typed node for some basic schema form checking, unless it is one already
+	node, ok := inNode.(dageth.Uncles)
+	if !ok {
+		builder := dageth.Type.Uncles.NewBuilder()
+		if err := builder.AssignNode(inNode); err != nil {
+			return dageth.ErrInvalidForm{Type: "Uncles", Err: err}
+		}
+		node = builder.Build().(dageth.Uncles)
 	}
-	node := builder.Build()
-	unclesIt := node.ListIterator()
+	unclesIt := node.Iterator()
 	for !unclesIt.Done() {
-		_, uncleNode, err := unclesIt.Next()
-		if err != nil {
-			return err
-		}
+		i, uncleNode := unclesIt.Next()
 		uncle := new(types.Header)
 		if err := dageth_header.EncodeHeader(uncle, uncleNode); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Uncles form (%v)", err)
+			return dageth.ErrInvalidForm{Type: "Uncles", Err: dageth.ErrInvalidField{
+				Type: "Uncles", Field: strconv.FormatInt(i, 10), Reason: "is not a valid Header", Err: err}}
 		}
 		*uncles = append(*uncles, uncle)
 	}
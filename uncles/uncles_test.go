@@ -15,6 +15,7 @@ import (
 	"github.com/multiformats/go-multihash"
 
 	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared/fuzztest"
 	unc "github.com/vulcanize/go-codec-dageth/uncles"
 )
 
@@ -340,3 +341,15 @@ func testUnclesEncode(t *testing.T) {
 		t.Errorf("uncles encoding (%x) does not match the expected RLP encoding (%x)", encodedUnclesBytes, unclesRLP)
 	}
 }
+
+func FuzzDecodeBytes(f *testing.F) {
+	f.Add(unclesRLP)
+	f.Add([]byte{0xc0})
+	codec, ok := dageth.LookupCodec(unc.MultiCodecType)
+	if !ok {
+		f.Fatal("codec is not in the dageth.Codecs table")
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzztest.FuzzRoundTrip(t, codec, data)
+	})
+}
@@ -1,44 +1,68 @@
 package uncles
 
 import (
-	"fmt"
+	"bytes"
 	"io"
-	"io/ioutil"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ipld/go-ipld-prime"
 
+	dageth "github.com/vulcanize/go-codec-dageth"
 	dageth_header "github.com/vulcanize/go-codec-dageth/header"
+	"github.com/vulcanize/go-codec-dageth/shared"
 )
 
 // Decode provides an IPLD codec decode interface for eth uncles IPLDs (header list).
 // This function is registered via the go-ipld-prime link loader for multicodec
 // code 0x91 when this package is invoked via init.
 func Decode(na ipld.NodeAssembler, in io.Reader) error {
-	var src []byte
 	if buf, ok := in.(interface{ Bytes() []byte }); ok {
-		src = buf.Bytes()
-	} else {
-		var err error
-		src, err = ioutil.ReadAll(in)
-		if err != nil {
-			return err
-		}
+		return DecodeBytes(na, buf.Bytes())
+	}
+	trailing, err := shared.StreamDecode(in, func(s *rlp.Stream) error {
+		return decodeUnclesStream(na, s)
+	})
+	if err != nil {
+		return dageth.ErrInvalidBinary{Type: "Uncles", Err: err}
 	}
-	return DecodeBytes(na, src)
+	if trailing > 0 {
+		return dageth.ErrInvalidBinary{Type: "Uncles", Err: rlp.ErrMoreThanOneValue}
+	}
+	return nil
 }
 
 // DecodeBytes is like Decode, but it uses an input buffer directly.
-// Decode will grab or read all the bytes from an io.Reader anyway, so this can
-// save having to copy the bytes or create a bytes.Buffer.
+// Decode uses this for readers that expose their buffer, such as a bytes.Buffer, and
+// decodes all other readers with shared.StreamDecode.
 func DecodeBytes(na ipld.NodeAssembler, src []byte) error {
-	var uncles []*types.Header
-	if err := rlp.DecodeBytes(src, &uncles); err != nil {
+	return Decode(na, bytes.NewReader(src))
+}
+
+// decodeUnclesStream reads the uncle headers from the stream one at a time, assembling each as it is read
+func decodeUnclesStream(na ipld.NodeAssembler, s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
 		return err
 	}
-
-	return DecodeUncles(na, uncles)
+	// the number of uncles is not known until they are read
+	la, err := na.BeginList(-1)
+	if err != nil {
+		return err
+	}
+	for i := 0; s.MoreDataInList(); i++ {
+		uncle, err := s.Raw()
+		if err != nil {
+			return err
+		}
+		if err := dageth_header.DecodeBytes(la.AssembleValue(), uncle); err != nil {
+			return dageth.ErrInvalidField{Type: "Uncles", Field: strconv.Itoa(i), Reason: "is not a valid Header", Err: err}
+		}
+	}
+	if err := s.ListEnd(); err != nil {
+		return err
+	}
+	return la.Finish()
 }
 
 // DecodeUncles unpacks a list of go-ethereum headers into the NodeAssembler
@@ -51,7 +75,8 @@ func DecodeUncles(na ipld.NodeAssembler, uncles []*types.Header) error {
 		// node := dageth.Type.Header.NewBuilder()
 		node := la.ValuePrototype(int64(i)).NewBuilder()
 		if err := dageth_header.DecodeHeader(node, *uncle); err != nil {
-			return fmt.Errorf("invalid DAG-ETH Uncles binary (%v)", err)
+			return dageth.ErrInvalidBinary{Type: "Uncles", Err: dageth.ErrInvalidField{
+				Type: "Uncles", Field: strconv.Itoa(i), Reason: "is not a valid Header", Err: err}}
 		}
 		if err := la.AssembleValue().AssignNode(node.Build()); err != nil {
 			return err
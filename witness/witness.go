@@ -0,0 +1,445 @@
+// Package witness extracts stateless witnesses from the DAG-ETH graph: the minimal set of state trie nodes, storage
+// trie nodes and contract code needed to execute a block against the state of its parent.
+package witness
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/traversal"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+	"github.com/multiformats/go-multihash"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/header"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/trie"
+
+	// register the codecs reached from a state root, for Verify
+	_ "github.com/ipld/go-ipld-prime/codec/raw"
+	_ "github.com/vulcanize/go-codec-dageth/state_trie"
+	_ "github.com/vulcanize/go-codec-dageth/storage_trie"
+)
+
+var (
+	emptyRootHash = types.EmptyRootHash.Bytes()
+	emptyCodeHash = crypto.Keccak256(nil)
+)
+
+// Accesses maps each account accessed by a block to the storage slots of the account it accesses.
+// Slots are the raw 32-byte slot keys, not their keccak256 hashes.
+type Accesses map[common.Address][]common.Hash
+
+// Witness is a CID-keyed bundle of the DAG-ETH blocks a block touches: its header and the header of its parent, which
+// links the block to the state it executes against, the state trie nodes on the paths to the accessed accounts, the
+// storage trie nodes on the paths to the accessed slots, and the code of the accessed accounts.
+// Nodes embedded in their parent are contained in the parent's block and are not listed separately.
+type Witness struct {
+	// Header is the CID of the header of the block the witness is for
+	Header cid.Cid
+	// StateRoot is the StateRootCID of the parent of the block, the state the block executes against
+	StateRoot cid.Cid
+	// Accesses are the accounts and slots the witness was built for, or those reported to the Recorder
+	Accesses Accesses
+	// Blocks holds the raw block data keyed by CID
+	Blocks map[cid.Cid][]byte
+}
+
+// Build builds the witness for executing the block with the provided header CID against the state of its parent,
+// loading the header, its parent, trie nodes and code from the LinkSystem. The state root of the witness is the
+// StateRootCID of the parent header.
+// For every accessed account the witness holds the state trie proof for the account, and if the account exists its
+// code and the storage trie proof for each of its accessed slots. Proofs of absence are included for accounts and
+// slots that do not exist, so the witness proves every access.
+func Build(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, headerCID cid.Cid, accesses Accesses) (*Witness, error) {
+	if headerCID.Prefix().Codec != cid.EthBlock {
+		return nil, fmt.Errorf("witness requires a header CID; got multicodec type (%d)", headerCID.Prefix().Codec)
+	}
+	w := &Witness{
+		Header:   headerCID,
+		Accesses: accesses,
+		Blocks:   make(map[cid.Cid][]byte),
+	}
+	raw, err := lsys.LoadRaw(lnkCtx, cidlink.Link{Cid: headerCID})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load header %s: %v", headerCID.String(), err)
+	}
+	w.Blocks[headerCID] = raw
+	parent, err := headerLink(raw, "ParentCID")
+	if err != nil {
+		return nil, fmt.Errorf("header %s: %v", headerCID.String(), err)
+	}
+	if raw, err = lsys.LoadRaw(lnkCtx, cidlink.Link{Cid: parent}); err != nil {
+		return nil, fmt.Errorf("unable to load parent header %s: %v", parent.String(), err)
+	}
+	w.Blocks[parent] = raw
+	stateRoot, err := headerLink(raw, "StateRootCID")
+	if err != nil {
+		return nil, fmt.Errorf("parent header %s: %v", parent.String(), err)
+	}
+	if stateRoot.Prefix().Codec != cid.EthStateTrie {
+		return nil, fmt.Errorf("parent header %s StateRootCID is not a state trie CID; got multicodec type (%d)", parent.String(), stateRoot.Prefix().Codec)
+	}
+	w.StateRoot = stateRoot
+	if isEmpty(stateRoot, emptyRootHash) {
+		return w, nil
+	}
+	for _, addr := range sortedAddresses(accesses) {
+		acct, err := w.prove(lnkCtx, lsys, stateRoot, shared.AddressToLeafKey(addr))
+		if err != nil {
+			return nil, fmt.Errorf("unable to prove account %s: %v", addr.Hex(), err)
+		}
+		if acct == nil {
+			continue
+		}
+		storageRoot, codeCID, err := accountLinks(acct)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %v", addr.Hex(), err)
+		}
+		if !isEmpty(codeCID, emptyCodeHash) {
+			code, err := lsys.LoadRaw(lnkCtx, cidlink.Link{Cid: codeCID})
+			if err != nil {
+				return nil, fmt.Errorf("unable to load code for account %s: %v", addr.Hex(), err)
+			}
+			w.Blocks[codeCID] = code
+		}
+		if isEmpty(storageRoot, emptyRootHash) {
+			continue
+		}
+		for _, slot := range accesses[addr] {
+			if _, err := w.prove(lnkCtx, lsys, storageRoot, crypto.Keccak256(slot.Bytes())); err != nil {
+				return nil, fmt.Errorf("unable to prove slot %s of account %s: %v", slot.Hex(), addr.Hex(), err)
+			}
+		}
+	}
+	return w, nil
+}
+
+// prove adds the proof for the key in the trie to the witness and returns the value at the key
+func (w *Witness) prove(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, root cid.Cid, key []byte) (ipld.Node, error) {
+	proof, val, err := proveKey(lnkCtx, lsys, root, key)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range proof {
+		w.Blocks[n.CID] = n.RLP
+	}
+	return val, nil
+}
+
+// proveKey returns the proof for the key in the trie, loaded from the LinkSystem, and the value it proves
+func proveKey(lnkCtx ipld.LinkContext, lsys ipld.LinkSystem, root cid.Cid, key []byte) (trie.Proof, ipld.Node, error) {
+	proof, err := trie.Prove(lnkCtx, lsys, root, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	val, err := trie.VerifyProof(root, key, proof)
+	return proof, val, err
+}
+
+// Verify checks the witness against its state root: every block must hash to its CID, the header and its parent must
+// be present, the state root must be the StateRootCID of the parent, every other block must be reachable from the
+// state root through the blocks of the witness, and every access the witness was built for must be proven by the
+// witness. Every access is proven absent from an empty state, which needs no trie nodes.
+func (w *Witness) Verify() error {
+	for c, raw := range w.Blocks {
+		sum, err := c.Prefix().Sum(raw)
+		if err != nil {
+			return fmt.Errorf("unable to hash block %s: %v", c.String(), err)
+		}
+		if !sum.Equals(c) {
+			return dageth.ErrHashMismatch{Expected: c, Actual: sum}
+		}
+	}
+	parent, err := w.checkStateRoot()
+	if err != nil {
+		return err
+	}
+	reached, err := w.reachable()
+	if err != nil {
+		return err
+	}
+	for c := range w.Blocks {
+		if c != w.Header && c != parent && !reached[c] {
+			return fmt.Errorf("block %s is not reachable from state root %s", c.String(), w.StateRoot.String())
+		}
+	}
+	if isEmpty(w.StateRoot, emptyRootHash) {
+		return nil
+	}
+	lnkCtx := ipld.LinkContext{}
+	lsys := w.LinkSystem()
+	for _, addr := range sortedAddresses(w.Accesses) {
+		_, acct, err := proveKey(lnkCtx, lsys, w.StateRoot, shared.AddressToLeafKey(addr))
+		if err != nil {
+			return fmt.Errorf("witness does not prove account %s: %v", addr.Hex(), err)
+		}
+		if acct == nil {
+			continue
+		}
+		storageRoot, codeCID, err := accountLinks(acct)
+		if err != nil {
+			return fmt.Errorf("account %s: %v", addr.Hex(), err)
+		}
+		if _, ok := w.Blocks[codeCID]; !ok && !isEmpty(codeCID, emptyCodeHash) {
+			return fmt.Errorf("witness is missing code %s for account %s", codeCID.String(), addr.Hex())
+		}
+		if isEmpty(storageRoot, emptyRootHash) {
+			continue
+		}
+		for _, slot := range w.Accesses[addr] {
+			if _, _, err := proveKey(lnkCtx, lsys, storageRoot, crypto.Keccak256(slot.Bytes())); err != nil {
+				return fmt.Errorf("witness does not prove slot %s of account %s: %v", slot.Hex(), addr.Hex(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// checkStateRoot checks that the header and its parent are in the witness, and that the state root of the witness is
+// the StateRootCID of the parent. It returns the CID of the parent header.
+func (w *Witness) checkStateRoot() (cid.Cid, error) {
+	raw, ok := w.Blocks[w.Header]
+	if !ok {
+		return cid.Cid{}, fmt.Errorf("witness is missing header %s", w.Header.String())
+	}
+	parent, err := headerLink(raw, "ParentCID")
+	if err != nil {
+		return cid.Cid{}, fmt.Errorf("header %s: %v", w.Header.String(), err)
+	}
+	if raw, ok = w.Blocks[parent]; !ok {
+		return cid.Cid{}, fmt.Errorf("witness is missing parent header %s", parent.String())
+	}
+	parentStateRoot, err := headerLink(raw, "StateRootCID")
+	if err != nil {
+		return cid.Cid{}, fmt.Errorf("parent header %s: %v", parent.String(), err)
+	}
+	if parentStateRoot != w.StateRoot {
+		return cid.Cid{}, fmt.Errorf("witness state root %s is not the state root %s of parent header %s",
+			w.StateRoot.String(), parentStateRoot.String(), parent.String())
+	}
+	return parent, nil
+}
+
+// reachable traverses the DAG rooted at the state root, following only links to blocks held in the witness,
+// and returns the set of blocks reached
+func (w *Witness) reachable() (map[cid.Cid]bool, error) {
+	reached := make(map[cid.Cid]bool)
+	if _, ok := w.Blocks[w.StateRoot]; !ok {
+		return reached, nil
+	}
+	lsys := w.LinkSystem()
+	readOpener := lsys.StorageReadOpener
+	lsys.StorageReadOpener = func(lnkCtx ipld.LinkContext, lnk ipld.Link) (io.Reader, error) {
+		cidLink, ok := lnk.(cidlink.Link)
+		if !ok {
+			return nil, fmt.Errorf("unsupported link type %T", lnk)
+		}
+		if _, ok := w.Blocks[cidLink.Cid]; !ok {
+			return nil, traversal.SkipMe{}
+		}
+		reached[cidLink.Cid] = true
+		return readOpener(lnkCtx, lnk)
+	}
+	root := cidlink.Link{Cid: w.StateRoot}
+	rootNode, err := lsys.Load(ipld.LinkContext{}, root, dageth.Type.TrieNode)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state root %s: %v", w.StateRoot.String(), err)
+	}
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	sel, err := selector.CompileSelector(ssb.ExploreRecursive(selector.RecursionLimitNone(),
+		ssb.ExploreAll(ssb.ExploreRecursiveEdge())).Node())
+	if err != nil {
+		return nil, err
+	}
+	prog := traversal.Progress{
+		Cfg: &traversal.Config{
+			LinkSystem:                     lsys,
+			LinkTargetNodePrototypeChooser: dageth.NodePrototypeChooser,
+		},
+	}
+	err = prog.WalkAdv(rootNode, sel, func(traversal.Progress, ipld.Node, traversal.VisitReason) error { return nil })
+	if err != nil {
+		return nil, fmt.Errorf("unable to traverse witness: %v", err)
+	}
+	return reached, nil
+}
+
+// LinkSystem returns a read-only LinkSystem that loads blocks from the witness, for executing the block statelessly
+func (w *Witness) LinkSystem() ipld.LinkSystem {
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.StorageReadOpener = func(_ ipld.LinkContext, lnk ipld.Link) (io.Reader, error) {
+		cidLink, ok := lnk.(cidlink.Link)
+		if !ok {
+			return nil, fmt.Errorf("unsupported link type %T", lnk)
+		}
+		raw, ok := w.Blocks[cidLink.Cid]
+		if !ok {
+			return nil, fmt.Errorf("witness is missing block %s", cidLink.Cid.String())
+		}
+		return bytes.NewReader(raw), nil
+	}
+	return lsys
+}
+
+// Recorder wraps a LinkSystem and records every block loaded through it, as an alternative to listing the accesses
+// of a block up front: executing the block, or any other traversal, against the recorded LinkSystem collects the
+// blocks it touches. The accounts and slots the execution accesses can be reported with Access, so that the witness
+// proves them. Recorder is safe for concurrent use.
+type Recorder struct {
+	lsys     ipld.LinkSystem
+	mu       sync.Mutex
+	blocks   map[cid.Cid][]byte
+	accesses Accesses
+}
+
+// NewRecorder returns a Recorder loading blocks from the provided LinkSystem
+func NewRecorder(lsys ipld.LinkSystem) *Recorder {
+	return &Recorder{
+		lsys:     lsys,
+		blocks:   make(map[cid.Cid][]byte),
+		accesses: make(Accesses),
+	}
+}
+
+// Access records that the account, and the provided storage slots of it, are accessed by the block
+func (r *Recorder) Access(addr common.Address, slots ...common.Hash) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accesses[addr] = append(r.accesses[addr], slots...)
+}
+
+// LinkSystem returns a LinkSystem that loads blocks from the wrapped LinkSystem and records them
+func (r *Recorder) LinkSystem() ipld.LinkSystem {
+	lsys := r.lsys
+	readOpener := lsys.StorageReadOpener
+	lsys.StorageReadOpener = func(lnkCtx ipld.LinkContext, lnk ipld.Link) (io.Reader, error) {
+		reader, err := readOpener(lnkCtx, lnk)
+		if err != nil {
+			return nil, err
+		}
+		cidLink, ok := lnk.(cidlink.Link)
+		if !ok {
+			return reader, nil
+		}
+		raw, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		r.mu.Lock()
+		r.blocks[cidLink.Cid] = raw
+		r.mu.Unlock()
+		return bytes.NewReader(raw), nil
+	}
+	return lsys
+}
+
+// Witness returns the blocks recorded so far as a witness for the block with the provided header CID, executing
+// against the StateRootCID of its parent header, along with the accesses reported so far. The header and its parent
+// must have been loaded through the recorder. Recorded blocks that are not reachable from the state root, such as
+// transaction or receipt trie nodes, are left out of the witness.
+func (r *Recorder) Witness(headerCID cid.Cid) (*Witness, error) {
+	r.mu.Lock()
+	w := &Witness{
+		Header:   headerCID,
+		Accesses: make(Accesses, len(r.accesses)),
+		Blocks:   make(map[cid.Cid][]byte, len(r.blocks)),
+	}
+	for addr, slots := range r.accesses {
+		w.Accesses[addr] = append([]common.Hash(nil), slots...)
+	}
+	for c, raw := range r.blocks {
+		w.Blocks[c] = raw
+	}
+	r.mu.Unlock()
+	raw, ok := w.Blocks[headerCID]
+	if !ok {
+		return nil, fmt.Errorf("header %s was not recorded", headerCID.String())
+	}
+	parent, err := headerLink(raw, "ParentCID")
+	if err != nil {
+		return nil, fmt.Errorf("header %s: %v", headerCID.String(), err)
+	}
+	if raw, ok = w.Blocks[parent]; !ok {
+		return nil, fmt.Errorf("parent header %s was not recorded", parent.String())
+	}
+	if w.StateRoot, err = headerLink(raw, "StateRootCID"); err != nil {
+		return nil, fmt.Errorf("parent header %s: %v", parent.String(), err)
+	}
+	reached, err := w.reachable()
+	if err != nil {
+		return nil, err
+	}
+	for c := range w.Blocks {
+		if c != headerCID && c != parent && !reached[c] {
+			delete(w.Blocks, c)
+		}
+	}
+	return w, nil
+}
+
+// headerLink decodes a raw header block and returns the CID of one of its link fields
+func headerLink(raw []byte, field string) (cid.Cid, error) {
+	builder := dageth.Type.Header.NewBuilder()
+	if err := header.DecodeBytes(builder, raw); err != nil {
+		return cid.Cid{}, err
+	}
+	return linkField(builder.Build(), field)
+}
+
+// accountLinks returns the StorageRootCID and CodeCID of an Account node
+func accountLinks(acct ipld.Node) (cid.Cid, cid.Cid, error) {
+	storageRoot, err := linkField(acct, "StorageRootCID")
+	if err != nil {
+		return cid.Cid{}, cid.Cid{}, err
+	}
+	codeCID, err := linkField(acct, "CodeCID")
+	if err != nil {
+		return cid.Cid{}, cid.Cid{}, err
+	}
+	return storageRoot, codeCID, nil
+}
+
+func linkField(node ipld.Node, field string) (cid.Cid, error) {
+	fieldNode, err := node.LookupByString(field)
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	lnk, err := fieldNode.AsLink()
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	cidLink, ok := lnk.(cidlink.Link)
+	if !ok {
+		return cid.Cid{}, fmt.Errorf("%s needs to be a CID", field)
+	}
+	return cidLink.Cid, nil
+}
+
+// isEmpty returns whether the CID references the provided empty trie root or empty code hash
+func isEmpty(c cid.Cid, emptyHash []byte) bool {
+	decodedMh, err := multihash.Decode(c.Hash())
+	return err == nil && bytes.Equal(decodedMh.Digest, emptyHash)
+}
+
+func sortedAddresses(accesses Accesses) []common.Address {
+	addrs := make([]common.Address, 0, len(accesses))
+	for addr := range accesses {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	return addrs
+}
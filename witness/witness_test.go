@@ -0,0 +1,367 @@
+package witness_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/storage/memstore"
+
+	dageth "github.com/vulcanize/go-codec-dageth"
+	"github.com/vulcanize/go-codec-dageth/shared"
+	"github.com/vulcanize/go-codec-dageth/trie"
+	"github.com/vulcanize/go-codec-dageth/witness"
+)
+
+const (
+	testAccounts = 200
+	testSlots    = 50
+)
+
+var (
+	testAddress = common.BigToAddress(big.NewInt(7))
+	testCode    = []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+)
+
+type fixture struct {
+	lsys        ipld.LinkSystem
+	header      cid.Cid
+	parent      cid.Cid
+	stateRoot   cid.Cid
+	storageRoot cid.Cid
+	codeCID     cid.Cid
+}
+
+func TestBuild(t *testing.T) {
+	f := newFixture(t)
+	accesses := witness.Accesses{
+		testAddress:                           {common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(1000))},
+		common.BigToAddress(big.NewInt(3)):    nil,
+		common.BigToAddress(big.NewInt(5000)): {common.BigToHash(big.NewInt(1))},
+	}
+	w, err := witness.Build(ipld.LinkContext{}, f.lsys, f.header, accesses)
+	if err != nil {
+		t.Fatalf("unable to build witness: %v", err)
+	}
+	if err := w.Verify(); err != nil {
+		t.Fatalf("witness failed to verify: %v", err)
+	}
+	if _, ok := w.Blocks[f.header]; !ok {
+		t.Errorf("witness should contain the header")
+	}
+	if _, ok := w.Blocks[f.parent]; !ok {
+		t.Errorf("witness should contain the parent header")
+	}
+	if _, ok := w.Blocks[f.codeCID]; !ok {
+		t.Errorf("witness should contain the code of the accessed contract")
+	}
+	if _, ok := w.Blocks[f.storageRoot]; !ok {
+		t.Errorf("witness should contain the storage root of the accessed contract")
+	}
+	var stateNodes int
+	if err := trie.Walk(ipld.LinkContext{}, f.lsys, f.stateRoot, func(n trie.WalkNode) error {
+		if n.CID.Defined() {
+			stateNodes++
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unable to walk state trie: %v", err)
+	}
+	if len(w.Blocks) >= stateNodes {
+		t.Errorf("witness should be smaller than the state trie; got %d blocks for %d state trie nodes", len(w.Blocks), stateNodes)
+	}
+
+	// the witness can be used in place of the full state to read the accessed values
+	proof, err := trie.Prove(ipld.LinkContext{}, w.LinkSystem(), f.storageRoot, crypto.Keccak256(common.BigToHash(big.NewInt(1)).Bytes()))
+	if err != nil {
+		t.Fatalf("unable to prove slot from witness: %v", err)
+	}
+	if len(proof) == 0 {
+		t.Errorf("expected a storage proof from the witness")
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	f := newFixture(t)
+	accesses := witness.Accesses{testAddress: {common.BigToHash(big.NewInt(1))}}
+	build := func() *witness.Witness {
+		w, err := witness.Build(ipld.LinkContext{}, f.lsys, f.header, accesses)
+		if err != nil {
+			t.Fatalf("unable to build witness: %v", err)
+		}
+		return w
+	}
+
+	w := build()
+	delete(w.Blocks, f.storageRoot)
+	if err := w.Verify(); err == nil {
+		t.Errorf("expected witness missing a storage node to fail verification")
+	}
+
+	w = build()
+	w.Blocks[f.codeCID] = []byte{0x00}
+	var mismatch dageth.ErrHashMismatch
+	if err := w.Verify(); !errors.As(err, &mismatch) || mismatch.Expected != f.codeCID {
+		t.Errorf("expected witness with a block not matching its CID to fail verification with ErrHashMismatch; got %v", err)
+	}
+
+	w = build()
+	other, err := witness.Build(ipld.LinkContext{}, f.lsys, f.header, witness.Accesses{common.BigToAddress(big.NewInt(150)): nil})
+	if err != nil {
+		t.Fatalf("unable to build witness: %v", err)
+	}
+	otherStorage := newStorageTrie(t, f.lsys, 3)
+	raw, err := f.lsys.LoadRaw(ipld.LinkContext{}, cidlink.Link{Cid: otherStorage})
+	if err != nil {
+		t.Fatalf("unable to load storage root: %v", err)
+	}
+	for c, raw := range other.Blocks {
+		w.Blocks[c] = raw
+	}
+	if err := w.Verify(); err != nil {
+		t.Errorf("witness extended with another state path should verify: %v", err)
+	}
+	w.Blocks[otherStorage] = raw
+	if err := w.Verify(); err == nil {
+		t.Errorf("expected witness with a block unreachable from the state root to fail verification")
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	f := newFixture(t)
+	rec := witness.NewRecorder(f.lsys)
+	lsys := rec.LinkSystem()
+	for _, c := range []cid.Cid{f.header, f.parent} {
+		if _, err := lsys.LoadRaw(ipld.LinkContext{}, cidlink.Link{Cid: c}); err != nil {
+			t.Fatalf("unable to load header: %v", err)
+		}
+	}
+	if _, err := trie.Prove(ipld.LinkContext{}, lsys, f.stateRoot, shared.AddressToLeafKey(testAddress)); err != nil {
+		t.Fatalf("unable to prove account: %v", err)
+	}
+	slot := common.BigToHash(big.NewInt(2))
+	if _, err := trie.Prove(ipld.LinkContext{}, lsys, f.storageRoot, crypto.Keccak256(slot.Bytes())); err != nil {
+		t.Fatalf("unable to prove slot: %v", err)
+	}
+	if _, err := lsys.LoadRaw(ipld.LinkContext{}, cidlink.Link{Cid: f.codeCID}); err != nil {
+		t.Fatalf("unable to load code: %v", err)
+	}
+	rec.Access(testAddress, slot)
+	// blocks loaded through the recorder that are not part of the state are left out of the witness
+	txBuilder, err := trie.NewBuilder(f.lsys, cid.EthTxTrie)
+	if err != nil {
+		t.Fatalf("unable to create trie builder: %v", err)
+	}
+	if err := txBuilder.PutIndexed(types.Transactions{types.NewTransaction(0, testAddress, big.NewInt(1), 21000, big.NewInt(1), nil)}); err != nil {
+		t.Fatalf("unable to add txs to trie: %v", err)
+	}
+	txRoot, err := txBuilder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit tx trie: %v", err)
+	}
+	if _, err := lsys.LoadRaw(ipld.LinkContext{}, cidlink.Link{Cid: txRoot}); err != nil {
+		t.Fatalf("unable to load tx trie node: %v", err)
+	}
+	w, err := rec.Witness(f.header)
+	if err != nil {
+		t.Fatalf("unable to make recorded witness: %v", err)
+	}
+	if w.StateRoot != f.stateRoot {
+		t.Errorf("recorded witness state root (%s) is not the state root of the parent header (%s)", w.StateRoot, f.stateRoot)
+	}
+	if slots := w.Accesses[testAddress]; len(slots) != 1 || slots[0] != slot {
+		t.Errorf("recorded witness should hold the reported accesses; got %v", w.Accesses)
+	}
+	if err := w.Verify(); err != nil {
+		t.Fatalf("recorded witness failed to verify: %v", err)
+	}
+	if _, ok := w.Blocks[f.storageRoot]; !ok {
+		t.Errorf("recorded witness should contain the storage root")
+	}
+	if _, ok := w.Blocks[txRoot]; ok {
+		t.Errorf("recorded witness should not contain blocks unreachable from the state root")
+	}
+
+	// an access the recorded blocks do not prove fails verification
+	rec.Access(common.BigToAddress(big.NewInt(150)))
+	if w, err = rec.Witness(f.header); err != nil {
+		t.Fatalf("unable to make recorded witness: %v", err)
+	}
+	if err := w.Verify(); err == nil {
+		t.Errorf("expected recorded witness with an unproven access to fail verification")
+	}
+}
+
+func TestVerifyRejectsForeignStateRoot(t *testing.T) {
+	f := newFixture(t)
+	// a state trie which is not the state of the parent of the header
+	foreignBuilder, err := trie.NewBuilder(f.lsys, cid.EthStateTrie)
+	if err != nil {
+		t.Fatalf("unable to create trie builder: %v", err)
+	}
+	acctRLP, err := rlp.EncodeToBytes(&types.StateAccount{Balance: big.NewInt(1), Root: types.EmptyRootHash, CodeHash: crypto.Keccak256(nil)})
+	if err != nil {
+		t.Fatalf("unable to RLP encode account: %v", err)
+	}
+	foreignBuilder.Put(shared.AddressToLeafKey(testAddress), acctRLP)
+	foreignRoot, err := foreignBuilder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit state trie: %v", err)
+	}
+	accesses := witness.Accesses{testAddress: nil}
+
+	// a witness holding valid proofs against the foreign state root still does not verify for the header
+	rec := witness.NewRecorder(f.lsys)
+	lsys := rec.LinkSystem()
+	for _, c := range []cid.Cid{f.header, f.parent} {
+		if _, err := lsys.LoadRaw(ipld.LinkContext{}, cidlink.Link{Cid: c}); err != nil {
+			t.Fatalf("unable to load header: %v", err)
+		}
+	}
+	if _, err := trie.Prove(ipld.LinkContext{}, lsys, foreignRoot, shared.AddressToLeafKey(testAddress)); err != nil {
+		t.Fatalf("unable to prove account: %v", err)
+	}
+	rec.Access(testAddress)
+	w, err := rec.Witness(f.header)
+	if err != nil {
+		t.Fatalf("unable to make recorded witness: %v", err)
+	}
+	if err := w.Verify(); err == nil {
+		t.Errorf("expected witness holding proofs against a foreign state root to fail verification")
+	}
+	w.StateRoot = foreignRoot
+	if err := w.Verify(); err == nil {
+		t.Errorf("expected witness against a foreign state root to fail verification")
+	}
+
+	// nor does one without the parent header
+	w, err = witness.Build(ipld.LinkContext{}, f.lsys, f.header, accesses)
+	if err != nil {
+		t.Fatalf("unable to build witness: %v", err)
+	}
+	delete(w.Blocks, f.parent)
+	if err := w.Verify(); err == nil {
+		t.Errorf("expected witness missing the parent header to fail verification")
+	}
+}
+
+func TestEmptyState(t *testing.T) {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	emptyRoot := shared.Keccak256ToCid(cid.EthStateTrie, types.EmptyRootHash.Bytes())
+	headerCID, _ := storeHeaders(t, store, emptyRoot)
+	w, err := witness.Build(ipld.LinkContext{}, lsys, headerCID, witness.Accesses{
+		testAddress: {common.BigToHash(big.NewInt(1))},
+	})
+	if err != nil {
+		t.Fatalf("unable to build witness: %v", err)
+	}
+	if err := w.Verify(); err != nil {
+		t.Errorf("witness for an empty state failed to verify: %v", err)
+	}
+}
+
+func newFixture(t *testing.T) *fixture {
+	store := &memstore.Store{}
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetReadStorage(store)
+	lsys.SetWriteStorage(store)
+	f := &fixture{lsys: lsys}
+
+	var err error
+	f.codeCID, err = shared.RawToCid(cid.Raw, testCode)
+	if err != nil {
+		t.Fatalf("unable to make code CID: %v", err)
+	}
+	if err := store.Put(context.Background(), string(f.codeCID.Bytes()), testCode); err != nil {
+		t.Fatalf("unable to store code: %v", err)
+	}
+	f.storageRoot = newStorageTrie(t, lsys, testSlots)
+
+	stateBuilder, err := trie.NewBuilder(lsys, cid.EthStateTrie)
+	if err != nil {
+		t.Fatalf("unable to create trie builder: %v", err)
+	}
+	for i := int64(0); i < testAccounts; i++ {
+		addr := common.BigToAddress(big.NewInt(i))
+		acct := &types.StateAccount{
+			Nonce:    uint64(i),
+			Balance:  big.NewInt(i),
+			Root:     types.EmptyRootHash,
+			CodeHash: crypto.Keccak256(nil),
+		}
+		if addr == testAddress {
+			acct.Root = common.BytesToHash(f.storageRoot.Hash()[2:])
+			acct.CodeHash = crypto.Keccak256(testCode)
+		}
+		acctRLP, err := rlp.EncodeToBytes(acct)
+		if err != nil {
+			t.Fatalf("unable to RLP encode account: %v", err)
+		}
+		stateBuilder.Put(shared.AddressToLeafKey(addr), acctRLP)
+	}
+	f.stateRoot, err = stateBuilder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit state trie: %v", err)
+	}
+
+	f.header, f.parent = storeHeaders(t, store, f.stateRoot)
+	return f
+}
+
+// storeHeaders stores a header and its parent, which has the provided state root, and returns their CIDs
+func storeHeaders(t *testing.T, store *memstore.Store, stateRoot cid.Cid) (cid.Cid, cid.Cid) {
+	parent := &types.Header{
+		UncleHash:   types.EmptyUncleHash,
+		Root:        common.BytesToHash(stateRoot.Hash()[2:]),
+		TxHash:      types.EmptyRootHash,
+		ReceiptHash: types.EmptyRootHash,
+		Difficulty:  big.NewInt(1),
+		Number:      big.NewInt(1),
+		Extra:       []byte{},
+	}
+	header := types.CopyHeader(parent)
+	header.ParentHash = parent.Hash()
+	header.Number = big.NewInt(2)
+	header.Root = crypto.Keccak256Hash([]byte("post state"))
+	cids := make([]cid.Cid, 2)
+	for i, h := range []*types.Header{header, parent} {
+		headerRLP, err := rlp.EncodeToBytes(h)
+		if err != nil {
+			t.Fatalf("unable to RLP encode header: %v", err)
+		}
+		if cids[i], err = shared.RawToCid(cid.EthBlock, headerRLP); err != nil {
+			t.Fatalf("unable to make header CID: %v", err)
+		}
+		if err := store.Put(context.Background(), string(cids[i].Bytes()), headerRLP); err != nil {
+			t.Fatalf("unable to store header: %v", err)
+		}
+	}
+	return cids[0], cids[1]
+}
+
+func newStorageTrie(t *testing.T, lsys ipld.LinkSystem, slots int64) cid.Cid {
+	builder, err := trie.NewBuilder(lsys, cid.EthStorageTrie)
+	if err != nil {
+		t.Fatalf("unable to create trie builder: %v", err)
+	}
+	for i := int64(0); i < slots; i++ {
+		slot := common.BigToHash(big.NewInt(i))
+		builder.Put(crypto.Keccak256(slot.Bytes()), crypto.Keccak256(slot.Bytes(), []byte{byte(slots)}))
+	}
+	root, err := builder.Commit(ipld.LinkContext{})
+	if err != nil {
+		t.Fatalf("unable to commit storage trie: %v", err)
+	}
+	return root
+}